@@ -26,15 +26,20 @@ import (
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	v1 "k8s.io/client-go/informers/core/v1"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	listersv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	cloudprovider "k8s.io/cloud-provider"
 
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
@@ -52,6 +57,7 @@ const (
 	providerPrefix = providerName + "://"
 
 	enableFlexCIDRController         = "ENABLE_FLEX_CIDR_CONTROLLER"
+	enableGatewayController          = "ENABLE_GATEWAY_CONTROLLER"
 	disableInstanceTaggingController = "DISABLE_INSTANCE_TAGGING_CONTROLLER"
 	openshiftNodeLabelId             = "OPENSHIFT_NODE_LABEL_ID"
 	// Default OpenShift node OS label key/value
@@ -76,9 +82,15 @@ type CloudProvider struct {
 	// with Worker Identity which then can be used to communicate with OCI services.
 	ServiceAccountLister listersv1.ServiceAccountLister
 
+	// ServiceLister provides a cache to lookup the services sharing a load balancer.
+	ServiceLister listersv1.ServiceLister
+
 	client     client.Interface
 	kubeclient clientset.Interface
 
+	// recorder records the events of the services of load balancers, if set.
+	recorder record.EventRecorder
+
 	securityListManagerFactory securityListManagerFactory
 	config                     *providercfg.Config
 
@@ -183,6 +195,10 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 		utilruntime.HandleError(fmt.Errorf("failed to create kubeclient: %v", err))
 	}
 
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: cp.kubeclient.CoreV1().Events("")})
+	cp.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "cloud-controller-manager"})
+
 	factory := informers.NewSharedInformerFactory(cp.kubeclient, 5*time.Minute)
 
 	nodeInfoController := NewNodeInfoController(
@@ -244,6 +260,8 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 
 	cp.ServiceAccountLister = serviceAccountInformer.Lister()
 
+	cp.ServiceLister = serviceInformer.Lister()
+
 	/* StorageBackfillController not applicable for Open Source CCM
 	enableStorageBackfillController := GetIsFeatureEnabledFromEnv(cp.logger, resourceTrackingFeatureFlagName, false)
 	if enableStorageBackfillController {
@@ -270,6 +288,43 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 		}
 		return newSecurityListManager(cp.logger, cp.client, serviceInformer, cp.config.LoadBalancer.SecurityLists, mode)
 	}
+
+	// The gateway controller reconciles load balancers, so it is started once
+	// the security list manager factory is set.
+	if GetIsFeatureEnabledFromEnv(cp.logger, enableGatewayController, false) {
+		cp.startGatewayController(clientBuilder, nodeInformer, serviceInformer)
+	} else {
+		cp.logger.Info("Gateway controller disabled because ENABLE_GATEWAY_CONTROLLER is unset or set to false")
+	}
+}
+
+// startGatewayController starts the controller implementing the Gateway API
+// with OCI load balancers.
+func (cp *CloudProvider) startGatewayController(clientBuilder cloudprovider.ControllerClientBuilder, nodeInformer v1.NodeInformer, serviceInformer v1.ServiceInformer) {
+	config, err := clientBuilder.Config("cloud-controller-manager")
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to create gateway controller client config: %v", err))
+		return
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to create gateway controller dynamic client: %v", err))
+		return
+	}
+	gatewayController, err := NewGatewayController(
+		dynamicClient,
+		cp.kubeclient,
+		nodeInformer,
+		serviceInformer,
+		cp,
+		cp.logger.With("controller", "gateway-controller"),
+	)
+	if err != nil {
+		cp.logger.With(zap.Error(err)).Error("Gateway controller disabled")
+		return
+	}
+	cp.logger.Info("Gateway controller enabled")
+	go gatewayController.Run(wait.NeverStop)
 }
 
 // ProviderName returns the cloud-provider ID.
@@ -305,9 +360,14 @@ func (cp *CloudProvider) Clusters() (cloudprovider.Clusters, bool) {
 }
 
 // Routes returns a routes interface along with whether the interface is
-// supported.
+// supported. Routes are only supported when a route table is configured.
 func (cp *CloudProvider) Routes() (cloudprovider.Routes, bool) {
-	return nil, false
+	if cp.config.Routes == nil || cp.config.Routes.RouteTableID == "" {
+		cp.logger.Debug("Claiming to not support routes")
+		return nil, false
+	}
+	cp.logger.Debug("Claiming to support routes")
+	return cp, true
 }
 
 // ScrubDNS provides an opportunity for cloud-provider-specific code to process
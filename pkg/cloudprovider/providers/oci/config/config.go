@@ -110,6 +110,14 @@ type LoadBalancerConfig struct {
 	SecurityLists map[string]string `yaml:"securityLists"`
 }
 
+// RoutesConfig holds the configuration options for programming pod network
+// routes into a VCN route table.
+type RoutesConfig struct {
+	// RouteTableID is the OCID of the route table into which a route rule is
+	// programmed for the pod CIDR of every node.
+	RouteTableID string `yaml:"routeTable"`
+}
+
 // RateLimiterConfig holds the configuration options for OCI rate limiting.
 type RateLimiterConfig struct {
 	RateLimitQPSRead     float32 `yaml:"rateLimitQPSRead"`
@@ -153,6 +161,8 @@ type Config struct {
 	Auth         AuthConfig          `yaml:"auth"`
 	LoadBalancer *LoadBalancerConfig `yaml:"loadBalancer"`
 	RateLimiter  *RateLimiterConfig  `yaml:"rateLimiter"`
+	// Routes to pod CIDRs are programmed when this configuration is provided
+	Routes *RoutesConfig `yaml:"routes"`
 	// Metrics collection is enabled when this configuration is provided
 	Metrics *MetricsConfig `yaml:"metrics"`
 	// Tags to be added to managed LB and BV
@@ -89,6 +89,10 @@ func ValidateConfig(c *Config) field.ErrorList {
 		allErrs = append(allErrs, validateLoadBalancerConfig(c, field.NewPath("loadBalancer"))...)
 	}
 
+	if c.Routes != nil && len(c.Routes.RouteTableID) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("routes", "routeTable"), "Route table is required for programming pod CIDR routes"))
+	}
+
 	// Validate metric config if the metrics block is not empty
 	if c.Metrics != nil {
 		if len(c.Metrics.CompartmentID) == 0 {
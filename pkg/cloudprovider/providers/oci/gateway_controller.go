@@ -0,0 +1,762 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const gatewayResyncPeriod = 5 * time.Minute
+
+// gatewayRouteResources are the route resources by kind.
+var gatewayRouteResources = map[string]schema.GroupVersionResource{
+	httpRouteKind: httpRoutesResource,
+	tlsRouteKind:  tlsRoutesResource,
+	tcpRouteKind:  tcpRoutesResource,
+}
+
+// gatewayQueueKey is the key of a GatewayClass or a Gateway to sync.
+type gatewayQueueKey struct {
+	kind string
+	key  string
+}
+
+// GatewayController implements the Gateways of the GatewayClasses of
+// GatewayControllerName with OCI load balancers, routing the traffic of their
+// listeners by the HTTPRoutes, TLSRoutes and TCPRoutes attached to them.
+type GatewayController struct {
+	dynamicClient        dynamic.Interface
+	kubeClient           clientset.Interface
+	informerFactory      dynamicinformer.DynamicSharedInformerFactory
+	gatewayClassInformer informers.GenericInformer
+	gatewayInformer      informers.GenericInformer
+	routeInformers       map[string]informers.GenericInformer
+	nodeInformer         coreinformers.NodeInformer
+	serviceInformer      coreinformers.ServiceInformer
+	cloud                *CloudProvider
+	queue                workqueue.RateLimitingInterface
+	logger               *zap.SugaredLogger
+}
+
+// NewGatewayController returns a gateway controller watching the Gateway API
+// resources served by the cluster. An error is returned if Gateways are not
+// served.
+func NewGatewayController(
+	dynamicClient dynamic.Interface,
+	kubeClient clientset.Interface,
+	nodeInformer coreinformers.NodeInformer,
+	serviceInformer coreinformers.ServiceInformer,
+	cloud *CloudProvider,
+	logger *zap.SugaredLogger) (*GatewayController, error) {
+
+	served, err := getServedGatewayResources(kubeClient.Discovery())
+	if err != nil {
+		return nil, err
+	}
+	if !served.Has(gatewayClassesResource) || !served.Has(gatewaysResource) {
+		return nil, errors.Errorf("%s/%s GatewayClasses and Gateways are not served, are the Gateway API CRDs installed?", gatewayAPIGroup, gatewaysResource.Version)
+	}
+
+	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, gatewayResyncPeriod)
+	controller := &GatewayController{
+		dynamicClient:        dynamicClient,
+		kubeClient:           kubeClient,
+		informerFactory:      informerFactory,
+		gatewayClassInformer: informerFactory.ForResource(gatewayClassesResource),
+		gatewayInformer:      informerFactory.ForResource(gatewaysResource),
+		routeInformers:       make(map[string]informers.GenericInformer),
+		nodeInformer:         nodeInformer,
+		serviceInformer:      serviceInformer,
+		cloud:                cloud,
+		queue:                workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		logger:               logger,
+	}
+
+	controller.gatewayClassInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.enqueueGatewayClass,
+		UpdateFunc: func(_, newObj interface{}) { controller.enqueueGatewayClass(newObj) },
+		DeleteFunc: controller.enqueueGatewayClass,
+	})
+	controller.gatewayInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.enqueueGateway,
+		UpdateFunc: func(_, newObj interface{}) { controller.enqueueGateway(newObj) },
+	})
+	for kind, resource := range gatewayRouteResources {
+		if !served.Has(resource) {
+			logger.Infof("%s/%s %ss are not served, skipping them", gatewayAPIGroup, resource.Version, kind)
+			continue
+		}
+		kind := kind
+		informer := informerFactory.ForResource(resource)
+		informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) { controller.enqueueRouteParents(kind, obj) },
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueueRouteParents(kind, oldObj)
+				controller.enqueueRouteParents(kind, newObj)
+			},
+			DeleteFunc: func(obj interface{}) { controller.enqueueRouteParents(kind, obj) },
+		})
+		controller.routeInformers[kind] = informer
+	}
+	controller.serviceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueServiceGateways,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if !equality.Semantic.DeepEqual(oldObj.(*v1.Service).Spec.Ports, newObj.(*v1.Service).Spec.Ports) {
+				controller.enqueueServiceGateways(newObj)
+			}
+		},
+		DeleteFunc: controller.enqueueServiceGateways,
+	})
+	controller.nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { controller.enqueueAllGateways() },
+		DeleteFunc: func(interface{}) { controller.enqueueAllGateways() },
+	})
+
+	return controller, nil
+}
+
+// getServedGatewayResources returns the Gateway API resources served by the
+// cluster.
+func getServedGatewayResources(discoveryClient discovery.DiscoveryInterface) (sets.Set[schema.GroupVersionResource], error) {
+	served := sets.New[schema.GroupVersionResource]()
+	for _, version := range []string{gatewaysResource.Version, tlsRoutesResource.Version} {
+		groupVersion := schema.GroupVersion{Group: gatewayAPIGroup, Version: version}
+		resources, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion.String())
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "discovering %s resources", groupVersion)
+		}
+		for _, resource := range resources.APIResources {
+			served.Insert(groupVersion.WithResource(resource.Name))
+		}
+	}
+	return served, nil
+}
+
+func (gc *GatewayController) Run(stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer gc.queue.ShutDown()
+
+	gc.logger.Info("Starting gateway controller")
+
+	gc.informerFactory.Start(stopCh)
+	synced := []cache.InformerSynced{
+		gc.gatewayClassInformer.Informer().HasSynced,
+		gc.gatewayInformer.Informer().HasSynced,
+		gc.nodeInformer.Informer().HasSynced,
+		gc.serviceInformer.Informer().HasSynced,
+	}
+	for _, informer := range gc.routeInformers {
+		synced = append(synced, informer.Informer().HasSynced)
+	}
+	if !cache.WaitForCacheSync(stopCh, synced...) {
+		utilruntime.HandleError(fmt.Errorf("timed out waiting for gateway controller caches to sync"))
+		return
+	}
+
+	wait.Until(gc.runWorker, time.Second, stopCh)
+}
+
+func (gc *GatewayController) runWorker() {
+	for gc.processNextItem() {
+	}
+}
+
+func (gc *GatewayController) processNextItem() bool {
+	item, quit := gc.queue.Get()
+	if quit {
+		return false
+	}
+	defer gc.queue.Done(item)
+
+	key := item.(gatewayQueueKey)
+	var err error
+	if key.kind == gatewayKind {
+		err = gc.syncGateway(key.key)
+	} else {
+		err = gc.syncGatewayClass(key.key)
+	}
+	if err != nil {
+		gc.logger.Errorf("Error syncing %s %s (will retry): %v", key.kind, key.key, err)
+		gc.queue.AddRateLimited(item)
+	} else {
+		gc.queue.Forget(item)
+	}
+
+	return true
+}
+
+func (gc *GatewayController) enqueueGatewayClass(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		gc.logger.With(zap.Error(err)).Debug("failed to determine gateway class cache key")
+		return
+	}
+	gc.queue.Add(gatewayQueueKey{kind: "GatewayClass", key: key})
+
+	// The parameters of the class apply to its gateways.
+	objs, err := gc.gatewayInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return
+	}
+	for _, o := range objs {
+		className, _, _ := unstructured.NestedString(o.(*unstructured.Unstructured).Object, "spec", "gatewayClassName")
+		if className == key {
+			gc.enqueueGateway(o)
+		}
+	}
+}
+
+func (gc *GatewayController) enqueueGateway(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		gc.logger.With(zap.Error(err)).Debug("failed to determine gateway cache key")
+		return
+	}
+	gc.queue.Add(gatewayQueueKey{kind: gatewayKind, key: key})
+}
+
+func (gc *GatewayController) enqueueAllGateways() {
+	objs, err := gc.gatewayInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return
+	}
+	for _, obj := range objs {
+		gc.enqueueGateway(obj)
+	}
+}
+
+// enqueueRouteParents enqueues the Gateways a route refers to.
+func (gc *GatewayController) enqueueRouteParents(kind string, obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	route, err := toGatewayRoute(kind, u)
+	if err != nil {
+		gc.logger.With(zap.Error(err)).Debugf("failed to decode %s", kind)
+		return
+	}
+	for _, ref := range route.Spec.ParentRefs {
+		if (ref.Group != nil && *ref.Group != gatewayAPIGroup) || (ref.Kind != nil && *ref.Kind != gatewayKind) {
+			continue
+		}
+		namespace := route.Namespace
+		if ref.Namespace != nil {
+			namespace = *ref.Namespace
+		}
+		gc.queue.Add(gatewayQueueKey{kind: gatewayKind, key: fmt.Sprintf("%s/%s", namespace, ref.Name)})
+	}
+}
+
+// enqueueServiceGateways enqueues the Gateways of the routes forwarding to a
+// service, whose node ports are the backends of their load balancers.
+func (gc *GatewayController) enqueueServiceGateways(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	svc, ok := obj.(*v1.Service)
+	if !ok {
+		return
+	}
+	routes, objs, err := gc.listGatewayRoutes()
+	if err != nil {
+		return
+	}
+	for _, route := range routes {
+		if route.Namespace != svc.Namespace {
+			continue
+		}
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if ref.Name == svc.Name {
+					gc.enqueueRouteParents(route.Kind, objs[gatewayRouteKey(route)])
+				}
+			}
+		}
+	}
+}
+
+func toGatewayRoute(kind string, u *unstructured.Unstructured) (*gatewayRoute, error) {
+	route := &gatewayRoute{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, route); err != nil {
+		return nil, err
+	}
+	route.Kind = kind
+	return route, nil
+}
+
+// listGatewayRoutes returns the routes of all the served kinds along with
+// their objects by route key.
+func (gc *GatewayController) listGatewayRoutes() ([]*gatewayRoute, map[string]*unstructured.Unstructured, error) {
+	var routes []*gatewayRoute
+	objs := make(map[string]*unstructured.Unstructured)
+	for kind, informer := range gc.routeInformers {
+		list, err := informer.Lister().List(labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, obj := range list {
+			u := obj.(*unstructured.Unstructured)
+			route, err := toGatewayRoute(kind, u)
+			if err != nil {
+				gc.logger.With(zap.Error(err)).Warnf("failed to decode %s %s/%s", kind, u.GetNamespace(), u.GetName())
+				continue
+			}
+			routes = append(routes, route)
+			objs[gatewayRouteKey(route)] = u
+		}
+	}
+	return routes, objs, nil
+}
+
+// getGatewayClass returns the GatewayClass of a name and whether its Gateways
+// are implemented by this controller.
+func (gc *GatewayController) getGatewayClass(name string) (*gatewayClass, *unstructured.Unstructured, bool, error) {
+	obj, err := gc.gatewayClassInformer.Lister().Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil, false, nil
+	} else if err != nil {
+		return nil, nil, false, err
+	}
+	u := obj.(*unstructured.Unstructured)
+	class := &gatewayClass{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, class); err != nil {
+		return nil, nil, false, err
+	}
+	return class, u, class.Spec.ControllerName == GatewayControllerName, nil
+}
+
+// getGatewayClassParameters returns the service annotations standing for the
+// parameters of a GatewayClass, held by the ConfigMap its parametersRef refers
+// to.
+func (gc *GatewayController) getGatewayClassParameters(ctx context.Context, class *gatewayClass) (map[string]string, error) {
+	annotations := make(map[string]string)
+	ref := class.Spec.ParametersRef
+	if ref == nil {
+		return annotations, nil
+	}
+	if ref.Group != "" || ref.Kind != configMapKind || ref.Namespace == nil {
+		return nil, errors.Errorf("parametersRef must refer to a ConfigMap by namespace and name")
+	}
+
+	cm, err := gc.kubeClient.CoreV1().ConfigMaps(*ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting parameters ConfigMap %s/%s", *ref.Namespace, ref.Name)
+	}
+	for k, v := range cm.Data {
+		annotation, ok := gatewayClassParameters[k]
+		if !ok {
+			return nil, errors.Errorf("unknown parameter %q in ConfigMap %s/%s", k, *ref.Namespace, ref.Name)
+		}
+		annotations[annotation] = v
+	}
+	return annotations, nil
+}
+
+func (gc *GatewayController) syncGatewayClass(name string) error {
+	ctx := context.Background()
+	class, obj, managed, err := gc.getGatewayClass(name)
+	if err != nil || !managed {
+		return err
+	}
+
+	accepted := metav1.Condition{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted}
+	if _, err := gc.getGatewayClassParameters(ctx, class); err != nil {
+		accepted.Status, accepted.Reason, accepted.Message = metav1.ConditionFalse, gatewayReasonInvalidParameters, err.Error()
+	}
+	status := gatewayClassStatus{
+		Conditions: mergeGatewayConditions(class.Status.Conditions, []metav1.Condition{withGeneration(accepted, class.Generation)}),
+	}
+	if equality.Semantic.DeepEqual(status, class.Status) {
+		return nil
+	}
+	return gc.updateGatewayStatus(ctx, gatewayClassesResource, obj, &status)
+}
+
+func (gc *GatewayController) syncGateway(key string) error {
+	ctx := context.Background()
+	logger := gc.logger.With("gateway", key)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	obj, err := gc.gatewayInformer.Lister().ByNamespace(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	u := obj.(*unstructured.Unstructured)
+	gw := &gateway{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, gw); err != nil {
+		return err
+	}
+
+	class, _, managed, err := gc.getGatewayClass(gw.Spec.GatewayClassName)
+	if err != nil {
+		return err
+	}
+	hasFinalizer := sets.New(gw.Finalizers...).Has(gatewayFinalizer)
+	if gw.DeletionTimestamp != nil || !managed {
+		if !hasFinalizer {
+			return nil
+		}
+		return gc.deleteGateway(ctx, logger, u, gw, class)
+	}
+	if !hasFinalizer {
+		// The update of the Gateway enqueues it again.
+		u = u.DeepCopy()
+		u.SetFinalizers(append(u.GetFinalizers(), gatewayFinalizer))
+		_, err = gc.dynamicClient.Resource(gatewaysResource).Namespace(namespace).Update(ctx, u, metav1.UpdateOptions{})
+		return err
+	}
+
+	routes, routeObjs, err := gc.listGatewayRoutes()
+	if err != nil {
+		return err
+	}
+	lb := getGatewayLoadBalancer(gw, routes, gc.serviceInformer.Lister())
+
+	accepted := metav1.Condition{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted}
+	var lbStatus *v1.LoadBalancerStatus
+	var lbErr error
+	annotations, err := gc.getGatewayClassParameters(ctx, class)
+	if err != nil {
+		accepted.Status, accepted.Reason, accepted.Message = metav1.ConditionFalse, gatewayReasonInvalidParameters, err.Error()
+	} else {
+		svc := getGatewayService(gw, annotations, lb)
+		compartmentID := getLoadBalancerCompartment(svc, gc.cloud.config.CompartmentID)
+		if u.GetAnnotations()[gatewayAnnotationLoadBalancerCompartment] != compartmentID {
+			// The compartment is recorded before the load balancer is created
+			// in it. The update of the Gateway enqueues it again.
+			u = u.DeepCopy()
+			gwAnnotations := u.GetAnnotations()
+			if gwAnnotations == nil {
+				gwAnnotations = map[string]string{}
+			}
+			gwAnnotations[gatewayAnnotationLoadBalancerCompartment] = compartmentID
+			u.SetAnnotations(gwAnnotations)
+			_, err = gc.dynamicClient.Resource(gatewaysResource).Namespace(namespace).Update(ctx, u, metav1.UpdateOptions{})
+			return err
+		}
+		lbStatus, lbErr = gc.ensureGatewayLoadBalancer(ctx, logger, svc, lb)
+		if lbErr != nil {
+			logger.With(zap.Error(lbErr)).Error("Failed to ensure the load balancer of the gateway")
+		}
+	}
+
+	status := getGatewayStatus(gw, lb, lbStatus, accepted, lbErr)
+	var errs []error
+	if lbErr != nil {
+		errs = append(errs, lbErr)
+	}
+	if !equality.Semantic.DeepEqual(status, gw.Status) {
+		if err := gc.updateGatewayStatus(ctx, gatewaysResource, u, &status); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := gc.updateRouteStatuses(ctx, gw, routes, routeObjs, lb.RouteParents); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// deleteGateway deletes the load balancer of a Gateway, removes the status of
+// its routes and finally its finalizer. The load balancer is deleted from the
+// compartment recorded on the Gateway.
+func (gc *GatewayController) deleteGateway(ctx context.Context, logger *zap.SugaredLogger, u *unstructured.Unstructured, gw *gateway, class *gatewayClass) error {
+	annotations := map[string]string{}
+	if class != nil {
+		var err error
+		if annotations, err = gc.getGatewayClassParameters(ctx, class); err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to get the gateway class parameters, deleting the load balancer with the default parameters")
+			annotations = map[string]string{}
+		}
+	}
+	if compartmentID, ok := gw.Annotations[gatewayAnnotationLoadBalancerCompartment]; ok {
+		annotations[util.CompartmentIDAnnotation] = compartmentID
+	}
+	svc := getGatewayService(gw, annotations, &gatewayLoadBalancer{})
+	if err := gc.cloud.EnsureLoadBalancerDeleted(ctx, "", svc); err != nil {
+		return err
+	}
+
+	routes, routeObjs, err := gc.listGatewayRoutes()
+	if err != nil {
+		return err
+	}
+	if err := gc.updateRouteStatuses(ctx, gw, routes, routeObjs, nil); err != nil {
+		return err
+	}
+
+	u = u.DeepCopy()
+	finalizers := sets.New(u.GetFinalizers()...)
+	finalizers.Delete(gatewayFinalizer)
+	u.SetFinalizers(sets.List(finalizers))
+	_, err = gc.dynamicClient.Resource(gatewaysResource).Namespace(gw.Namespace).Update(ctx, u, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// getGatewayNodes returns the nodes the backends of the load balancer of a
+// Gateway run on.
+func (gc *GatewayController) getGatewayNodes(svc *v1.Service) ([]*v1.Node, error) {
+	nodes, err := gc.nodeInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*v1.Node
+	for _, node := range nodes {
+		if _, exclude := node.Labels[v1.LabelNodeExcludeBalancers]; !exclude {
+			candidates = append(candidates, node)
+		}
+	}
+	return filterNodes(svc, candidates)
+}
+
+// ensureGatewayLoadBalancer creates or updates the load balancer of a Gateway
+// from the load balancer spec of its service, whose listeners are replaced by
+// the listeners and routing policies of the Gateway. Gateways are synced one
+// at a time so unlike services their load balancers are not locked.
+func (gc *GatewayController) ensureGatewayLoadBalancer(ctx context.Context, logger *zap.SugaredLogger, svc *v1.Service, gatewayLB *gatewayLoadBalancer) (*v1.LoadBalancerStatus, error) {
+	cp := gc.cloud
+	lbName := GetLoadBalancerName(svc)
+	logger = logger.With("loadBalancerName", lbName)
+
+	nodes, err := gc.getGatewayNodes(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	lbProvider, err := cp.getLoadBalancerProvider(ctx, svc)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to get Load Balancer Client.")
+	}
+	lb, err := lbProvider.lbClient.GetLoadBalancerByName(ctx, getLoadBalancerCompartment(svc, cp.config.CompartmentID), lbName)
+	if err != nil && !client.IsNotFound(err) {
+		return nil, err
+	}
+	lbExists := !client.IsNotFound(err)
+	if lbExists {
+		if err = cp.checkPendingLBWorkRequests(ctx, logger, lbProvider, lb, svc, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	var sslConfig *SSLConfig
+	if gatewayLB.Certificate != "" {
+		sslConfig = NewSSLConfig(gatewayLB.Certificate, "", svc, gatewayLB.SSLPorts, cp)
+	}
+	lbSubnetIds, err := cp.getLoadBalancerSubnets(ctx, logger, svc)
+	if err != nil {
+		return nil, err
+	}
+	lbSubnets, err := getSubnets(ctx, lbSubnetIds, cp.client.Networking(nil))
+	if err != nil {
+		return nil, err
+	}
+	nodeSubnets, err := getSubnetsForNodes(ctx, nodes, cp.client)
+	if err != nil {
+		return nil, err
+	}
+	ipVersions, err := cp.getOciIpVersions(lbSubnets, nodeSubnets, svc)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := NewLBSpec(logger, svc, nodes, lbSubnetIds, sslConfig, cp.securityListManagerFactory, ipVersions, cp.config.Tags, lb, cp.config.CompartmentID)
+	if err != nil {
+		return nil, err
+	}
+	applyGatewayLoadBalancer(spec, gatewayLB)
+
+	if !lbExists {
+		lbStatus, _, err := lbProvider.createLoadBalancer(ctx, spec)
+		return lbStatus, err
+	}
+
+	if lb.LifecycleState == nil || *lb.LifecycleState != lbLifecycleStateActive {
+		return nil, errors.Errorf("rejecting request to update LB which is not in %s state", lbLifecycleStateActive)
+	}
+	if spec, err = updateSpecWithLbSubnets(spec, lb.SubnetIds); err != nil {
+		return nil, err
+	}
+	if sslConfig != nil {
+		if err := lbProvider.ensureSSLCertificates(ctx, lb, spec); err != nil {
+			return nil, errors.Wrap(err, "ensuring ssl certificates")
+		}
+	}
+	if isNetworkPartition, err := cp.checkForNetworkPartition(logger, nodes); err != nil {
+		return nil, err
+	} else if isNetworkPartition {
+		return nil, errors.New("all the backend nodes of the load balancer are not ready")
+	}
+	if err := lbProvider.updateLoadBalancer(ctx, lb, spec); err != nil {
+		return nil, err
+	}
+	return loadBalancerToStatus(lb, spec.ingressIpMode, false, logger)
+}
+
+// getGatewayStatus returns the status of a Gateway, Programmed once its load
+// balancer has been ensured.
+func getGatewayStatus(gw *gateway, lb *gatewayLoadBalancer, lbStatus *v1.LoadBalancerStatus, accepted metav1.Condition, lbErr error) gatewayStatus {
+	programmed := metav1.Condition{Type: gatewayConditionProgrammed, Status: metav1.ConditionTrue, Reason: gatewayReasonProgrammed}
+	if accepted.Status != metav1.ConditionTrue {
+		programmed.Status, programmed.Reason, programmed.Message = metav1.ConditionFalse, gatewayReasonInvalid, accepted.Message
+	} else if lbErr != nil {
+		programmed.Status, programmed.Reason, programmed.Message = metav1.ConditionFalse, gatewayReasonPending, lbErr.Error()
+	}
+
+	status := gatewayStatus{
+		Addresses: gw.Status.Addresses,
+		Conditions: mergeGatewayConditions(gw.Status.Conditions, []metav1.Condition{
+			withGeneration(accepted, gw.Generation),
+			withGeneration(programmed, gw.Generation),
+		}),
+	}
+	if lbStatus != nil {
+		status.Addresses = nil
+		for _, ingress := range lbStatus.Ingress {
+			if ingress.IP != "" {
+				status.Addresses = append(status.Addresses, gatewayAddress{Type: common.String("IPAddress"), Value: ingress.IP})
+			} else if ingress.Hostname != "" {
+				status.Addresses = append(status.Addresses, gatewayAddress{Type: common.String("Hostname"), Value: ingress.Hostname})
+			}
+		}
+	}
+
+	existing := make(map[string]gatewayListenerStatus)
+	for _, l := range gw.Status.Listeners {
+		existing[l.Name] = l
+	}
+	for _, l := range lb.ListenerStatuses {
+		listenerProgrammed := programmed
+		for _, c := range l.Conditions {
+			// Listeners are invalid unless accepted, resolved and not conflicted.
+			invalid := c.Status != metav1.ConditionTrue
+			if c.Type == gatewayConditionConflicted {
+				invalid = c.Status != metav1.ConditionFalse
+			}
+			if invalid {
+				listenerProgrammed.Status, listenerProgrammed.Reason, listenerProgrammed.Message = metav1.ConditionFalse, gatewayReasonInvalid, c.Message
+			}
+		}
+		l.Conditions = mergeGatewayConditions(existing[l.Name].Conditions, append(l.Conditions, withGeneration(listenerProgrammed, gw.Generation)))
+		status.Listeners = append(status.Listeners, l)
+	}
+	return status
+}
+
+// mergeGatewayConditions sets the desired conditions on a copy of the existing
+// ones, keeping the transition time of the conditions whose status is
+// unchanged.
+func mergeGatewayConditions(existing, desired []metav1.Condition) []metav1.Condition {
+	conditions := make([]metav1.Condition, len(existing))
+	copy(conditions, existing)
+	for _, c := range desired {
+		meta.SetStatusCondition(&conditions, c)
+	}
+	return conditions
+}
+
+// getGatewayRouteParents returns the parent statuses of a route with the ones
+// of this controller for the Gateway replaced by the desired ones.
+func getGatewayRouteParents(route *gatewayRoute, gw *gateway, desired []gatewayRouteParentStatus) []gatewayRouteParentStatus {
+	parents := []gatewayRouteParentStatus{}
+	existing := make(map[string][]metav1.Condition)
+	for _, p := range route.Status.Parents {
+		if p.ControllerName == GatewayControllerName && isGatewayParentRef(route, p.ParentRef, gw) {
+			existing[fmt.Sprintf("%+v", p.ParentRef)] = p.Conditions
+			continue
+		}
+		parents = append(parents, p)
+	}
+	for _, p := range desired {
+		p.Conditions = mergeGatewayConditions(existing[fmt.Sprintf("%+v", p.ParentRef)], p.Conditions)
+		parents = append(parents, p)
+	}
+	return parents
+}
+
+// updateRouteStatuses updates the status of the routes whose parent statuses
+// for the Gateway differ from the desired ones, by route key.
+func (gc *GatewayController) updateRouteStatuses(ctx context.Context, gw *gateway, routes []*gatewayRoute, objs map[string]*unstructured.Unstructured, desired map[string][]gatewayRouteParentStatus) error {
+	var errs []error
+	for _, route := range routes {
+		key := gatewayRouteKey(route)
+		status := gatewayRouteStatus{Parents: getGatewayRouteParents(route, gw, desired[key])}
+		if len(status.Parents) == len(route.Status.Parents) && equality.Semantic.DeepEqual(status.Parents, route.Status.Parents) {
+			continue
+		}
+		if err := gc.updateGatewayStatus(ctx, gatewayRouteResources[route.Kind], objs[key], &status); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// updateGatewayStatus replaces the status of a Gateway API object by a
+// pointer to its new status.
+func (gc *GatewayController) updateGatewayStatus(ctx context.Context, resource schema.GroupVersionResource, obj *unstructured.Unstructured, status interface{}) error {
+	statusObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return err
+	}
+	u := obj.DeepCopy()
+	u.Object["status"] = statusObj
+	if u.GetNamespace() == "" {
+		_, err = gc.dynamicClient.Resource(resource).UpdateStatus(ctx, u, metav1.UpdateOptions{})
+	} else {
+		_, err = gc.dynamicClient.Resource(resource).Namespace(u.GetNamespace()).UpdateStatus(ctx, u, metav1.UpdateOptions{})
+	}
+	return err
+}
@@ -0,0 +1,341 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"go.uber.org/zap"
+	authv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	testclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func Test_getGatewayStatus(t *testing.T) {
+	accepted := metav1.Condition{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted}
+	lb := &gatewayLoadBalancer{ListenerStatuses: []gatewayListenerStatus{
+		{Name: "http", Conditions: []metav1.Condition{
+			{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted},
+			{Type: gatewayConditionConflicted, Status: metav1.ConditionFalse, Reason: gatewayReasonNoConflicts},
+		}},
+		{Name: "tcp", Conditions: []metav1.Condition{
+			{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted},
+			{Type: gatewayConditionConflicted, Status: metav1.ConditionTrue, Reason: gatewayReasonProtocolConflict},
+		}},
+	}}
+
+	testCases := map[string]struct {
+		lbStatus           *v1.LoadBalancerStatus
+		lbErr              error
+		addresses          []string
+		programmed         string
+		listenerProgrammed []metav1.ConditionStatus
+	}{
+		"programmed": {
+			lbStatus:           &v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "10.0.0.10"}}},
+			addresses:          []string{"10.0.0.10"},
+			programmed:         gatewayReasonProgrammed,
+			listenerProgrammed: []metav1.ConditionStatus{metav1.ConditionTrue, metav1.ConditionFalse},
+		},
+		"load balancer pending": {
+			lbErr:              errors.New("work request in progress"),
+			addresses:          []string{"10.0.0.1"},
+			programmed:         gatewayReasonPending,
+			listenerProgrammed: []metav1.ConditionStatus{metav1.ConditionFalse, metav1.ConditionFalse},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gw := &gateway{Status: gatewayStatus{Addresses: []gatewayAddress{{Value: "10.0.0.1"}}}}
+			status := getGatewayStatus(gw, lb, tc.lbStatus, accepted, tc.lbErr)
+
+			var addresses []string
+			for _, a := range status.Addresses {
+				addresses = append(addresses, a.Value)
+			}
+			if !reflect.DeepEqual(addresses, tc.addresses) {
+				t.Errorf("Expected addresses %v but got %v", tc.addresses, addresses)
+			}
+			if c := meta.FindStatusCondition(status.Conditions, gatewayConditionProgrammed); c == nil || c.Reason != tc.programmed {
+				t.Errorf("Expected Programmed reason %s but got %+v", tc.programmed, c)
+			}
+			var listenerProgrammed []metav1.ConditionStatus
+			for _, l := range status.Listeners {
+				listenerProgrammed = append(listenerProgrammed, meta.FindStatusCondition(l.Conditions, gatewayConditionProgrammed).Status)
+			}
+			if !reflect.DeepEqual(listenerProgrammed, tc.listenerProgrammed) {
+				t.Errorf("Expected listeners programmed %v but got %v", tc.listenerProgrammed, listenerProgrammed)
+			}
+		})
+	}
+}
+
+func Test_getGatewayRouteParents(t *testing.T) {
+	gw := &gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"}}
+	transitionTime := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	otherParent := gatewayRouteParentStatus{
+		ParentRef:      gatewayParentReference{Name: "other"},
+		ControllerName: "example.com/gateway-controller",
+	}
+	route := &gatewayRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Status: gatewayRouteStatus{Parents: []gatewayRouteParentStatus{
+			otherParent,
+			{
+				ParentRef:      gatewayParentReference{Name: "gw"},
+				ControllerName: GatewayControllerName,
+				Conditions: []metav1.Condition{
+					{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted, LastTransitionTime: transitionTime},
+				},
+			},
+		}},
+	}
+	desired := []gatewayRouteParentStatus{{
+		ParentRef:      gatewayParentReference{Name: "gw"},
+		ControllerName: GatewayControllerName,
+		Conditions: []metav1.Condition{
+			{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted},
+		},
+	}}
+
+	parents := getGatewayRouteParents(route, gw, desired)
+	if len(parents) != 2 || !reflect.DeepEqual(parents[0], otherParent) {
+		t.Fatalf("Expected the parents of other controllers to be kept but got %+v", parents)
+	}
+	if c := parents[1].Conditions[0]; !c.LastTransitionTime.Equal(&transitionTime) {
+		t.Errorf("Expected the transition time of an unchanged condition to be kept but got %v", c.LastTransitionTime)
+	}
+
+	if parents := getGatewayRouteParents(route, gw, nil); !reflect.DeepEqual(parents, []gatewayRouteParentStatus{otherParent}) {
+		t.Errorf("Expected the parents of the Gateway to be removed but got %+v", parents)
+	}
+}
+
+// gatewayTestOCIClient records the compartment the load balancers are looked
+// up in, none of which exists.
+type gatewayTestOCIClient struct {
+	MockOCIClient
+	lbClient *gatewayTestLoadBalancerClient
+}
+
+func (c gatewayTestOCIClient) LoadBalancer(*zap.SugaredLogger, string, string, *authv1.TokenRequest) client.GenericLoadBalancerInterface {
+	return c.lbClient
+}
+
+type gatewayTestLoadBalancerClient struct {
+	MockLoadBalancerClient
+	compartmentIDs []string
+}
+
+func (c *gatewayTestLoadBalancerClient) GetLoadBalancerByName(ctx context.Context, compartmentID string, name string) (*client.GenericLoadBalancer, error) {
+	c.compartmentIDs = append(c.compartmentIDs, compartmentID)
+	return nil, mockServiceError{StatusCode: http.StatusNotFound, Code: "NotAuthorizedOrNotFound", Message: "load balancer not found"}
+}
+
+func newGatewayTestObject(resource schema.GroupVersionResource, kind string, obj interface{}) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		panic(err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(resource.GroupVersion().String())
+	u.SetKind(kind)
+	return u
+}
+
+// newGatewayTestController returns a GatewayController over a fake dynamic
+// client holding the given GatewayClasses and Gateways, whose informers are
+// filled with them without being started.
+func newGatewayTestController(t *testing.T, lbClient *gatewayTestLoadBalancerClient, kubeObjs []runtime.Object, objs ...*unstructured.Unstructured) (*GatewayController, *dynamicfake.FakeDynamicClient) {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		gatewayClassesResource: "GatewayClassList",
+		gatewaysResource:       "GatewayList",
+	})
+	kubeClient := testclient.NewSimpleClientset(kubeObjs...)
+	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 0)
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	gc := &GatewayController{
+		dynamicClient:        dynamicClient,
+		kubeClient:           kubeClient,
+		informerFactory:      informerFactory,
+		gatewayClassInformer: informerFactory.ForResource(gatewayClassesResource),
+		gatewayInformer:      informerFactory.ForResource(gatewaysResource),
+		routeInformers:       map[string]informers.GenericInformer{},
+		nodeInformer:         kubeInformerFactory.Core().V1().Nodes(),
+		serviceInformer:      kubeInformerFactory.Core().V1().Services(),
+		cloud: &CloudProvider{
+			client:        gatewayTestOCIClient{lbClient: lbClient},
+			config:        &providercfg.Config{CompartmentID: "cluster-compartment"},
+			logger:        zap.S(),
+			instanceCache: &mockInstanceCache{},
+			kubeclient:    kubeClient,
+			lbLocks:       NewLoadBalancerLocks(),
+		},
+		queue:  workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		logger: zap.S(),
+	}
+	for _, obj := range objs {
+		// The resources are given as the fake client guesses them wrong from
+		// the kinds.
+		resource, informer := gatewaysResource, gc.gatewayInformer
+		if obj.GetKind() == "GatewayClass" {
+			resource, informer = gatewayClassesResource, gc.gatewayClassInformer
+		}
+		if err := dynamicClient.Tracker().Create(resource, obj, obj.GetNamespace()); err != nil {
+			t.Fatal(err)
+		}
+		if err := informer.Informer().GetIndexer().Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gc, dynamicClient
+}
+
+func TestGatewayController_syncGateway(t *testing.T) {
+	namespace := "kube-system"
+	parametersRef := &gatewayParametersReference{Kind: configMapKind, Name: "gateway-parameters", Namespace: &namespace}
+	parameters := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "gateway-parameters"},
+		Data:       map[string]string{"compartment": "class-compartment"},
+	}
+	newClass := func(ref *gatewayParametersReference) *unstructured.Unstructured {
+		return newGatewayTestObject(gatewayClassesResource, "GatewayClass", &gatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "oci"},
+			Spec:       gatewayClassSpec{ControllerName: GatewayControllerName, ParametersRef: ref},
+		})
+	}
+	newGateway := func(finalizers []string, annotations map[string]string, deleted bool) *unstructured.Unstructured {
+		gw := &gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        "gw",
+				UID:         "gw-uid",
+				Finalizers:  finalizers,
+				Annotations: annotations,
+			},
+			Spec: gatewaySpec{
+				GatewayClassName: "oci",
+				Listeners:        []gatewayListener{{Name: "http", Port: 80, Protocol: "HTTP"}},
+			},
+		}
+		if deleted {
+			deletionTimestamp := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+			gw.DeletionTimestamp = &deletionTimestamp
+		}
+		return newGatewayTestObject(gatewaysResource, "Gateway", gw)
+	}
+	recorded := map[string]string{gatewayAnnotationLoadBalancerCompartment: "recorded-compartment"}
+
+	tests := []struct {
+		name                   string
+		class                  *unstructured.Unstructured
+		gateway                *unstructured.Unstructured
+		wantFinalizers         []string
+		wantCompartment        string
+		wantAccepted           *metav1.Condition
+		wantLookupCompartments []string
+	}{
+		{
+			name:           "adds the finalizer",
+			class:          newClass(nil),
+			gateway:        newGateway(nil, nil, false),
+			wantFinalizers: []string{gatewayFinalizer},
+		},
+		{
+			name:            "records the compartment of the load balancer",
+			class:           newClass(parametersRef),
+			gateway:         newGateway([]string{gatewayFinalizer}, nil, false),
+			wantFinalizers:  []string{gatewayFinalizer},
+			wantCompartment: "class-compartment",
+		},
+		{
+			name:            "updates the status with invalid parameters",
+			class:           newClass(&gatewayParametersReference{Kind: configMapKind, Name: "missing", Namespace: &namespace}),
+			gateway:         newGateway([]string{gatewayFinalizer}, recorded, false),
+			wantFinalizers:  []string{gatewayFinalizer},
+			wantCompartment: "recorded-compartment",
+			wantAccepted:    &metav1.Condition{Type: gatewayConditionAccepted, Status: metav1.ConditionFalse, Reason: gatewayReasonInvalidParameters},
+		},
+		{
+			name:                   "deletes the load balancer from the recorded compartment",
+			class:                  newClass(parametersRef),
+			gateway:                newGateway([]string{gatewayFinalizer}, recorded, true),
+			wantCompartment:        "recorded-compartment",
+			wantLookupCompartments: []string{"recorded-compartment"},
+		},
+		{
+			name:                   "deletes the load balancer once the gateway class is gone",
+			gateway:                newGateway([]string{gatewayFinalizer}, recorded, true),
+			wantCompartment:        "recorded-compartment",
+			wantLookupCompartments: []string{"recorded-compartment"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []*unstructured.Unstructured{tt.gateway}
+			if tt.class != nil {
+				objs = append(objs, tt.class)
+			}
+			lbClient := &gatewayTestLoadBalancerClient{}
+			gc, dynamicClient := newGatewayTestController(t, lbClient, []runtime.Object{parameters}, objs...)
+
+			if err := gc.syncGateway("default/gw"); err != nil {
+				t.Fatalf("syncGateway() error = %v", err)
+			}
+
+			u, err := dynamicClient.Resource(gatewaysResource).Namespace("default").Get(context.Background(), "gw", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			gw := &gateway{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, gw); err != nil {
+				t.Fatal(err)
+			}
+			if !equality.Semantic.DeepEqual(gw.Finalizers, tt.wantFinalizers) {
+				t.Errorf("Expected finalizers %v but got %v", tt.wantFinalizers, gw.Finalizers)
+			}
+			if compartmentID := gw.Annotations[gatewayAnnotationLoadBalancerCompartment]; compartmentID != tt.wantCompartment {
+				t.Errorf("Expected the recorded compartment %q but got %q", tt.wantCompartment, compartmentID)
+			}
+			accepted := meta.FindStatusCondition(gw.Status.Conditions, gatewayConditionAccepted)
+			if tt.wantAccepted == nil && accepted != nil {
+				t.Errorf("Expected no status but got %+v", gw.Status)
+			} else if tt.wantAccepted != nil && (accepted == nil || accepted.Status != tt.wantAccepted.Status || accepted.Reason != tt.wantAccepted.Reason) {
+				t.Errorf("Expected the Accepted condition %+v but got %+v", tt.wantAccepted, accepted)
+			}
+			if !reflect.DeepEqual(lbClient.compartmentIDs, tt.wantLookupCompartments) {
+				t.Errorf("Expected the load balancer to be looked up in %v but got %v", tt.wantLookupCompartments, lbClient.compartmentIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,939 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// GatewayControllerName is the controller name of the GatewayClasses whose
+	// Gateways are implemented by OCI load balancers.
+	GatewayControllerName = "oci.oraclecloud.com/gateway-controller"
+
+	// gatewayFinalizer guards the deletion of the load balancer of a Gateway.
+	gatewayFinalizer = "oci.oraclecloud.com/gateway-load-balancer"
+
+	// gatewayAnnotationLoadBalancerCompartment records the compartment of the
+	// load balancer of a Gateway, which is deleted from it even once the
+	// GatewayClass or its parameters are gone.
+	gatewayAnnotationLoadBalancerCompartment = "oci.oraclecloud.com/gateway-load-balancer-compartment-id"
+
+	gatewayAPIGroup = "gateway.networking.k8s.io"
+
+	gatewayKind      = "Gateway"
+	httpRouteKind    = "HTTPRoute"
+	tlsRouteKind     = "TLSRoute"
+	tcpRouteKind     = "TCPRoute"
+	serviceKind      = "Service"
+	secretKind       = "Secret"
+	configMapKind    = "ConfigMap"
+	gatewayProtoHTTP = "HTTP"
+	gatewayProtoTLS  = "TLS"
+	gatewayProtoTCP  = "TCP"
+	gatewayProtoSSL  = "HTTPS"
+
+	gatewayTLSModeTerminate   = "Terminate"
+	gatewayTLSModePassthrough = "Passthrough"
+
+	gatewayPathMatchExact   = "Exact"
+	gatewayPathMatchPrefix  = "PathPrefix"
+	gatewayHeaderMatchExact = "Exact"
+
+	gatewayNamespacesFromAll  = "All"
+	gatewayNamespacesFromSame = "Same"
+
+	// Condition types and reasons of the Gateway API.
+	gatewayConditionAccepted     = "Accepted"
+	gatewayConditionProgrammed   = "Programmed"
+	gatewayConditionResolvedRefs = "ResolvedRefs"
+	gatewayConditionConflicted   = "Conflicted"
+
+	gatewayReasonAccepted              = "Accepted"
+	gatewayReasonProgrammed            = "Programmed"
+	gatewayReasonResolvedRefs          = "ResolvedRefs"
+	gatewayReasonNoConflicts           = "NoConflicts"
+	gatewayReasonInvalid               = "Invalid"
+	gatewayReasonInvalidParameters     = "InvalidParameters"
+	gatewayReasonPending               = "Pending"
+	gatewayReasonUnsupportedProtocol   = "UnsupportedProtocol"
+	gatewayReasonUnsupportedValue      = "UnsupportedValue"
+	gatewayReasonProtocolConflict      = "ProtocolConflict"
+	gatewayReasonHostnameConflict      = "HostnameConflict"
+	gatewayReasonInvalidCertificateRef = "InvalidCertificateRef"
+	gatewayReasonInvalidRouteKinds     = "InvalidRouteKinds"
+	gatewayReasonRefNotPermitted       = "RefNotPermitted"
+	gatewayReasonInvalidKind           = "InvalidKind"
+	gatewayReasonBackendNotFound       = "BackendNotFound"
+	gatewayReasonNoMatchingParent      = "NoMatchingParent"
+	gatewayReasonNotAllowedByListeners = "NotAllowedByListeners"
+)
+
+var (
+	gatewayClassesResource = schema.GroupVersionResource{Group: gatewayAPIGroup, Version: "v1", Resource: "gatewayclasses"}
+	gatewaysResource       = schema.GroupVersionResource{Group: gatewayAPIGroup, Version: "v1", Resource: "gateways"}
+	httpRoutesResource     = schema.GroupVersionResource{Group: gatewayAPIGroup, Version: "v1", Resource: "httproutes"}
+	tlsRoutesResource      = schema.GroupVersionResource{Group: gatewayAPIGroup, Version: "v1alpha2", Resource: "tlsroutes"}
+	tcpRoutesResource      = schema.GroupVersionResource{Group: gatewayAPIGroup, Version: "v1alpha2", Resource: "tcproutes"}
+)
+
+// gatewayClassParameters maps the keys of the ConfigMap referenced by the
+// parametersRef of a GatewayClass to the service annotations they stand for.
+var gatewayClassParameters = map[string]string{
+	"shape":                      ServiceAnnotationLoadBalancerShape,
+	"flexMinMbps":                ServiceAnnotationLoadBalancerShapeFlexMin,
+	"flexMaxMbps":                ServiceAnnotationLoadBalancerShapeFlexMax,
+	"subnet1":                    ServiceAnnotationLoadBalancerSubnet1,
+	"subnet2":                    ServiceAnnotationLoadBalancerSubnet2,
+	"internal":                   ServiceAnnotationLoadBalancerInternal,
+	"networkSecurityGroups":      ServiceAnnotationLoadBalancerNetworkSecurityGroups,
+	"securityListManagementMode": ServiceAnnotationLoadBalancerSecurityListManagementMode,
+	"policy":                     ServiceAnnotationLoadBalancerPolicy,
+	"connectionIdleTimeout":      ServiceAnnotationLoadBalancerConnectionIdleTimeout,
+	"compartment":                util.CompartmentIDAnnotation,
+}
+
+// The Gateway API resources are read and written through the dynamic client
+// so only the fields used by the gateway controller are modelled below.
+
+type gatewayParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type gatewayObjectReference struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+type gatewayBackendRef struct {
+	gatewayObjectReference `json:",inline"`
+	Port                   *int32               `json:"port,omitempty"`
+	Weight                 *int32               `json:"weight,omitempty"`
+	Filters                []gatewayRouteFilter `json:"filters,omitempty"`
+}
+
+type gatewayRouteFilter struct {
+	Type string `json:"type"`
+}
+
+type gatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              gatewayClassSpec   `json:"spec"`
+	Status            gatewayClassStatus `json:"status,omitempty"`
+}
+
+type gatewayClassSpec struct {
+	ControllerName string                      `json:"controllerName"`
+	ParametersRef  *gatewayParametersReference `json:"parametersRef,omitempty"`
+}
+
+type gatewayParametersReference struct {
+	Group     string  `json:"group"`
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+type gatewayClassStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              gatewaySpec   `json:"spec"`
+	Status            gatewayStatus `json:"status,omitempty"`
+}
+
+type gatewaySpec struct {
+	GatewayClassName string            `json:"gatewayClassName"`
+	Listeners        []gatewayListener `json:"listeners"`
+	Addresses        []gatewayAddress  `json:"addresses,omitempty"`
+}
+
+type gatewayListener struct {
+	Name          string                `json:"name"`
+	Hostname      *string               `json:"hostname,omitempty"`
+	Port          int32                 `json:"port"`
+	Protocol      string                `json:"protocol"`
+	TLS           *gatewayTLSConfig     `json:"tls,omitempty"`
+	AllowedRoutes *gatewayAllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+type gatewayTLSConfig struct {
+	Mode            *string                  `json:"mode,omitempty"`
+	CertificateRefs []gatewayObjectReference `json:"certificateRefs,omitempty"`
+}
+
+type gatewayAllowedRoutes struct {
+	Namespaces *gatewayRouteNamespaces `json:"namespaces,omitempty"`
+	Kinds      []gatewayRouteGroupKind `json:"kinds,omitempty"`
+}
+
+type gatewayRouteNamespaces struct {
+	From *string `json:"from,omitempty"`
+}
+
+type gatewayRouteGroupKind struct {
+	Group *string `json:"group,omitempty"`
+	Kind  string  `json:"kind"`
+}
+
+type gatewayAddress struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+type gatewayStatus struct {
+	Addresses  []gatewayAddress        `json:"addresses,omitempty"`
+	Conditions []metav1.Condition      `json:"conditions,omitempty"`
+	Listeners  []gatewayListenerStatus `json:"listeners,omitempty"`
+}
+
+type gatewayListenerStatus struct {
+	Name           string                  `json:"name"`
+	SupportedKinds []gatewayRouteGroupKind `json:"supportedKinds"`
+	AttachedRoutes int32                   `json:"attachedRoutes"`
+	Conditions     []metav1.Condition      `json:"conditions"`
+}
+
+// gatewayRoute is an HTTPRoute, a TLSRoute or a TCPRoute, told apart by its
+// kind. The matches and filters of the rules are only set for HTTPRoutes.
+type gatewayRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              gatewayRouteSpec   `json:"spec"`
+	Status            gatewayRouteStatus `json:"status,omitempty"`
+}
+
+type gatewayRouteSpec struct {
+	ParentRefs []gatewayParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string                 `json:"hostnames,omitempty"`
+	Rules      []gatewayRouteRule       `json:"rules,omitempty"`
+}
+
+type gatewayRouteRule struct {
+	Matches     []gatewayHTTPRouteMatch `json:"matches,omitempty"`
+	Filters     []gatewayRouteFilter    `json:"filters,omitempty"`
+	BackendRefs []gatewayBackendRef     `json:"backendRefs,omitempty"`
+}
+
+type gatewayHTTPRouteMatch struct {
+	Path        *gatewayHTTPPathMatch    `json:"path,omitempty"`
+	Headers     []gatewayHTTPHeaderMatch `json:"headers,omitempty"`
+	QueryParams []gatewayHTTPHeaderMatch `json:"queryParams,omitempty"`
+	Method      *string                  `json:"method,omitempty"`
+}
+
+type gatewayHTTPPathMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type gatewayHTTPHeaderMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Name  string  `json:"name"`
+	Value string  `json:"value"`
+}
+
+type gatewayRouteStatus struct {
+	Parents []gatewayRouteParentStatus `json:"parents"`
+}
+
+type gatewayRouteParentStatus struct {
+	ParentRef      gatewayParentReference `json:"parentRef"`
+	ControllerName string                 `json:"controllerName"`
+	Conditions     []metav1.Condition     `json:"conditions,omitempty"`
+}
+
+// gatewayLoadBalancer is the load balancer configuration derived from a
+// Gateway and its routes, along with the status of its listeners and routes.
+type gatewayLoadBalancer struct {
+	Listeners       map[string]client.GenericListener
+	RoutingPolicies map[string]loadbalancer.RoutingPolicyDetails
+	// Backends maps the node port of every backend of the routes to the port
+	// of a listener forwarding to it.
+	Backends map[int32]int
+	// Certificate is the namespace/name of the secret holding the certificate
+	// of the HTTPS listeners and SSLPorts the ports of these listeners.
+	Certificate string
+	SSLPorts    []int
+
+	ListenerStatuses []gatewayListenerStatus
+	// RouteParents holds the status of the parent references to the Gateway
+	// of every route, by route key.
+	RouteParents map[string][]gatewayRouteParentStatus
+}
+
+// gatewayRoutingRule is a routing rule of an HTTP listener before it is
+// ordered by precedence and rendered as an OCI routing policy rule.
+type gatewayRoutingRule struct {
+	hostnames []string
+	match     gatewayHTTPRouteMatch
+	nodePort  int32
+	route     *gatewayRoute
+	index     int
+}
+
+// gatewayPort is the OCI load balancer listener serving the Gateway
+// listeners of one port, which share their protocol.
+type gatewayPort struct {
+	protocol    string
+	listeners   []int
+	rules       []gatewayRoutingRule
+	tcpBackends []int32
+}
+
+// gatewayRouteKey returns the key of a route, unique across route kinds.
+func gatewayRouteKey(route *gatewayRoute) string {
+	return fmt.Sprintf("%s/%s/%s", route.Kind, route.Namespace, route.Name)
+}
+
+// getGatewayRoutingPolicyName returns the name of the routing policy of the
+// HTTP listener of a port.
+func getGatewayRoutingPolicyName(port int) string {
+	return fmt.Sprintf("%s_%d", gatewayProtoHTTP, port)
+}
+
+// getGatewayLoadBalancer derives the listeners, backends and routing
+// policies of the load balancer of a Gateway from the routes attached to it.
+func getGatewayLoadBalancer(gw *gateway, routes []*gatewayRoute, serviceLister corelisters.ServiceLister) *gatewayLoadBalancer {
+	lb := &gatewayLoadBalancer{
+		Listeners:       make(map[string]client.GenericListener),
+		RoutingPolicies: make(map[string]loadbalancer.RoutingPolicyDetails),
+		Backends:        make(map[int32]int),
+		RouteParents:    make(map[string][]gatewayRouteParentStatus),
+	}
+
+	ports := make(map[int32]*gatewayPort)
+	valid := make([]bool, len(gw.Spec.Listeners))
+	for i, l := range gw.Spec.Listeners {
+		status := gatewayListenerStatus{
+			Name:           l.Name,
+			SupportedKinds: getGatewayListenerSupportedKinds(l),
+		}
+		accepted := metav1.Condition{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted}
+		resolvedRefs := metav1.Condition{Type: gatewayConditionResolvedRefs, Status: metav1.ConditionTrue, Reason: gatewayReasonResolvedRefs}
+		conflicted := metav1.Condition{Type: gatewayConditionConflicted, Status: metav1.ConditionFalse, Reason: gatewayReasonNoConflicts}
+
+		if _, err := getGatewayListenerProtocol(l); err != nil {
+			accepted.Status, accepted.Reason, accepted.Message = metav1.ConditionFalse, gatewayReasonUnsupportedProtocol, err.Error()
+		} else if len(status.SupportedKinds) == 0 {
+			resolvedRefs.Status, resolvedRefs.Reason, resolvedRefs.Message = metav1.ConditionFalse, gatewayReasonInvalidRouteKinds, "none of the route kinds of the listener are supported"
+		} else if certificate, err := getGatewayListenerCertificate(gw, l); err != nil {
+			resolvedRefs.Status, resolvedRefs.Reason, resolvedRefs.Message = metav1.ConditionFalse, gatewayReasonInvalidCertificateRef, err.Error()
+		} else if certificate != "" && lb.Certificate != "" && certificate != lb.Certificate {
+			resolvedRefs.Status, resolvedRefs.Reason, resolvedRefs.Message = metav1.ConditionFalse, gatewayReasonInvalidCertificateRef,
+				fmt.Sprintf("the HTTPS listeners of a Gateway must share one certificate, %s is already in use", lb.Certificate)
+		} else if p, ok := ports[l.Port]; ok && p.protocol != l.Protocol {
+			conflicted.Status, conflicted.Reason, conflicted.Message = metav1.ConditionTrue, gatewayReasonProtocolConflict,
+				fmt.Sprintf("port %d is already used by a %s listener", l.Port, p.protocol)
+		} else if ok && (l.Protocol == gatewayProtoTLS || l.Protocol == gatewayProtoTCP) {
+			// TCP listeners can not tell the hostnames of the connections apart.
+			conflicted.Status, conflicted.Reason, conflicted.Message = metav1.ConditionTrue, gatewayReasonHostnameConflict,
+				fmt.Sprintf("port %d is already used by a %s listener", l.Port, p.protocol)
+		} else {
+			if !ok {
+				p = &gatewayPort{protocol: l.Protocol}
+				ports[l.Port] = p
+				if certificate != "" {
+					lb.Certificate = certificate
+					lb.SSLPorts = append(lb.SSLPorts, int(l.Port))
+				}
+			}
+			p.listeners = append(p.listeners, i)
+			valid[i] = true
+		}
+
+		status.Conditions = []metav1.Condition{
+			withGeneration(accepted, gw.Generation),
+			withGeneration(resolvedRefs, gw.Generation),
+			withGeneration(conflicted, gw.Generation),
+		}
+		lb.ListenerStatuses = append(lb.ListenerStatuses, status)
+	}
+
+	sortGatewayRoutes(routes)
+	for _, route := range routes {
+		rules, resolvedRefs, err := resolveGatewayRouteRules(route, serviceLister)
+		for _, parentRef := range route.Spec.ParentRefs {
+			if !isGatewayParentRef(route, parentRef, gw) {
+				continue
+			}
+			accepted := metav1.Condition{Type: gatewayConditionAccepted, Status: metav1.ConditionTrue, Reason: gatewayReasonAccepted}
+			if err != nil {
+				accepted.Status, accepted.Reason, accepted.Message = metav1.ConditionFalse, gatewayReasonUnsupportedValue, err.Error()
+			} else {
+				matched, attached := 0, 0
+				for i, l := range gw.Spec.Listeners {
+					if !isGatewayListenerReferenced(l, parentRef) {
+						continue
+					}
+					matched++
+					hostnames, ok := getGatewayRouteHostnames(l, route)
+					if !valid[i] || !isGatewayRouteAllowed(gw, l, route) || !ok {
+						continue
+					}
+					attached++
+					lb.ListenerStatuses[i].AttachedRoutes++
+					p := ports[l.Port]
+					for _, rule := range rules {
+						if route.Kind == httpRouteKind {
+							rule.hostnames = hostnames
+							p.rules = append(p.rules, rule)
+						} else {
+							p.tcpBackends = append(p.tcpBackends, rule.nodePort)
+						}
+					}
+				}
+				if matched == 0 {
+					accepted.Status, accepted.Reason, accepted.Message = metav1.ConditionFalse, gatewayReasonNoMatchingParent, "no listener of the Gateway matches the parent reference"
+				} else if attached == 0 {
+					accepted.Status, accepted.Reason, accepted.Message = metav1.ConditionFalse, gatewayReasonNotAllowedByListeners, "the route is not allowed by the listeners of the Gateway"
+				}
+			}
+			parentStatus := gatewayRouteParentStatus{
+				ParentRef:      parentRef,
+				ControllerName: GatewayControllerName,
+				Conditions: []metav1.Condition{
+					withGeneration(accepted, route.Generation),
+					withGeneration(resolvedRefs, route.Generation),
+				},
+			}
+			key := gatewayRouteKey(route)
+			lb.RouteParents[key] = append(lb.RouteParents[key], parentStatus)
+		}
+	}
+
+	for port, p := range ports {
+		listenerPort := int(port)
+		var listener client.GenericListener
+		protocol, _ := getGatewayListenerProtocol(gw.Spec.Listeners[p.listeners[0]])
+		switch {
+		case protocol == gatewayProtoHTTP && len(p.rules) > 0:
+			sortGatewayRoutingRules(p.rules)
+			policyName := getGatewayRoutingPolicyName(listenerPort)
+			policy := loadbalancer.RoutingPolicyDetails{}
+			for i, rule := range p.rules {
+				policy.Rules = append(policy.Rules, loadbalancer.RoutingRule{
+					Name:      common.String(fmt.Sprintf("rule_%d", i)),
+					Condition: common.String(getGatewayRoutingRuleCondition(rule)),
+					Actions: []loadbalancer.Action{loadbalancer.ForwardToBackendSet{
+						BackendSetName: common.String(getBackendSetName(string(v1.ProtocolTCP), int(rule.nodePort))),
+					}},
+				})
+				setGatewayBackendListenerPort(lb.Backends, rule.nodePort, listenerPort)
+			}
+			lb.RoutingPolicies[policyName] = policy
+			// Requests matching no rule are sent to the backends of the rule
+			// with the lowest precedence.
+			listener = client.GenericListener{
+				Protocol:              common.String(gatewayProtoHTTP),
+				DefaultBackendSetName: common.String(getBackendSetName(string(v1.ProtocolTCP), int(p.rules[len(p.rules)-1].nodePort))),
+				RoutingPolicyName:     common.String(policyName),
+			}
+			if slices.Contains(lb.SSLPorts, listenerPort) {
+				listener.SslConfiguration, _ = getSSLConfiguration(NewSSLConfig(lb.Certificate, "", nil, lb.SSLPorts, nil), sslCertificates{name: getGatewayCertificateName(lb.Certificate)}, listenerPort, "")
+			}
+		case protocol == gatewayProtoTCP && len(p.tcpBackends) > 0:
+			// TCP listeners can not route, the oldest route is served.
+			nodePort := p.tcpBackends[0]
+			setGatewayBackendListenerPort(lb.Backends, nodePort, listenerPort)
+			listener = client.GenericListener{
+				Protocol:              common.String(gatewayProtoTCP),
+				DefaultBackendSetName: common.String(getBackendSetName(string(v1.ProtocolTCP), int(nodePort))),
+			}
+		default:
+			continue
+		}
+		name := getListenerName(*listener.Protocol, listenerPort)
+		listener.Name = common.String(name)
+		listener.Port = common.Int(listenerPort)
+		lb.Listeners[name] = listener
+	}
+
+	return lb
+}
+
+// setGatewayBackendListenerPort records the lowest port of the listeners
+// forwarding to a backend.
+func setGatewayBackendListenerPort(backends map[int32]int, nodePort int32, listenerPort int) {
+	if p, ok := backends[nodePort]; !ok || listenerPort < p {
+		backends[nodePort] = listenerPort
+	}
+}
+
+func withGeneration(condition metav1.Condition, generation int64) metav1.Condition {
+	condition.ObservedGeneration = generation
+	return condition
+}
+
+// getGatewayListenerProtocol returns the protocol of the OCI load balancer
+// listener of a Gateway listener.
+func getGatewayListenerProtocol(l gatewayListener) (string, error) {
+	switch l.Protocol {
+	case gatewayProtoHTTP, gatewayProtoSSL:
+		return gatewayProtoHTTP, nil
+	case gatewayProtoTLS, gatewayProtoTCP:
+		return gatewayProtoTCP, nil
+	}
+	return "", fmt.Errorf("protocol %q is not supported, supported protocols are HTTP, HTTPS, TLS and TCP", l.Protocol)
+}
+
+// getGatewayListenerSupportedKinds returns the route kinds a Gateway listener
+// accepts, the kinds it allows which are supported for its protocol.
+func getGatewayListenerSupportedKinds(l gatewayListener) []gatewayRouteGroupKind {
+	var kinds []string
+	switch l.Protocol {
+	case gatewayProtoHTTP, gatewayProtoSSL:
+		kinds = []string{httpRouteKind}
+	case gatewayProtoTLS:
+		kinds = []string{tlsRouteKind}
+	case gatewayProtoTCP:
+		kinds = []string{tcpRouteKind}
+	}
+
+	supported := []gatewayRouteGroupKind{}
+	for _, kind := range kinds {
+		allowed := l.AllowedRoutes == nil || len(l.AllowedRoutes.Kinds) == 0
+		if !allowed {
+			for _, k := range l.AllowedRoutes.Kinds {
+				if k.Kind == kind && (k.Group == nil || *k.Group == gatewayAPIGroup) {
+					allowed = true
+				}
+			}
+		}
+		if allowed {
+			supported = append(supported, gatewayRouteGroupKind{Group: common.String(gatewayAPIGroup), Kind: kind})
+		}
+	}
+	return supported
+}
+
+// getGatewayListenerCertificate returns the namespace/name of the secret
+// holding the certificate of an HTTPS listener, terminating TLS. TLS
+// listeners must pass TLS through to the backends.
+func getGatewayListenerCertificate(gw *gateway, l gatewayListener) (string, error) {
+	mode := gatewayTLSModeTerminate
+	if l.TLS != nil && l.TLS.Mode != nil {
+		mode = *l.TLS.Mode
+	}
+	switch l.Protocol {
+	case gatewayProtoTLS:
+		if mode != gatewayTLSModePassthrough {
+			return "", fmt.Errorf("TLS listeners only support the %s TLS mode", gatewayTLSModePassthrough)
+		}
+		return "", nil
+	case gatewayProtoSSL:
+		if mode != gatewayTLSModeTerminate {
+			return "", fmt.Errorf("HTTPS listeners only support the %s TLS mode", gatewayTLSModeTerminate)
+		}
+	default:
+		return "", nil
+	}
+
+	if l.TLS == nil || len(l.TLS.CertificateRefs) == 0 {
+		return "", fmt.Errorf("HTTPS listeners require a certificate reference")
+	}
+	ref := l.TLS.CertificateRefs[0]
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != secretKind) {
+		return "", fmt.Errorf("certificate references must refer to a Secret")
+	}
+	if ref.Namespace != nil && *ref.Namespace != gw.Namespace {
+		return "", fmt.Errorf("certificate references must refer to a Secret of the namespace of the Gateway")
+	}
+	return fmt.Sprintf("%s/%s", gw.Namespace, ref.Name), nil
+}
+
+// getGatewayCertificateName returns the name of the OCI certificate of the
+// certificate secret of a Gateway.
+func getGatewayCertificateName(certificate string) string {
+	name, _ := getSecretParts(certificate, nil)
+	return name
+}
+
+// isGatewayParentRef returns true if the parent reference of a route refers
+// to the Gateway.
+func isGatewayParentRef(route *gatewayRoute, ref gatewayParentReference, gw *gateway) bool {
+	if ref.Group != nil && *ref.Group != gatewayAPIGroup {
+		return false
+	}
+	if ref.Kind != nil && *ref.Kind != gatewayKind {
+		return false
+	}
+	namespace := route.Namespace
+	if ref.Namespace != nil {
+		namespace = *ref.Namespace
+	}
+	return namespace == gw.Namespace && ref.Name == gw.Name
+}
+
+// isGatewayListenerReferenced returns true if the section name and port of a
+// parent reference select the Gateway listener.
+func isGatewayListenerReferenced(l gatewayListener, ref gatewayParentReference) bool {
+	if ref.SectionName != nil && *ref.SectionName != l.Name {
+		return false
+	}
+	return ref.Port == nil || *ref.Port == l.Port
+}
+
+// isGatewayRouteAllowed returns true if the Gateway listener allows the kind
+// and namespace of the route. Namespace selectors are not supported.
+func isGatewayRouteAllowed(gw *gateway, l gatewayListener, route *gatewayRoute) bool {
+	supported := false
+	for _, kind := range getGatewayListenerSupportedKinds(l) {
+		if kind.Kind == route.Kind {
+			supported = true
+		}
+	}
+	if !supported {
+		return false
+	}
+
+	from := gatewayNamespacesFromSame
+	if l.AllowedRoutes != nil && l.AllowedRoutes.Namespaces != nil && l.AllowedRoutes.Namespaces.From != nil {
+		from = *l.AllowedRoutes.Namespaces.From
+	}
+	switch from {
+	case gatewayNamespacesFromAll:
+		return true
+	case gatewayNamespacesFromSame:
+		return route.Namespace == gw.Namespace
+	}
+	return false
+}
+
+// getGatewayRouteHostnames returns the hostnames a route is served for by a
+// Gateway listener, the intersection of the hostnames of the route and of the
+// listener, and false if they do not intersect. No hostname matches any host.
+func getGatewayRouteHostnames(l gatewayListener, route *gatewayRoute) ([]string, bool) {
+	if route.Kind == tcpRouteKind || len(route.Spec.Hostnames) == 0 {
+		if l.Hostname == nil || *l.Hostname == "" {
+			return nil, true
+		}
+		return []string{*l.Hostname}, true
+	}
+	if l.Hostname == nil || *l.Hostname == "" {
+		return route.Spec.Hostnames, true
+	}
+
+	var hostnames []string
+	for _, h := range route.Spec.Hostnames {
+		if hostname, ok := intersectGatewayHostnames(*l.Hostname, h); ok {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	return hostnames, len(hostnames) > 0
+}
+
+// intersectGatewayHostnames returns the most specific of two hostnames if one
+// of them matches the other. Wildcard hostnames match the hostnames of their
+// subdomains.
+func intersectGatewayHostnames(a, b string) (string, bool) {
+	switch {
+	case a == b:
+		return a, true
+	case strings.HasPrefix(a, "*.") && strings.HasSuffix(b, a[1:]):
+		return b, true
+	case strings.HasPrefix(b, "*.") && strings.HasSuffix(a, b[1:]):
+		return a, true
+	}
+	return "", false
+}
+
+// resolveGatewayRouteRules resolves the backends of the rules of a route to
+// the node ports of their services. Rules whose backend can not be resolved
+// are dropped and reported by the ResolvedRefs condition. An error is
+// returned if the route uses a feature the load balancer does not support.
+func resolveGatewayRouteRules(route *gatewayRoute, serviceLister corelisters.ServiceLister) ([]gatewayRoutingRule, metav1.Condition, error) {
+	resolvedRefs := metav1.Condition{Type: gatewayConditionResolvedRefs, Status: metav1.ConditionTrue, Reason: gatewayReasonResolvedRefs}
+	var rules []gatewayRoutingRule
+	for i, rule := range route.Spec.Rules {
+		if len(rule.Filters) > 0 {
+			return nil, resolvedRefs, fmt.Errorf("rule %d: filters are not supported", i)
+		}
+		if len(rule.BackendRefs) != 1 {
+			return nil, resolvedRefs, fmt.Errorf("rule %d: exactly one backend reference is supported", i)
+		}
+		backendRef := rule.BackendRefs[0]
+		if len(backendRef.Filters) > 0 {
+			return nil, resolvedRefs, fmt.Errorf("rule %d: backend filters are not supported", i)
+		}
+		matches := rule.Matches
+		if route.Kind != httpRouteKind || len(matches) == 0 {
+			matches = []gatewayHTTPRouteMatch{{}}
+		}
+		for _, match := range matches {
+			if err := validateGatewayHTTPRouteMatch(match); err != nil {
+				return nil, resolvedRefs, fmt.Errorf("rule %d: %v", i, err)
+			}
+		}
+
+		nodePort, reason, err := resolveGatewayBackendRef(route, backendRef, serviceLister)
+		if err != nil {
+			resolvedRefs.Status, resolvedRefs.Reason, resolvedRefs.Message = metav1.ConditionFalse, reason, err.Error()
+			continue
+		}
+		for _, match := range matches {
+			rules = append(rules, gatewayRoutingRule{match: match, nodePort: nodePort, route: route, index: len(rules)})
+		}
+	}
+	return rules, resolvedRefs, nil
+}
+
+// resolveGatewayBackendRef returns the node port of the service port a
+// backend reference refers to, or the reason it can not be resolved.
+func resolveGatewayBackendRef(route *gatewayRoute, ref gatewayBackendRef, serviceLister corelisters.ServiceLister) (int32, string, error) {
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != serviceKind) {
+		return 0, gatewayReasonInvalidKind, fmt.Errorf("backend %s must refer to a Service", ref.Name)
+	}
+	if ref.Namespace != nil && *ref.Namespace != route.Namespace {
+		return 0, gatewayReasonRefNotPermitted, fmt.Errorf("backend %s/%s must refer to a Service of the namespace of the route", *ref.Namespace, ref.Name)
+	}
+	if ref.Port == nil {
+		return 0, gatewayReasonBackendNotFound, fmt.Errorf("backend %s has no port", ref.Name)
+	}
+
+	svc, err := serviceLister.Services(route.Namespace).Get(ref.Name)
+	if apierrors.IsNotFound(err) {
+		return 0, gatewayReasonBackendNotFound, fmt.Errorf("service %s/%s not found", route.Namespace, ref.Name)
+	} else if err != nil {
+		return 0, gatewayReasonBackendNotFound, err
+	}
+	for _, port := range svc.Spec.Ports {
+		if port.Port != *ref.Port {
+			continue
+		}
+		if port.NodePort == 0 || port.Protocol != v1.ProtocolTCP {
+			return 0, gatewayReasonBackendNotFound, fmt.Errorf("port %d of service %s/%s has no TCP node port", *ref.Port, route.Namespace, ref.Name)
+		}
+		return port.NodePort, "", nil
+	}
+	return 0, gatewayReasonBackendNotFound, fmt.Errorf("service %s/%s has no port %d", route.Namespace, ref.Name, *ref.Port)
+}
+
+// validateGatewayHTTPRouteMatch returns an error if an HTTPRoute match can not
+// be expressed in the condition language of OCI routing policies.
+func validateGatewayHTTPRouteMatch(match gatewayHTTPRouteMatch) error {
+	if len(match.QueryParams) > 0 || match.Method != nil {
+		return fmt.Errorf("query parameter and method matches are not supported")
+	}
+	if match.Path != nil {
+		if match.Path.Type != nil && *match.Path.Type != gatewayPathMatchExact && *match.Path.Type != gatewayPathMatchPrefix {
+			return fmt.Errorf("path match type %q is not supported", *match.Path.Type)
+		}
+		if match.Path.Value != nil && strings.ContainsAny(*match.Path.Value, `'\`) {
+			return fmt.Errorf("path %q is not supported", *match.Path.Value)
+		}
+	}
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gatewayHeaderMatchExact {
+			return fmt.Errorf("header match type %q is not supported", *header.Type)
+		}
+		if strings.ContainsAny(header.Name+header.Value, `'\`) {
+			return fmt.Errorf("header match %s is not supported", header.Name)
+		}
+	}
+	return nil
+}
+
+// sortGatewayRoutes orders routes oldest first. Conflicts between routes are
+// settled in favour of the oldest one.
+func sortGatewayRoutes(routes []*gatewayRoute) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		if !routes[i].CreationTimestamp.Equal(&routes[j].CreationTimestamp) {
+			return routes[i].CreationTimestamp.Before(&routes[j].CreationTimestamp)
+		}
+		return gatewayRouteKey(routes[i]) < gatewayRouteKey(routes[j])
+	})
+}
+
+// sortGatewayRoutingRules orders the rules of an HTTP listener by the
+// precedence of the Gateway API since the first matching rule of an OCI
+// routing policy wins: hostnames, exact paths, longest paths and most headers
+// first. Rules of older routes come first on ties.
+func sortGatewayRoutingRules(rules []gatewayRoutingRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		a, b := rules[i], rules[j]
+		if ha, hb := getGatewayHostnamePrecedence(a.hostnames), getGatewayHostnamePrecedence(b.hostnames); ha != hb {
+			return ha > hb
+		}
+		pathTypeA, pathA := getGatewayPathMatch(a.match)
+		pathTypeB, pathB := getGatewayPathMatch(b.match)
+		if pathTypeA != pathTypeB {
+			return pathTypeA == gatewayPathMatchExact
+		}
+		if len(pathA) != len(pathB) {
+			return len(pathA) > len(pathB)
+		}
+		return len(a.match.Headers) > len(b.match.Headers)
+	})
+}
+
+// getGatewayHostnamePrecedence ranks exact hostnames over wildcard hostnames
+// and wildcard hostnames over any host.
+func getGatewayHostnamePrecedence(hostnames []string) int {
+	if len(hostnames) == 0 {
+		return 0
+	}
+	for _, h := range hostnames {
+		if strings.HasPrefix(h, "*.") {
+			return 1
+		}
+	}
+	return 2
+}
+
+func getGatewayPathMatch(match gatewayHTTPRouteMatch) (string, string) {
+	pathType, path := gatewayPathMatchPrefix, "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			path = *match.Path.Value
+		}
+	}
+	return pathType, path
+}
+
+// getGatewayRoutingRuleCondition renders a routing rule in the condition
+// language of OCI routing policies.
+func getGatewayRoutingRuleCondition(rule gatewayRoutingRule) string {
+	var conditions []string
+
+	var hostConditions []string
+	for _, h := range rule.hostnames {
+		if strings.HasPrefix(h, "*.") {
+			hostConditions = append(hostConditions, fmt.Sprintf("any(http.request.headers[(i 'host')] ew (i '%s'))", h[1:]))
+		} else {
+			hostConditions = append(hostConditions, fmt.Sprintf("any(http.request.headers[(i 'host')] eq (i '%s'))", h))
+		}
+	}
+	switch len(hostConditions) {
+	case 0:
+	case 1:
+		conditions = append(conditions, hostConditions[0])
+	default:
+		conditions = append(conditions, fmt.Sprintf("any(%s)", strings.Join(hostConditions, ", ")))
+	}
+
+	pathType, path := getGatewayPathMatch(rule.match)
+	if pathType == gatewayPathMatchExact {
+		conditions = append(conditions, fmt.Sprintf("http.request.url.path eq '%s'", path))
+	} else if path = strings.TrimSuffix(path, "/"); path != "" {
+		// Path prefixes match whole path segments.
+		conditions = append(conditions, fmt.Sprintf("any(http.request.url.path eq '%s', http.request.url.path sw '%s/')", path, path))
+	}
+
+	for _, header := range rule.match.Headers {
+		conditions = append(conditions, fmt.Sprintf("any(http.request.headers[(i '%s')] eq '%s')", header.Name, header.Value))
+	}
+
+	switch len(conditions) {
+	case 0:
+		return "http.request.url.path sw '/'"
+	case 1:
+		return conditions[0]
+	}
+	return fmt.Sprintf("all(%s)", strings.Join(conditions, ", "))
+}
+
+// getGatewayService returns the service the load balancer of a Gateway is
+// derived from. The annotations hold the parameters of the GatewayClass and
+// the ports are the node ports of the backends of the routes, so that the
+// backend sets are named after them.
+func getGatewayService(gw *gateway, annotations map[string]string, lb *gatewayLoadBalancer) *v1.Service {
+	svcAnnotations := map[string]string{
+		// The routing policies of the listeners are managed.
+		ServiceAnnotationLoadBalancerListenerRouting: "{}",
+	}
+	for k, v := range annotations {
+		svcAnnotations[k] = v
+	}
+
+	nodePorts := make([]int, 0, len(lb.Backends))
+	for nodePort := range lb.Backends {
+		nodePorts = append(nodePorts, int(nodePort))
+	}
+	sort.Ints(nodePorts)
+	ports := make([]v1.ServicePort, 0, len(nodePorts))
+	for _, nodePort := range nodePorts {
+		ports = append(ports, v1.ServicePort{
+			Name:     fmt.Sprintf("tcp-%d", nodePort),
+			Protocol: v1.ProtocolTCP,
+			Port:     int32(nodePort),
+			NodePort: int32(nodePort),
+		})
+	}
+
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gw.Name,
+			Namespace:   gw.Namespace,
+			UID:         gw.UID,
+			Annotations: svcAnnotations,
+		},
+		Spec: v1.ServiceSpec{
+			Type:                  v1.ServiceTypeLoadBalancer,
+			Ports:                 ports,
+			SessionAffinity:       v1.ServiceAffinityNone,
+			ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyTypeCluster,
+			IPFamilies:            []v1.IPFamily{v1.IPv4Protocol},
+		},
+	}
+	for _, address := range gw.Spec.Addresses {
+		if address.Type == nil || *address.Type == "IPAddress" {
+			svc.Spec.LoadBalancerIP = address.Value
+			break
+		}
+	}
+	return svc
+}
+
+// applyGatewayLoadBalancer replaces the listeners of a load balancer spec
+// derived from the service of a Gateway by the listeners and routing policies
+// of the Gateway.
+func applyGatewayLoadBalancer(spec *LBSpec, lb *gatewayLoadBalancer) {
+	spec.Listeners = lb.Listeners
+	spec.RoutingPolicies = lb.RoutingPolicies
+	for name, ports := range spec.Ports {
+		if listenerPort, ok := lb.Backends[int32(ports.BackendPort)]; ok {
+			ports.ListenerPort = listenerPort
+			spec.Ports[name] = ports
+		}
+	}
+}
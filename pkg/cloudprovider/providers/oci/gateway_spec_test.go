@@ -0,0 +1,401 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+)
+
+func newGatewayTestServiceLister(t *testing.T) v1listers.ServiceLister {
+	serviceCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	services := []*v1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+			Spec: v1.ServiceSpec{Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: 80, NodePort: 30080},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"},
+			Spec: v1.ServiceSpec{Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: 8080, NodePort: 30081},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "internal"},
+			Spec: v1.ServiceSpec{Ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: 80},
+			}},
+		},
+	}
+	for _, svc := range services {
+		if err := serviceCache.Add(svc); err != nil {
+			t.Fatalf("failed to add service: %v", err)
+		}
+	}
+	return v1listers.NewServiceLister(serviceCache)
+}
+
+func newGatewayTestRoute(kind, name string, age time.Duration, spec gatewayRouteSpec) *gatewayRoute {
+	return &gatewayRoute{
+		TypeMeta: metav1.TypeMeta{Kind: kind},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(-age)),
+		},
+		Spec: spec,
+	}
+}
+
+func gatewayTestBackend(name string, port int32) []gatewayBackendRef {
+	return []gatewayBackendRef{{
+		gatewayObjectReference: gatewayObjectReference{Name: name},
+		Port:                   pointer.Int32(port),
+	}}
+}
+
+func gatewayTestPathMatch(pathType, path string) []gatewayHTTPRouteMatch {
+	return []gatewayHTTPRouteMatch{{
+		Path: &gatewayHTTPPathMatch{Type: common.String(pathType), Value: common.String(path)},
+	}}
+}
+
+func Test_getGatewayLoadBalancer(t *testing.T) {
+	gw := &gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"},
+		Spec: gatewaySpec{
+			GatewayClassName: "oci",
+			Listeners: []gatewayListener{
+				{Name: "http", Port: 80, Protocol: gatewayProtoHTTP},
+				{
+					Name:     "https",
+					Port:     443,
+					Protocol: gatewayProtoSSL,
+					Hostname: common.String("*.example.com"),
+					TLS: &gatewayTLSConfig{CertificateRefs: []gatewayObjectReference{
+						{Name: "tls"},
+					}},
+				},
+				{Name: "tcp", Port: 5432, Protocol: gatewayProtoTCP},
+				{Name: "conflict", Port: 80, Protocol: gatewayProtoTCP},
+			},
+		},
+	}
+	parentRefs := []gatewayParentReference{{Name: "gw"}}
+
+	testCases := map[string]struct {
+		routes          []*gatewayRoute
+		listeners       map[string]client.GenericListener
+		routingPolicies map[string]loadbalancer.RoutingPolicyDetails
+		backends        map[int32]int
+		attachedRoutes  []int32
+		routeReasons    map[string][]string
+	}{
+		"no routes": {
+			listeners:       map[string]client.GenericListener{},
+			routingPolicies: map[string]loadbalancer.RoutingPolicyDetails{},
+			backends:        map[int32]int{},
+			attachedRoutes:  []int32{0, 0, 0, 0},
+			routeReasons:    map[string][]string{},
+		},
+		"http routes ordered by precedence": {
+			routes: []*gatewayRoute{
+				newGatewayTestRoute(httpRouteKind, "web", 2*time.Hour, gatewayRouteSpec{
+					ParentRefs: []gatewayParentReference{{Name: "gw", SectionName: common.String("http")}},
+					Rules: []gatewayRouteRule{
+						{BackendRefs: gatewayTestBackend("web", 80)},
+					},
+				}),
+				newGatewayTestRoute(httpRouteKind, "api", time.Hour, gatewayRouteSpec{
+					ParentRefs: []gatewayParentReference{{Name: "gw", SectionName: common.String("http")}},
+					Rules: []gatewayRouteRule{
+						{Matches: gatewayTestPathMatch(gatewayPathMatchPrefix, "/api"), BackendRefs: gatewayTestBackend("api", 8080)},
+					},
+				}),
+			},
+			listeners: map[string]client.GenericListener{
+				"HTTP-80": {
+					Name:                  common.String("HTTP-80"),
+					Protocol:              common.String("HTTP"),
+					Port:                  common.Int(80),
+					DefaultBackendSetName: common.String("TCP-30080"),
+					RoutingPolicyName:     common.String("HTTP_80"),
+				},
+			},
+			routingPolicies: map[string]loadbalancer.RoutingPolicyDetails{
+				"HTTP_80": {Rules: []loadbalancer.RoutingRule{
+					{
+						Name:      common.String("rule_0"),
+						Condition: common.String("any(http.request.url.path eq '/api', http.request.url.path sw '/api/')"),
+						Actions:   []loadbalancer.Action{loadbalancer.ForwardToBackendSet{BackendSetName: common.String("TCP-30081")}},
+					},
+					{
+						Name:      common.String("rule_1"),
+						Condition: common.String("http.request.url.path sw '/'"),
+						Actions:   []loadbalancer.Action{loadbalancer.ForwardToBackendSet{BackendSetName: common.String("TCP-30080")}},
+					},
+				}},
+			},
+			backends:       map[int32]int{30080: 80, 30081: 80},
+			attachedRoutes: []int32{2, 0, 0, 0},
+			routeReasons: map[string][]string{
+				"HTTPRoute/default/web": {gatewayReasonAccepted, gatewayReasonResolvedRefs},
+				"HTTPRoute/default/api": {gatewayReasonAccepted, gatewayReasonResolvedRefs},
+			},
+		},
+		"https route restricted to the listener hostname": {
+			routes: []*gatewayRoute{
+				newGatewayTestRoute(httpRouteKind, "web", time.Hour, gatewayRouteSpec{
+					ParentRefs: []gatewayParentReference{{Name: "gw", Port: pointer.Int32(443)}},
+					Hostnames:  []string{"www.example.com", "www.example.org"},
+					Rules: []gatewayRouteRule{
+						{BackendRefs: gatewayTestBackend("web", 80)},
+					},
+				}),
+			},
+			listeners: map[string]client.GenericListener{
+				"HTTP-443": {
+					Name:                  common.String("HTTP-443"),
+					Protocol:              common.String("HTTP"),
+					Port:                  common.Int(443),
+					DefaultBackendSetName: common.String("TCP-30080"),
+					RoutingPolicyName:     common.String("HTTP_443"),
+					SslConfiguration: &client.GenericSslConfigurationDetails{
+						CertificateName:       common.String("tls"),
+						VerifyDepth:           common.Int(0),
+						VerifyPeerCertificate: common.Bool(false),
+					},
+				},
+			},
+			routingPolicies: map[string]loadbalancer.RoutingPolicyDetails{
+				"HTTP_443": {Rules: []loadbalancer.RoutingRule{
+					{
+						Name:      common.String("rule_0"),
+						Condition: common.String("any(http.request.headers[(i 'host')] eq (i 'www.example.com'))"),
+						Actions:   []loadbalancer.Action{loadbalancer.ForwardToBackendSet{BackendSetName: common.String("TCP-30080")}},
+					},
+				}},
+			},
+			backends:       map[int32]int{30080: 443},
+			attachedRoutes: []int32{0, 1, 0, 0},
+			routeReasons: map[string][]string{
+				"HTTPRoute/default/web": {gatewayReasonAccepted, gatewayReasonResolvedRefs},
+			},
+		},
+		"tcp routes served oldest first": {
+			routes: []*gatewayRoute{
+				newGatewayTestRoute(tcpRouteKind, "new", time.Hour, gatewayRouteSpec{
+					ParentRefs: parentRefs,
+					Rules:      []gatewayRouteRule{{BackendRefs: gatewayTestBackend("api", 8080)}},
+				}),
+				newGatewayTestRoute(tcpRouteKind, "old", 2*time.Hour, gatewayRouteSpec{
+					ParentRefs: parentRefs,
+					Rules:      []gatewayRouteRule{{BackendRefs: gatewayTestBackend("web", 80)}},
+				}),
+			},
+			listeners: map[string]client.GenericListener{
+				"TCP-5432": {
+					Name:                  common.String("TCP-5432"),
+					Protocol:              common.String("TCP"),
+					Port:                  common.Int(5432),
+					DefaultBackendSetName: common.String("TCP-30080"),
+				},
+			},
+			routingPolicies: map[string]loadbalancer.RoutingPolicyDetails{},
+			backends:        map[int32]int{30080: 5432},
+			attachedRoutes:  []int32{0, 0, 2, 0},
+			routeReasons: map[string][]string{
+				"TCPRoute/default/new": {gatewayReasonAccepted, gatewayReasonResolvedRefs},
+				"TCPRoute/default/old": {gatewayReasonAccepted, gatewayReasonResolvedRefs},
+			},
+		},
+		"unresolved and unsupported routes": {
+			routes: []*gatewayRoute{
+				newGatewayTestRoute(httpRouteKind, "no-node-port", time.Hour, gatewayRouteSpec{
+					ParentRefs: []gatewayParentReference{{Name: "gw", SectionName: common.String("http")}},
+					Rules:      []gatewayRouteRule{{BackendRefs: gatewayTestBackend("internal", 80)}},
+				}),
+				newGatewayTestRoute(httpRouteKind, "filters", time.Hour, gatewayRouteSpec{
+					ParentRefs: parentRefs,
+					Rules: []gatewayRouteRule{{
+						Filters:     []gatewayRouteFilter{{Type: "RequestRedirect"}},
+						BackendRefs: gatewayTestBackend("web", 80),
+					}},
+				}),
+				newGatewayTestRoute(httpRouteKind, "unknown-section", time.Hour, gatewayRouteSpec{
+					ParentRefs: []gatewayParentReference{{Name: "gw", SectionName: common.String("grpc")}},
+					Rules:      []gatewayRouteRule{{BackendRefs: gatewayTestBackend("web", 80)}},
+				}),
+				newGatewayTestRoute(tlsRouteKind, "tls", time.Hour, gatewayRouteSpec{
+					ParentRefs: parentRefs,
+					Rules:      []gatewayRouteRule{{BackendRefs: gatewayTestBackend("web", 80)}},
+				}),
+			},
+			listeners:       map[string]client.GenericListener{},
+			routingPolicies: map[string]loadbalancer.RoutingPolicyDetails{},
+			backends:        map[int32]int{},
+			attachedRoutes:  []int32{1, 0, 0, 0},
+			routeReasons: map[string][]string{
+				"HTTPRoute/default/no-node-port":    {gatewayReasonAccepted, gatewayReasonBackendNotFound},
+				"HTTPRoute/default/filters":         {gatewayReasonUnsupportedValue, gatewayReasonResolvedRefs},
+				"HTTPRoute/default/unknown-section": {gatewayReasonNoMatchingParent, gatewayReasonResolvedRefs},
+				"TLSRoute/default/tls":              {gatewayReasonNotAllowedByListeners, gatewayReasonResolvedRefs},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lb := getGatewayLoadBalancer(gw, tc.routes, newGatewayTestServiceLister(t))
+
+			if !reflect.DeepEqual(lb.Listeners, tc.listeners) {
+				t.Errorf("Expected listeners\n%+v\nbut got\n%+v", tc.listeners, lb.Listeners)
+			}
+			if !reflect.DeepEqual(lb.RoutingPolicies, tc.routingPolicies) {
+				t.Errorf("Expected routing policies\n%+v\nbut got\n%+v", tc.routingPolicies, lb.RoutingPolicies)
+			}
+			if !reflect.DeepEqual(lb.Backends, tc.backends) {
+				t.Errorf("Expected backends\n%+v\nbut got\n%+v", tc.backends, lb.Backends)
+			}
+
+			var attachedRoutes []int32
+			for _, l := range lb.ListenerStatuses {
+				attachedRoutes = append(attachedRoutes, l.AttachedRoutes)
+			}
+			if !reflect.DeepEqual(attachedRoutes, tc.attachedRoutes) {
+				t.Errorf("Expected attached routes %v but got %v", tc.attachedRoutes, attachedRoutes)
+			}
+
+			routeReasons := map[string][]string{}
+			for key, parents := range lb.RouteParents {
+				for _, c := range parents[0].Conditions {
+					routeReasons[key] = append(routeReasons[key], c.Reason)
+				}
+			}
+			if !reflect.DeepEqual(routeReasons, tc.routeReasons) {
+				t.Errorf("Expected route condition reasons\n%+v\nbut got\n%+v", tc.routeReasons, routeReasons)
+			}
+
+			conflicted := lb.ListenerStatuses[3].Conditions[2]
+			if conflicted.Type != gatewayConditionConflicted || conflicted.Reason != gatewayReasonProtocolConflict {
+				t.Errorf("Expected listener conflict %s but got %+v", gatewayReasonProtocolConflict, conflicted)
+			}
+		})
+	}
+}
+
+func Test_getGatewayRoutingRuleCondition(t *testing.T) {
+	testCases := map[string]struct {
+		rule     gatewayRoutingRule
+		expected string
+	}{
+		"any request": {
+			rule:     gatewayRoutingRule{},
+			expected: "http.request.url.path sw '/'",
+		},
+		"exact path": {
+			rule:     gatewayRoutingRule{match: gatewayTestPathMatch(gatewayPathMatchExact, "/healthz")[0]},
+			expected: "http.request.url.path eq '/healthz'",
+		},
+		"path prefix with trailing slash": {
+			rule:     gatewayRoutingRule{match: gatewayTestPathMatch(gatewayPathMatchPrefix, "/api/")[0]},
+			expected: "any(http.request.url.path eq '/api', http.request.url.path sw '/api/')",
+		},
+		"hostnames and header": {
+			rule: gatewayRoutingRule{
+				hostnames: []string{"example.com", "*.example.com"},
+				match: gatewayHTTPRouteMatch{
+					Headers: []gatewayHTTPHeaderMatch{{Name: "x-canary", Value: "true"}},
+				},
+			},
+			expected: "all(any(any(http.request.headers[(i 'host')] eq (i 'example.com')), any(http.request.headers[(i 'host')] ew (i '.example.com'))), " +
+				"any(http.request.headers[(i 'x-canary')] eq 'true'))",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			condition := getGatewayRoutingRuleCondition(tc.rule)
+			if condition != tc.expected {
+				t.Errorf("Expected\n%s\nbut got\n%s", tc.expected, condition)
+			}
+		})
+	}
+}
+
+func Test_intersectGatewayHostnames(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected string
+		ok       bool
+	}{
+		{a: "example.com", b: "example.com", expected: "example.com", ok: true},
+		{a: "*.example.com", b: "www.example.com", expected: "www.example.com", ok: true},
+		{a: "www.example.com", b: "*.example.com", expected: "www.example.com", ok: true},
+		{a: "*.example.com", b: "example.com"},
+		{a: "www.example.com", b: "api.example.com"},
+	}
+
+	for _, tc := range testCases {
+		hostname, ok := intersectGatewayHostnames(tc.a, tc.b)
+		if hostname != tc.expected || ok != tc.ok {
+			t.Errorf("intersectGatewayHostnames(%q, %q): expected %q, %t but got %q, %t", tc.a, tc.b, tc.expected, tc.ok, hostname, ok)
+		}
+	}
+}
+
+func Test_getGatewayService(t *testing.T) {
+	gw := &gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw", UID: "gw-uid"},
+		Spec: gatewaySpec{
+			Addresses: []gatewayAddress{{Value: "10.0.0.10"}},
+		},
+	}
+	lb := &gatewayLoadBalancer{Backends: map[int32]int{30081: 80, 30080: 443}}
+
+	svc := getGatewayService(gw, map[string]string{ServiceAnnotationLoadBalancerShape: "flexible"}, lb)
+
+	if GetLoadBalancerName(svc) != "gw-uid" {
+		t.Errorf("Expected load balancer name gw-uid but got %s", GetLoadBalancerName(svc))
+	}
+	if svc.Spec.LoadBalancerIP != "10.0.0.10" {
+		t.Errorf("Expected load balancer IP 10.0.0.10 but got %s", svc.Spec.LoadBalancerIP)
+	}
+	if _, ok := svc.Annotations[ServiceAnnotationLoadBalancerListenerRouting]; !ok || svc.Annotations[ServiceAnnotationLoadBalancerShape] != "flexible" {
+		t.Errorf("Unexpected annotations %v", svc.Annotations)
+	}
+	expectedBackendSets := map[string]v1.ServicePort{
+		"TCP-30080": {Name: "tcp-30080", Protocol: v1.ProtocolTCP, Port: 30080, NodePort: 30080},
+		"TCP-30081": {Name: "tcp-30081", Protocol: v1.ProtocolTCP, Port: 30081, NodePort: 30081},
+	}
+	if backendSets := getBackendSetNamePortMap(svc); !reflect.DeepEqual(backendSets, expectedBackendSets) {
+		t.Errorf("Expected backend sets\n%+v\nbut got\n%+v", expectedBackendSets, backendSets)
+	}
+	if err := validateService(svc); err != nil {
+		t.Errorf("Expected a valid service but got %v", err)
+	}
+}
@@ -41,6 +41,16 @@ import (
 
 var (
 	instanceVnics = map[string]*core.Vnic{
+		"ocid1.route-instance": {
+			Id:                  common.String("ocid1.vnic.route-node"),
+			PrivateIp:           common.String("10.0.0.10"),
+			SkipSourceDestCheck: common.Bool(true),
+		},
+		"ocid1.route-instance-source-dest-check": {
+			Id:                  common.String("ocid1.vnic.route-node-source-dest-check"),
+			PrivateIp:           common.String("10.0.0.11"),
+			SkipSourceDestCheck: common.Bool(false),
+		},
 		"ocid1.default": {
 			PrivateIp:     common.String("10.0.0.1"),
 			PublicIp:      common.String("0.0.0.1"),
@@ -877,6 +887,10 @@ func (c MockOCIClient) Lustre() client.LustreInterface {
 	return nil
 }
 
+func (c MockOCIClient) Limits() client.LimitsInterface {
+	return nil
+}
+
 func (MockOCIClient) Compute() client.ComputeInterface {
 	return &MockComputeClient{}
 }
@@ -1025,12 +1039,44 @@ func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, sna
 	return []core.VolumeBackup{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeBackup, error) {
+	return []core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) UpdateVolumeBackup(ctx context.Context, id string, details core.UpdateVolumeBackupDetails) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) InRegion(region string) (client.BlockStorageInterface, error) {
+	return c, nil
+}
+
+func (c *MockBlockStorageClient) ListVolumeBackupPolicies(ctx context.Context, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssetAssignment(ctx context.Context, assetID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, assetID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	return nil
+}
+
 // MockVirtualNetworkClient mocks VirtualNetwork client implementation
 type MockVirtualNetworkClient struct {
 }
 
 func (c *MockVirtualNetworkClient) ListPrivateIps(ctx context.Context, vnicId string) ([]core.PrivateIp, error) {
-	return nil, nil
+	return vnicPrivateIps[vnicId], nil
 }
 
 func (c *MockVirtualNetworkClient) CreatePrivateIp(ctx context.Context, vnicID string) (*core.PrivateIp, error) {
@@ -1133,6 +1179,10 @@ func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, lbID str
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -1173,6 +1223,42 @@ func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string,
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteHostname(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) UpdateLoadBalancer(ctx context.Context, lbID string, details *client.GenericUpdateLoadBalancerDetails) (string, error) {
 	if err, ok := updateLoadBalancerErrors[lbID]; ok {
 		return "", err
@@ -1251,6 +1337,10 @@ func (c *MockNetworkLoadBalancerClient) CreateCertificate(ctx context.Context, l
 	return "", nil
 }
 
+func (c *MockNetworkLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockNetworkLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -1287,6 +1377,42 @@ func (c *MockNetworkLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID
 	return "", nil
 }
 
+func (c *MockNetworkLoadBalancerClient) CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) DeleteHostname(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockNetworkLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockNetworkLoadBalancerClient) AwaitWorkRequest(ctx context.Context, id string) (*client.GenericWorkRequest, error) {
 	if err, ok := awaitLoadbalancerWorkrequestMap[id]; ok {
 		return nil, err
@@ -1319,11 +1445,55 @@ func (c *MockBlockStorageClient) GetBootVolume(ctx context.Context, id string) (
 	return nil, nil
 }
 
+func (c *MockBlockStorageClient) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupBackupCommittedOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
 // AwaitVolumeCloneAvailableOrTimeout implements client.BlockStorageInterface.
 func (*MockBlockStorageClient) AwaitVolumeHydratedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
 	return nil, nil
 }
 
+func (*MockBlockStorageClient) AwaitVolumeReplicasRemovedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
+	return nil, nil
+}
+
 func (MockBlockStorageClient) AwaitVolumeAvailableORTimeout(ctx context.Context, id string) (*core.Volume, error) {
 	return nil, nil
 }
@@ -1356,6 +1526,10 @@ func (MockBlockStorageClient) GetVolumesByName(ctx context.Context, volumeName,
 	return nil, nil
 }
 
+func (MockBlockStorageClient) ListVolumes(ctx context.Context, compartmentID string) ([]core.Volume, error) {
+	return nil, nil
+}
+
 func (MockBlockStorageClient) DeleteVolume(ctx context.Context, id string) error {
 	return nil
 }
@@ -1391,6 +1565,10 @@ func (MockFileStorageClient) AwaitFileSystemActive(ctx context.Context, logger *
 	return nil, nil
 }
 
+func (MockFileStorageClient) AwaitFileSystemHydrated(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.FileSystem, error) {
+	return nil, nil
+}
+
 func (MockFileStorageClient) CreateFileSystem(ctx context.Context, details filestorage.CreateFileSystemDetails) (*filestorage.FileSystem, error) {
 	return nil, nil
 }
@@ -1419,6 +1597,42 @@ func (MockFileStorageClient) DeleteMountTarget(ctx context.Context, id string) e
 	return nil
 }
 
+func (MockFileStorageClient) CreateSnapshot(ctx context.Context, details filestorage.CreateSnapshotDetails) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (MockFileStorageClient) GetSnapshot(ctx context.Context, id string) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (MockFileStorageClient) ListSnapshots(ctx context.Context, fileSystemID string) ([]filestorage.SnapshotSummary, error) {
+	return nil, nil
+}
+
+func (MockFileStorageClient) AwaitSnapshotActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (MockFileStorageClient) DeleteSnapshot(ctx context.Context, id string) error {
+	return nil
+}
+
+func (MockFileStorageClient) ToggleQuotaRules(ctx context.Context, fileSystemID string, enabled bool) error {
+	return nil
+}
+
+func (MockFileStorageClient) ListQuotaRules(ctx context.Context, fileSystemID string, principalType filestorage.ListQuotaRulesPrincipalTypeEnum) ([]filestorage.QuotaRuleSummary, error) {
+	return nil, nil
+}
+
+func (MockFileStorageClient) CreateQuotaRule(ctx context.Context, fileSystemID string, details filestorage.CreateQuotaRuleDetails) (*filestorage.QuotaRule, error) {
+	return nil, nil
+}
+
+func (MockFileStorageClient) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, details filestorage.UpdateQuotaRuleDetails) (*filestorage.QuotaRule, error) {
+	return nil, nil
+}
+
 // MockIdentityClient mocks Identity client implementaion
 type MockIdentityClient struct{}
 
@@ -192,6 +192,9 @@ func (cp *CloudProvider) GetLoadBalancerName(ctx context.Context, clusterName st
 // GetLoadBalancer returns whether the specified load balancer exists, and if
 // so, what its status is.
 func (cp *CloudProvider) GetLoadBalancer(ctx context.Context, clusterName string, service *v1.Service) (*v1.LoadBalancerStatus, bool, error) {
+	if err := cp.ensureClusterUID(ctx, service); err != nil {
+		return nil, false, err
+	}
 	name := cp.GetLoadBalancerName(ctx, clusterName, service)
 	logger := cp.logger.With("loadBalancerName", name, "loadBalancerType", getLoadBalancerType(service))
 	if sa, useWI := service.Annotations[ServiceAnnotationServiceAccountName]; useWI { // When using Workload Identity
@@ -415,6 +418,16 @@ func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, sp
 		DefinedTags:             spec.DefinedTags,
 		IpVersion:               spec.IpVersions.LbEndpointIpVersion,
 		RuleSets:                spec.RuleSets,
+		Hostnames:               spec.Hostnames,
+		PathRouteSets:           spec.PathRouteSets,
+	}
+	if len(spec.RoutingPolicies) > 0 {
+		// Routing policies are created and attached once the load balancer exists.
+		details.Listeners = make(map[string]client.GenericListener, len(spec.Listeners))
+		for name, listener := range spec.Listeners {
+			listener.RoutingPolicyName = nil
+			details.Listeners[name] = listener
+		}
 	}
 	// do not block creation if the defined tag limit is reached. defer LB to tracked by backfilling
 	if len(details.DefinedTags) > MaxDefinedTagPerResource {
@@ -475,6 +488,12 @@ func (clb *CloudLoadBalancerProvider) createLoadBalancer(ctx context.Context, sp
 
 	logger.With("loadBalancerID", *lb.Id).Info("Load balancer created")
 
+	if len(spec.RoutingPolicies) > 0 {
+		if err = clb.attachRoutingPolicies(ctx, *lb.Id, spec); err != nil {
+			return nil, "", errors.Wrap(err, "attaching routing policies")
+		}
+	}
+
 	skipPrivateIP, err := isSkipPrivateIP(spec.service)
 	if err != nil {
 		return nil, "", err
@@ -565,6 +584,9 @@ func checkSubnetIpFamilyCompatibility(subnets []*core.Subnet, ipVersion string)
 // EnsureLoadBalancer creates a new load balancer or updates the existing one.
 // Returns the status of the balancer (i.e it's public IP address if one exists).
 func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName string, service *v1.Service, clusterNodes []*v1.Node) (*v1.LoadBalancerStatus, error) {
+	if err := cp.ensureClusterUID(ctx, service); err != nil {
+		return nil, err
+	}
 	startTime := time.Now()
 	lbName := GetLoadBalancerName(service)
 	loadBalancerType := getLoadBalancerType(service)
@@ -581,7 +603,7 @@ func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName str
 		logger.Info("Service already deleted or no more exists")
 		return nil, errors.New("Service already deleted or no more exists")
 	}
-	loadBalancerService := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+	loadBalancerService := getLoadBalancerLockKey(service)
 	if acquired := cp.lbLocks.TryAcquire(loadBalancerService); !acquired {
 		logger.Error("Could not acquire lock for Ensuring Load Balancer")
 		return nil, LbOperationAlreadyExists
@@ -690,6 +712,18 @@ func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName str
 		return nil, err
 	}
 
+	if _, shared := getSharedLoadBalancerGroup(service); shared {
+		sharedLB, sharedObjects, err := cp.getSharedLoadBalancerView(ctx, service, lb)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("Failed to reconcile the services of the shared load balancer")
+			return nil, err
+		}
+		if lb != nil {
+			lb = sharedLB
+		}
+		spec.sharedObjects = sharedObjects
+	}
+
 	if requiresNsgManagement(service) {
 		// Fetch existing frontend NSG and use it to manage rules
 		frontendNsgId := ""
@@ -1058,13 +1092,31 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 		ruleSetActions = getRuleSetChanges(lb.RuleSets, spec.RuleSets)
 	}
 
+	var routingActions []Action
+	if spec.Hostnames != nil {
+		routingActions = append(routingActions, getHostnameChanges(lb.Hostnames, spec.Hostnames)...)
+	}
+	if spec.PathRouteSets != nil {
+		routingActions = append(routingActions, getPathRouteSetChanges(lb.PathRouteSets, spec.PathRouteSets)...)
+	}
+	if spec.RoutingPolicies != nil {
+		routingActions = append(routingActions, getRoutingPolicyChanges(lb.RoutingPolicies, spec.RoutingPolicies)...)
+	}
+	_, manageRouting := spec.service.Annotations[ServiceAnnotationLoadBalancerListenerRouting]
+	if spec.sharedObjects != nil {
+		// Rule sets, hostnames, path route sets and routing policies of a shared
+		// load balancer may be declared by the other services of the group.
+		ruleSetActions = withoutDeclaredDeleteActions(ruleSetActions, spec.sharedObjects)
+		routingActions = withoutDeclaredDeleteActions(routingActions, spec.sharedObjects)
+	}
+
 	actualBackendSets := lb.BackendSets
 	desiredBackendSets := spec.BackendSets
 	backendSetActions := getBackendSetChanges(logger, actualBackendSets, desiredBackendSets)
 
 	actualListeners := lb.Listeners
 	desiredListeners := spec.Listeners
-	listenerActions := getListenerChanges(logger, actualListeners, desiredListeners, spec.RuleSets)
+	listenerActions := getListenerChanges(logger, actualListeners, desiredListeners, spec.RuleSets, manageRouting)
 
 	if len(backendSetActions) == 0 && len(listenerActions) == 0 {
 		// If there are no backendSetActions or Listener actions
@@ -1077,7 +1129,7 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 			return err
 		}
 	}
-	actions := sortAndCombineActions(logger, backendSetActions, listenerActions, ruleSetActions)
+	actions := sortAndCombineActions(logger, backendSetActions, listenerActions, ruleSetActions, routingActions)
 
 	for _, action := range actions {
 		switch a := action.(type) {
@@ -1108,6 +1160,21 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 			if err != nil {
 				return errors.Wrap(err, "updating RuleSet")
 			}
+		case *HostnameAction:
+			err := clb.updateHostname(ctx, lbID, a, spec)
+			if err != nil {
+				return errors.Wrap(err, "updating Hostname")
+			}
+		case *PathRouteSetAction:
+			err := clb.updatePathRouteSet(ctx, lbID, a, spec)
+			if err != nil {
+				return errors.Wrap(err, "updating PathRouteSet")
+			}
+		case *RoutingPolicyAction:
+			err := clb.updateRoutingPolicy(ctx, lbID, a, spec)
+			if err != nil {
+				return errors.Wrap(err, "updating RoutingPolicy")
+			}
 		}
 	}
 
@@ -1384,8 +1451,141 @@ func (clb *CloudLoadBalancerProvider) updateRuleSet(ctx context.Context, lbID st
 	return nil
 }
 
+func (clb *CloudLoadBalancerProvider) updateHostname(ctx context.Context, lbID string, action *HostnameAction, spec *LBSpec) (err error) {
+	var workRequestID string
+
+	logger := clb.logger.With(
+		"actionType", action.Type(),
+		"hostnameName", action.Name(),
+		"loadBalancerID", lbID,
+		"loadBalancerType", getLoadBalancerType(spec.service))
+	logger.Info("Applying action on hostname")
+
+	switch action.Type() {
+	case Create:
+		workRequestID, err = clb.lbClient.CreateHostname(ctx, lbID, action.Name(), &action.HostnameDetails)
+	case Update:
+		workRequestID, err = clb.lbClient.UpdateHostname(ctx, lbID, action.Name(), &action.HostnameDetails)
+	case Delete:
+		workRequestID, err = clb.lbClient.DeleteHostname(ctx, lbID, action.Name())
+	}
+
+	if err != nil {
+		return err
+	}
+	logger = logger.With("workRequestID", workRequestID)
+	logger.Info("Await work request for loadbalancer hostname")
+	_, err = clb.lbClient.AwaitWorkRequest(ctx, workRequestID)
+	if err != nil {
+		return err
+	}
+	logger.Info("Work request for loadbalancer hostname completed successfully")
+
+	return nil
+}
+
+func (clb *CloudLoadBalancerProvider) updatePathRouteSet(ctx context.Context, lbID string, action *PathRouteSetAction, spec *LBSpec) (err error) {
+	var workRequestID string
+
+	logger := clb.logger.With(
+		"actionType", action.Type(),
+		"pathRouteSetName", action.Name(),
+		"loadBalancerID", lbID,
+		"loadBalancerType", getLoadBalancerType(spec.service))
+	logger.Info("Applying action on path route set")
+
+	switch action.Type() {
+	case Create:
+		workRequestID, err = clb.lbClient.CreatePathRouteSet(ctx, lbID, action.Name(), &action.PathRouteSetDetails)
+	case Update:
+		workRequestID, err = clb.lbClient.UpdatePathRouteSet(ctx, lbID, action.Name(), &action.PathRouteSetDetails)
+	case Delete:
+		workRequestID, err = clb.lbClient.DeletePathRouteSet(ctx, lbID, action.Name())
+	}
+
+	if err != nil {
+		return err
+	}
+	logger = logger.With("workRequestID", workRequestID)
+	logger.Info("Await work request for loadbalancer path route set")
+	_, err = clb.lbClient.AwaitWorkRequest(ctx, workRequestID)
+	if err != nil {
+		return err
+	}
+	logger.Info("Work request for loadbalancer path route set completed successfully")
+
+	return nil
+}
+
+func (clb *CloudLoadBalancerProvider) updateRoutingPolicy(ctx context.Context, lbID string, action *RoutingPolicyAction, spec *LBSpec) (err error) {
+	var workRequestID string
+
+	logger := clb.logger.With(
+		"actionType", action.Type(),
+		"routingPolicyName", action.Name(),
+		"loadBalancerID", lbID,
+		"loadBalancerType", getLoadBalancerType(spec.service))
+	logger.Info("Applying action on routing policy")
+
+	switch action.Type() {
+	case Create:
+		workRequestID, err = clb.lbClient.CreateRoutingPolicy(ctx, lbID, action.Name(), &action.RoutingPolicyDetails)
+	case Update:
+		workRequestID, err = clb.lbClient.UpdateRoutingPolicy(ctx, lbID, action.Name(), &action.RoutingPolicyDetails)
+	case Delete:
+		workRequestID, err = clb.lbClient.DeleteRoutingPolicy(ctx, lbID, action.Name())
+	}
+
+	if err != nil {
+		return err
+	}
+	logger = logger.With("workRequestID", workRequestID)
+	logger.Info("Await work request for loadbalancer routing policy")
+	_, err = clb.lbClient.AwaitWorkRequest(ctx, workRequestID)
+	if err != nil {
+		return err
+	}
+	logger.Info("Work request for loadbalancer routing policy completed successfully")
+
+	return nil
+}
+
+// attachRoutingPolicies creates the routing policies of a newly created load
+// balancer and attaches them to their listeners. Unlike hostnames and path
+// route sets, routing policies can not be created along with the load balancer.
+func (clb *CloudLoadBalancerProvider) attachRoutingPolicies(ctx context.Context, lbID string, spec *LBSpec) error {
+	for name, details := range spec.RoutingPolicies {
+		err := clb.updateRoutingPolicy(ctx, lbID, &RoutingPolicyAction{
+			name:                 name,
+			RoutingPolicyDetails: details,
+			actionType:           Create,
+		}, spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, listener := range spec.Listeners {
+		if listener.RoutingPolicyName == nil {
+			continue
+		}
+		workRequestID, err := clb.lbClient.UpdateListener(ctx, lbID, name, &listener)
+		if err != nil {
+			return err
+		}
+		_, err = clb.lbClient.AwaitWorkRequest(ctx, workRequestID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // UpdateLoadBalancer updates an existing loadbalancer
 func (cp *CloudProvider) UpdateLoadBalancer(ctx context.Context, clusterName string, service *v1.Service, nodes []*v1.Node) error {
+	if err := cp.ensureClusterUID(ctx, service); err != nil {
+		return err
+	}
 	startTime := time.Now()
 	lbName := GetLoadBalancerName(service)
 	loadBalancerType := getLoadBalancerType(service)
@@ -1402,7 +1602,7 @@ func (cp *CloudProvider) UpdateLoadBalancer(ctx context.Context, clusterName str
 		logger.Info("Service already deleted or no more exists")
 		return errors.New("Service already deleted or no more exists")
 	}
-	loadBalancerService := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+	loadBalancerService := getLoadBalancerLockKey(service)
 	if acquired := cp.lbLocks.TryAcquire(loadBalancerService); !acquired {
 		logger.Error("Could not acquire lock for Updating Load Balancer")
 		return LbOperationAlreadyExists
@@ -1594,6 +1794,9 @@ func (cp *CloudProvider) getNodesAndPodsByIPs(ctx context.Context, backendIPs []
 // returning nil if the load balancer specified either didn't exist or was
 // successfully deleted.
 func (cp *CloudProvider) EnsureLoadBalancerDeleted(ctx context.Context, clusterName string, service *v1.Service) error {
+	if err := cp.ensureClusterUID(ctx, service); err != nil {
+		return err
+	}
 	startTime := time.Now()
 	name := cp.GetLoadBalancerName(ctx, clusterName, service)
 	loadBalancerType := getLoadBalancerType(service)
@@ -1602,7 +1805,7 @@ func (cp *CloudProvider) EnsureLoadBalancerDeleted(ctx context.Context, clusterN
 		logger = logger.With("serviceAccount", sa, "nameSpace", service.Namespace)
 	}
 	logger.Debug("Attempting to delete load balancer")
-	loadBalancerService := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+	loadBalancerService := getLoadBalancerLockKey(service)
 	if acquired := cp.lbLocks.TryAcquire(loadBalancerService); !acquired {
 		logger.Error("Could not acquire lock for Deleting Load Balancer")
 		return LbOperationAlreadyExists
@@ -1674,6 +1877,17 @@ func (cp *CloudProvider) EnsureLoadBalancerDeleted(ctx context.Context, clusterN
 	dimensionsMap[metrics.ResourceOCIDDimension] = id
 	logger = logger.With("loadBalancerID", id, "loadBalancerType", getLoadBalancerType(service))
 
+	if _, shared := getSharedLoadBalancerGroup(service); shared && loadBalancerType == LB {
+		members, err := cp.getSharedLoadBalancerMembers(service)
+		if err != nil {
+			return err
+		}
+		if len(members) > 0 {
+			logger.With("services", len(members)).Info("Load balancer is still shared by other services, deleting the listeners of the service")
+			return cp.deleteSharedLoadBalancerListeners(ctx, logger, lbProvider, lb, service, members, securityRuleManagementMode)
+		}
+	}
+
 	if securityRuleManagementMode == NSG {
 		// List network security groups
 		nsgs := lb.NetworkSecurityGroupIds
@@ -1757,6 +1971,165 @@ func (cp *CloudProvider) EnsureLoadBalancerDeleted(ctx context.Context, clusterN
 	return nil
 }
 
+// ensureClusterUID looks up the UID of the cluster, which the names of shared
+// load balancers hold, the first time a shared load balancer is reconciled.
+// Other load balancers do not need it.
+func (cp *CloudProvider) ensureClusterUID(ctx context.Context, service *v1.Service) error {
+	if _, shared := getSharedLoadBalancerGroup(service); !shared || getLoadBalancerType(service) != LB || getClusterUIDHash() != "" {
+		return nil
+	}
+	clusterUID, err := util.LookupClusterUID(ctx, cp.kubeclient)
+	if err != nil {
+		return errors.Wrap(err, "getting the cluster UID of the shared load balancer")
+	}
+	setClusterUID(clusterUID)
+	return nil
+}
+
+// getSharedLoadBalancerMembers returns the other services of the shared load
+// balancer group of the service, which are in the namespace of the service.
+func (cp *CloudProvider) getSharedLoadBalancerMembers(service *v1.Service) ([]*v1.Service, error) {
+	group, _ := getSharedLoadBalancerGroup(service)
+	services, err := cp.ServiceLister.Services(service.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.Wrap(err, "listing services")
+	}
+
+	var members []*v1.Service
+	for _, svc := range services {
+		if svc.UID == service.UID || svc.DeletionTimestamp != nil || svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+			continue
+		}
+		if g, shared := getSharedLoadBalancerGroup(svc); shared && g == group && getLoadBalancerType(svc) == LB {
+			members = append(members, svc)
+		}
+	}
+	return members, nil
+}
+
+// getSharedLoadBalancerView returns the shared load balancer restricted to the
+// listeners and backend sets the service manages, and the objects declared by
+// the other services of its group. Ports of the service that are owned by
+// another service of the group, and load balancer settings differing from
+// those of the owner of the load balancer, are reported as an event on the
+// service and an error.
+func (cp *CloudProvider) getSharedLoadBalancerView(ctx context.Context, service *v1.Service, lb *client.GenericLoadBalancer) (*client.GenericLoadBalancer, *sharedLoadBalancerObjects, error) {
+	members, err := cp.getSharedLoadBalancerMembers(service)
+	if err != nil {
+		return nil, nil, err
+	}
+	group := append(members, service)
+	owners := getSharedLoadBalancerPortOwners(group)
+
+	var conflicts []string
+	for _, servicePort := range service.Spec.Ports {
+		if owner := owners[int(servicePort.Port)]; owner.UID != service.UID {
+			conflicts = append(conflicts, fmt.Sprintf("port %d is used by service %s/%s", servicePort.Port, owner.Namespace, owner.Name))
+		}
+	}
+	if len(conflicts) > 0 {
+		msg := fmt.Sprintf("shared load balancer %q: %s", GetLoadBalancerName(service), strings.Join(conflicts, ", "))
+		if cp.recorder != nil {
+			cp.recorder.Event(service, v1.EventTypeWarning, "SharedLoadBalancerPortConflict", msg)
+		}
+		return nil, nil, errors.New(msg)
+	}
+
+	if err := validateSharedLoadBalancerSettings(service, sortSharedLoadBalancerServices(group)[0]); err != nil {
+		msg := fmt.Sprintf("shared load balancer %q: %v", GetLoadBalancerName(service), err)
+		if cp.recorder != nil {
+			cp.recorder.Event(service, v1.EventTypeWarning, "SharedLoadBalancerSettingsConflict", msg)
+		}
+		return nil, nil, errors.New(msg)
+	}
+
+	declared, err := getSharedLoadBalancerObjects(members)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting the objects of the shared load balancer")
+	}
+
+	if lb == nil {
+		return nil, declared, nil
+	}
+	return filterLoadBalancerListeners(lb, func(port int) bool {
+		owner, ok := owners[port]
+		return !ok || owner.UID == service.UID
+	}), declared, nil
+}
+
+// deleteSharedLoadBalancerListeners deletes the listeners and backend sets of
+// the service from a load balancer still used by other services of its group,
+// along with the certificates, rule sets, hostnames, path route sets and
+// routing policies only the service declared.
+func (cp *CloudProvider) deleteSharedLoadBalancerListeners(ctx context.Context, logger *zap.SugaredLogger, lbProvider CloudLoadBalancerProvider, lb *client.GenericLoadBalancer, service *v1.Service, members []*v1.Service, securityRuleManagementMode string) error {
+	owners := getSharedLoadBalancerPortOwners(members)
+	servicePorts := sets.NewInt()
+	for _, servicePort := range service.Spec.Ports {
+		servicePorts.Insert(int(servicePort.Port))
+	}
+	owned := filterLoadBalancerListeners(lb, func(port int) bool {
+		_, used := owners[port]
+		return servicePorts.Has(port) && !used
+	})
+
+	declared, err := getSharedLoadBalancerObjects(members)
+	if err != nil {
+		return errors.Wrap(err, "getting the objects of the shared load balancer")
+	}
+	declaredByService, err := getSharedLoadBalancerObjects([]*v1.Service{service})
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to get the objects declared by the service, leaving them on the shared load balancer")
+		declaredByService, _ = getSharedLoadBalancerObjects(nil)
+	}
+
+	if securityRuleManagementMode != ManagementModeNone {
+		if err := cp.cleanupSecurityRulesForLoadBalancerDelete(owned, logger, ctx, service, GetLoadBalancerName(service), ""); err != nil {
+			return err
+		}
+	}
+
+	deleteObjects := func(kind string, names sets.String, deleteObject func(ctx context.Context, lbID, name string) (string, error)) error {
+		for _, name := range names.List() {
+			logger := logger.With("name", name)
+			logger.Infof("Deleting %s from shared load balancer", kind)
+			workRequestID, err := deleteObject(ctx, *lb.Id, name)
+			if err != nil {
+				return errors.Wrapf(err, "delete %s %q", kind, name)
+			}
+			if _, err = lbProvider.lbClient.AwaitWorkRequest(ctx, workRequestID); err != nil {
+				return errors.Wrapf(err, "awaiting deletion of %s %q", kind, name)
+			}
+		}
+		return nil
+	}
+	// Listeners refer to every other object, and path route sets and routing
+	// policies refer to backend sets, so they are deleted first.
+	if err := deleteObjects("listener", sets.StringKeySet(owned.Listeners), lbProvider.lbClient.DeleteListener); err != nil {
+		return err
+	}
+	routingPolicies := declaredByService.routingPolicies.Difference(declared.routingPolicies).Intersection(sets.StringKeySet(lb.RoutingPolicies))
+	if err := deleteObjects("routing policy", routingPolicies, lbProvider.lbClient.DeleteRoutingPolicy); err != nil {
+		return err
+	}
+	pathRouteSets := declaredByService.pathRouteSets.Difference(declared.pathRouteSets).Intersection(sets.StringKeySet(lb.PathRouteSets))
+	if err := deleteObjects("path route set", pathRouteSets, lbProvider.lbClient.DeletePathRouteSet); err != nil {
+		return err
+	}
+	if err := deleteObjects("backend set", sets.StringKeySet(owned.BackendSets), lbProvider.lbClient.DeleteBackendSet); err != nil {
+		return err
+	}
+	hostnames := declaredByService.hostnames.Difference(declared.hostnames).Intersection(sets.StringKeySet(lb.Hostnames))
+	if err := deleteObjects("hostname", hostnames, lbProvider.lbClient.DeleteHostname); err != nil {
+		return err
+	}
+	ruleSets := declaredByService.ruleSets.Difference(declared.ruleSets).Intersection(sets.StringKeySet(lb.RuleSets))
+	if err := deleteObjects("rule set", ruleSets, lbProvider.lbClient.DeleteRuleSet); err != nil {
+		return err
+	}
+	certificates := declaredByService.certificates.Difference(declared.certificates).Intersection(sets.StringKeySet(lb.Certificates))
+	return deleteObjects("certificate", certificates, lbProvider.lbClient.DeleteCertificate)
+}
+
 // Critical Section for Security List Updates
 func (cp *CloudProvider) cleanupSecurityRulesForLoadBalancerDelete(lb *client.GenericLoadBalancer, logger *zap.SugaredLogger, ctx context.Context, service *v1.Service, name string, frontendNsgOcid string) error {
 	updateRulesMutex.Lock()
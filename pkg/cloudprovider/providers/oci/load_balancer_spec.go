@@ -108,6 +108,29 @@ const (
 	// See: https://kubernetes.io/docs/concepts/services-networking/ingress/#tls
 	ServiceAnnotationLoadBalancerTLSBackendSetSecret = "service.beta.kubernetes.io/oci-load-balancer-tls-backendset-secret"
 
+	// ServiceAnnotationLoadBalancerTLSCertificateId is a Service annotation for
+	// specifying the OCID of the OCI Certificates service certificate of the load
+	// balancer listeners which have SSL enabled, instead of a TLS secret.
+	ServiceAnnotationLoadBalancerTLSCertificateId = "oci.oraclecloud.com/oci-load-balancer-tls-certificate-id"
+
+	// ServiceAnnotationLoadBalancerTLSCABundleIds is a Service annotation for
+	// specifying the comma separated OCIDs of the OCI Certificates service CA
+	// bundles the load balancer listeners which have SSL enabled verify the
+	// certificates of the clients with.
+	ServiceAnnotationLoadBalancerTLSCABundleIds = "oci.oraclecloud.com/oci-load-balancer-tls-ca-bundle-ids"
+
+	// ServiceAnnotationLoadBalancerTLSBackendSetCertificateId is a Service
+	// annotation for specifying the OCID of the OCI Certificates service
+	// certificate the load balancer presents to the backends of the ports which
+	// have SSL enabled, instead of a backend set secret.
+	ServiceAnnotationLoadBalancerTLSBackendSetCertificateId = "oci.oraclecloud.com/oci-load-balancer-tls-backendset-certificate-id"
+
+	// ServiceAnnotationLoadBalancerTLSBackendSetCABundleIds is a Service
+	// annotation for specifying the comma separated OCIDs of the OCI Certificates
+	// service CA bundles the load balancer verifies the certificates of the
+	// backends of the ports which have SSL enabled with.
+	ServiceAnnotationLoadBalancerTLSBackendSetCABundleIds = "oci.oraclecloud.com/oci-load-balancer-tls-backendset-ca-bundle-ids"
+
 	// ServiceAnnotationLoadBalancerConnectionIdleTimeout is the annotation used
 	// on the service to specify the idle connection timeout.
 	ServiceAnnotationLoadBalancerConnectionIdleTimeout = "service.beta.kubernetes.io/oci-load-balancer-connection-idle-timeout"
@@ -189,6 +212,57 @@ const (
 	// Expected format is a JSON blob containing a JSON object literal with keys being rule names and values being a JSON
 	// representation of a valid Rule object. https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/datatypes/Rule
 	ServiceAnnotationRuleSets = "oci.oraclecloud.com/oci-load-balancer-rule-sets"
+
+	// ServiceAnnotationLoadBalancerSessionPersistence is a service annotation for enabling session persistence
+	// on the backend sets of a load balancer ("app-cookie", "lb-cookie").
+	ServiceAnnotationLoadBalancerSessionPersistence = "oci.oraclecloud.com/oci-load-balancer-session-persistence"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceCookieName is a service annotation for specifying the name of
+	// the cookie used for session persistence. It is required for application cookie session persistence, where "*"
+	// matches any cookie set by the application.
+	ServiceAnnotationLoadBalancerSessionPersistenceCookieName = "oci.oraclecloud.com/oci-load-balancer-session-persistence-cookie-name"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceCookieDomain is a service annotation for specifying the domain of
+	// the cookie inserted by the load balancer for load balancer cookie session persistence.
+	ServiceAnnotationLoadBalancerSessionPersistenceCookieDomain = "oci.oraclecloud.com/oci-load-balancer-session-persistence-cookie-domain"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceCookiePath is a service annotation for specifying the path of
+	// the cookie inserted by the load balancer for load balancer cookie session persistence.
+	ServiceAnnotationLoadBalancerSessionPersistenceCookiePath = "oci.oraclecloud.com/oci-load-balancer-session-persistence-cookie-path"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge is a service annotation for specifying the max age,
+	// in seconds, of the cookie inserted by the load balancer for load balancer cookie session persistence.
+	ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge = "oci.oraclecloud.com/oci-load-balancer-session-persistence-cookie-max-age"
+
+	// ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback is a service annotation for specifying whether
+	// requests of a persisted session fail instead of going to another backend when the original backend is unavailable.
+	ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback = "oci.oraclecloud.com/oci-load-balancer-session-persistence-disable-fallback"
+
+	// ServiceAnnotationLoadBalancerHostnames allows the user to specify the virtual hostnames of a load balancer.
+	// Expected format is a JSON object with keys being hostname names and values being hostnames, for example
+	// {"app": "app.example.com"}.
+	ServiceAnnotationLoadBalancerHostnames = "oci.oraclecloud.com/oci-load-balancer-hostnames"
+
+	// ServiceAnnotationLoadBalancerPathRouteSets allows the user to specify path route sets which route the requests
+	// of a load balancer listener to backend sets by path. Expected format is a JSON object with keys being path route
+	// set names and values being a JSON representation of a valid PathRouteSetDetails object.
+	// https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/datatypes/PathRouteSetDetails
+	ServiceAnnotationLoadBalancerPathRouteSets = "oci.oraclecloud.com/oci-load-balancer-path-route-sets"
+
+	// ServiceAnnotationLoadBalancerRoutingPolicies allows the user to specify routing policies which route the requests
+	// of a load balancer listener to backend sets by conditions on the request. Expected format is a JSON object with
+	// keys being routing policy names and values being a JSON representation of a valid RoutingPolicyDetails object.
+	// https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/datatypes/RoutingPolicyDetails
+	ServiceAnnotationLoadBalancerRoutingPolicies = "oci.oraclecloud.com/oci-load-balancer-routing-policies"
+
+	// ServiceAnnotationLoadBalancerListenerRouting allows the user to attach hostnames and a path route set or a
+	// routing policy to the load balancer listener of a service port. Expected format is a JSON object with keys being
+	// ports and values being objects with the optional keys "hostnameNames", "pathRouteSetName" and "routingPolicyName".
+	ServiceAnnotationLoadBalancerListenerRouting = "oci.oraclecloud.com/oci-load-balancer-listener-routing"
+
+	// ServiceAnnotationLoadBalancerSharedGroup is a service annotation for sharing one load balancer between all the
+	// services of a group. Each service of the group contributes its own listeners and backend sets to the load balancer.
+	ServiceAnnotationLoadBalancerSharedGroup = "oci.oraclecloud.com/oci-load-balancer-shared-group"
 )
 
 // NLB specific annotations
@@ -276,8 +350,32 @@ const (
 const (
 	ProtocolGrpc              = "GRPC"
 	DefaultCipherSuiteForGRPC = "oci-default-http2-ssl-cipher-suite-v1"
+
+	// DefaultSSLVerifyDepth is the depth of the verification of the certificate
+	// chains of the peers of listeners and backend sets trusting CA bundles,
+	// unless set by the SSL configuration annotations.
+	DefaultSSLVerifyDepth = 3
+)
+
+// Session persistence types of a load balancer backend set.
+const (
+	AppCookieSessionPersistence = "app-cookie"
+	LBCookieSessionPersistence  = "lb-cookie"
+
+	// DefaultLBCookieName and DefaultLBCookiePath are the name and path the load balancer
+	// uses for the cookie it inserts when they are not specified.
+	DefaultLBCookieName = "X-Oracle-BMC-LBS-Route"
+	DefaultLBCookiePath = "/"
 )
 
+// listenerRouting is the hostnames and the path route set or routing policy of
+// a load balancer listener.
+type listenerRouting struct {
+	HostnameNames     []string `json:"hostnameNames"`
+	PathRouteSetName  *string  `json:"pathRouteSetName"`
+	RoutingPolicyName *string  `json:"routingPolicyName"`
+}
+
 // certificateData is a structure containing the data about a K8S secret required
 // to store SSL information required for BackendSets and Listeners
 type certificateData struct {
@@ -308,9 +406,46 @@ type SSLConfig struct {
 	BackendSetSSLSecretName      string
 	BackendSetSSLSecretNamespace string
 
+	// The OCIDs of the OCI Certificates service certificates and CA bundles
+	// used instead of, or along with, the certificates of the secrets.
+	ListenerCertificateIds                   []string
+	ListenerTrustedCertificateAuthorityIds   []string
+	BackendSetCertificateIds                 []string
+	BackendSetTrustedCertificateAuthorityIds []string
+
 	sslSecretReader
 }
 
+// sslCertificates are the certificates of the listeners or of the backend sets
+// of a load balancer: the name of a certificate created from a secret or the
+// OCID of a certificate of the OCI Certificates service, and the OCIDs of the
+// CA bundles verifying the certificates of the peers.
+type sslCertificates struct {
+	name                           string
+	certificateIds                 []string
+	trustedCertificateAuthorityIds []string
+}
+
+func (c sslCertificates) isEmpty() bool {
+	return c.name == "" && len(c.certificateIds) == 0 && len(c.trustedCertificateAuthorityIds) == 0
+}
+
+func (cfg *SSLConfig) listenerCertificates() sslCertificates {
+	return sslCertificates{
+		name:                           cfg.ListenerSSLSecretName,
+		certificateIds:                 cfg.ListenerCertificateIds,
+		trustedCertificateAuthorityIds: cfg.ListenerTrustedCertificateAuthorityIds,
+	}
+}
+
+func (cfg *SSLConfig) backendSetCertificates() sslCertificates {
+	return sslCertificates{
+		name:                           cfg.BackendSetSSLSecretName,
+		certificateIds:                 cfg.BackendSetCertificateIds,
+		trustedCertificateAuthorityIds: cfg.BackendSetTrustedCertificateAuthorityIds,
+	}
+}
+
 type ManagedNetworkSecurityGroup struct {
 	nsgRuleManagementMode string
 	frontendNsgId         string
@@ -342,7 +477,7 @@ func NewSSLConfig(secretListenerString string, secretBackendSetString string, se
 	listenerSecretName, listenerSecretNamespace := getSecretParts(secretListenerString, service)
 	backendSecretName, backendSecretNamespace := getSecretParts(secretBackendSetString, service)
 
-	return &SSLConfig{
+	cfg := &SSLConfig{
 		Ports:                        sets.NewInt(ports...),
 		ListenerSSLSecretName:        listenerSecretName,
 		ListenerSSLSecretNamespace:   listenerSecretNamespace,
@@ -350,6 +485,64 @@ func NewSSLConfig(secretListenerString string, secretBackendSetString string, se
 		BackendSetSSLSecretNamespace: backendSecretNamespace,
 		sslSecretReader:              ssr,
 	}
+	if service != nil {
+		cfg.ListenerCertificateIds = getCertificateOCIDs(service, ServiceAnnotationLoadBalancerTLSCertificateId)
+		cfg.ListenerTrustedCertificateAuthorityIds = getCertificateOCIDs(service, ServiceAnnotationLoadBalancerTLSCABundleIds)
+		cfg.BackendSetCertificateIds = getCertificateOCIDs(service, ServiceAnnotationLoadBalancerTLSBackendSetCertificateId)
+		cfg.BackendSetTrustedCertificateAuthorityIds = getCertificateOCIDs(service, ServiceAnnotationLoadBalancerTLSBackendSetCABundleIds)
+	}
+	return cfg
+}
+
+// getCertificateOCIDs returns the comma separated OCIDs of the OCI
+// Certificates service certificates or CA bundles of an annotation.
+func getCertificateOCIDs(svc *v1.Service, annotation string) []string {
+	var ocids []string
+	for _, ocid := range strings.Split(svc.Annotations[annotation], ",") {
+		if ocid = strings.TrimSpace(ocid); ocid != "" && !contains(ocids, ocid) {
+			ocids = append(ocids, ocid)
+		}
+	}
+	return ocids
+}
+
+// validateCertificateOCIDs validates the annotations referencing the OCI
+// Certificates service. A certificate can not be referenced along with a
+// secret, and only one certificate is supported.
+func validateCertificateOCIDs(svc *v1.Service) error {
+	for _, annotation := range []string{
+		ServiceAnnotationLoadBalancerTLSCertificateId,
+		ServiceAnnotationLoadBalancerTLSCABundleIds,
+		ServiceAnnotationLoadBalancerTLSBackendSetCertificateId,
+		ServiceAnnotationLoadBalancerTLSBackendSetCABundleIds,
+	} {
+		for _, ocid := range getCertificateOCIDs(svc, annotation) {
+			if !strings.HasPrefix(ocid, "ocid1.") {
+				return fmt.Errorf("invalid OCID: [%s] provided for annotation: %s", ocid, annotation)
+			}
+		}
+	}
+
+	for _, annotations := range [][2]string{
+		{ServiceAnnotationLoadBalancerTLSCertificateId, ServiceAnnotationLoadBalancerTLSSecret},
+		{ServiceAnnotationLoadBalancerTLSBackendSetCertificateId, ServiceAnnotationLoadBalancerTLSBackendSetSecret},
+	} {
+		certificateAnnotation, secretAnnotation := annotations[0], annotations[1]
+		certificateIds := getCertificateOCIDs(svc, certificateAnnotation)
+		if len(certificateIds) > 1 {
+			return fmt.Errorf("only one certificate OCID can be provided for annotation: %s", certificateAnnotation)
+		}
+		if len(certificateIds) > 0 && svc.Annotations[secretAnnotation] != "" {
+			return fmt.Errorf("annotations %s and %s can not be used together", certificateAnnotation, secretAnnotation)
+		}
+	}
+
+	// Listeners terminating SSL need a certificate of their own.
+	if svc.Annotations[ServiceAnnotationLoadBalancerTLSCABundleIds] != "" && svc.Annotations[ServiceAnnotationLoadBalancerTLSSecret] == "" &&
+		len(getCertificateOCIDs(svc, ServiceAnnotationLoadBalancerTLSCertificateId)) == 0 {
+		return fmt.Errorf("annotation %s requires annotation %s or %s", ServiceAnnotationLoadBalancerTLSCABundleIds, ServiceAnnotationLoadBalancerTLSCertificateId, ServiceAnnotationLoadBalancerTLSSecret)
+	}
+	return nil
 }
 
 // LBSpec holds the data required to build a OCI load balancer from a
@@ -379,11 +572,17 @@ type LBSpec struct {
 	ingressIpMode               *v1.LoadBalancerIPMode
 	Compartment                 string
 	RuleSets                    map[string]loadbalancer.RuleSetDetails
+	Hostnames                   map[string]loadbalancer.HostnameDetails
+	PathRouteSets               map[string]loadbalancer.PathRouteSetDetails
+	RoutingPolicies             map[string]loadbalancer.RoutingPolicyDetails
 	AssignedPrivateIpv4         *string
 	AssignedIpv6                *string
 
 	service *v1.Service
 	nodes   []*v1.Node
+	// sharedObjects are the objects declared by the other services of a
+	// shared load balancer, which the service must not delete.
+	sharedObjects *sharedLoadBalancerObjects
 }
 
 // NewLBSpec creates a LB Spec from a Kubernetes service and a slice of nodes.
@@ -402,6 +601,10 @@ func NewLBSpec(logger *zap.SugaredLogger, svc *v1.Service, provisionedNodes []*v
 		ListenerBackendIpVersion: versions.ListenerBackendIpVersion,
 	}
 
+	if err := validateSharedLoadBalancer(svc); err != nil {
+		return nil, err
+	}
+
 	internal, err := isInternalLB(svc)
 	if err != nil {
 		return nil, err
@@ -422,6 +625,21 @@ func NewLBSpec(logger *zap.SugaredLogger, svc *v1.Service, provisionedNodes []*v
 		return nil, err
 	}
 
+	hostnames, err := getHostnames(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	pathRouteSets, err := getPathRouteSets(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	routingPolicies, err := getRoutingPolicies(svc)
+	if err != nil {
+		return nil, err
+	}
+
 	listeners, err := getListeners(svc, sslConfig, convertOciIpVersionsToOciIpFamilies(versions.ListenerBackendIpVersion))
 	if err != nil {
 		return nil, err
@@ -514,6 +732,9 @@ func NewLBSpec(logger *zap.SugaredLogger, svc *v1.Service, provisionedNodes []*v
 		ingressIpMode:               ingressIpMode,
 		Compartment:                 compartment,
 		RuleSets:                    ruleSets,
+		Hostnames:                   hostnames,
+		PathRouteSets:               pathRouteSets,
+		RoutingPolicies:             routingPolicies,
 		AssignedPrivateIpv4:         assignedPrivateIpv4,
 		AssignedIpv6:                assignedIpv6,
 	}, nil
@@ -685,6 +906,12 @@ func validateService(svc *v1.Service) error {
 		return errors.New("OCI only supports SessionAffinity \"None\" currently")
 	}
 
+	if lbType == LB {
+		if err := validateCertificateOCIDs(svc); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -834,14 +1061,16 @@ func getBackendSets(logger *zap.SugaredLogger, svc *v1.Service, provisionedNodes
 	if err != nil {
 		return nil, err
 	}
+	sessionPersistenceConfiguration, lbCookieSessionPersistenceConfiguration, err := getSessionPersistenceConfiguration(svc)
+	if err != nil {
+		return nil, err
+	}
 
 	for backendSetName, servicePort := range getBackendSetNamePortMap(svc) {
-		var secretName string
 		var sslConfiguration *client.GenericSslConfigurationDetails
-		if sslCfg != nil && len(sslCfg.BackendSetSSLSecretName) != 0 && getLoadBalancerType(svc) == LB {
-			secretName = sslCfg.BackendSetSSLSecretName
+		if sslCfg != nil && !sslCfg.backendSetCertificates().isEmpty() && getLoadBalancerType(svc) == LB {
 			backendSetSSLConfig, _ := svc.Annotations[ServiceAnnotationLoadbalancerBackendSetSSLConfig]
-			sslConfiguration, err = getSSLConfiguration(sslCfg, secretName, int(servicePort.Port), backendSetSSLConfig)
+			sslConfiguration, err = getSSLConfiguration(sslCfg, sslCfg.backendSetCertificates(), int(servicePort.Port), backendSetSSLConfig)
 			if err != nil {
 				return nil, err
 			}
@@ -853,11 +1082,13 @@ func getBackendSets(logger *zap.SugaredLogger, svc *v1.Service, provisionedNodes
 		backendsIPv4, backendsIPv6 := getBackends(logger, provisionedNodes, servicePort.NodePort)
 
 		genericBackendSetDetails := client.GenericBackendSetDetails{
-			Name:             common.String(backendSetName),
-			Policy:           &loadbalancerPolicy,
-			HealthChecker:    healthChecker,
-			IsPreserveSource: &isPreserveSource,
-			SslConfiguration: sslConfiguration,
+			Name:                                    common.String(backendSetName),
+			Policy:                                  &loadbalancerPolicy,
+			HealthChecker:                           healthChecker,
+			IsPreserveSource:                        &isPreserveSource,
+			SslConfiguration:                        sslConfiguration,
+			SessionPersistenceConfiguration:         sessionPersistenceConfiguration,
+			LbCookieSessionPersistenceConfiguration: lbCookieSessionPersistenceConfiguration,
 		}
 
 		if strings.Contains(backendSetName, IPv6) && contains(listenerBackendIpVersion, IPv6) {
@@ -1019,15 +1250,15 @@ func getHealthCheckTimeout(svc *v1.Service) (int, error) {
 }
 
 func GetSSLConfiguration(cfg *SSLConfig, name string, port int, sslConfigAnnotation string) (*client.GenericSslConfigurationDetails, error) {
-	sslConfig, err := getSSLConfiguration(cfg, name, port, sslConfigAnnotation)
+	sslConfig, err := getSSLConfiguration(cfg, sslCertificates{name: name}, port, sslConfigAnnotation)
 	if err != nil {
 		return nil, err
 	}
 	return sslConfig, nil
 }
 
-func getSSLConfiguration(cfg *SSLConfig, name string, port int, lbSslConfigurationAnnotation string) (*client.GenericSslConfigurationDetails, error) {
-	if cfg == nil || !cfg.Ports.Has(port) || len(name) == 0 {
+func getSSLConfiguration(cfg *SSLConfig, certificates sslCertificates, port int, lbSslConfigurationAnnotation string) (*client.GenericSslConfigurationDetails, error) {
+	if cfg == nil || !cfg.Ports.Has(port) || certificates.isEmpty() {
 		return nil, nil
 	}
 	// TODO: fast-follow to pass the sslconfiguration object directly to loadbalancer
@@ -1040,10 +1271,22 @@ func getSSLConfiguration(cfg *SSLConfig, name string, port int, lbSslConfigurati
 		}
 	}
 	genericSSLConfigurationDetails := &client.GenericSslConfigurationDetails{
-		CertificateName:       &name,
 		VerifyDepth:           common.Int(0),
 		VerifyPeerCertificate: common.Bool(false),
 	}
+	if certificates.name != "" {
+		genericSSLConfigurationDetails.CertificateName = common.String(certificates.name)
+	}
+	genericSSLConfigurationDetails.CertificateIds = certificates.certificateIds
+	if len(certificates.trustedCertificateAuthorityIds) > 0 {
+		// Peers are verified against the trusted CA bundles.
+		genericSSLConfigurationDetails.TrustedCertificateAuthorityIds = certificates.trustedCertificateAuthorityIds
+		genericSSLConfigurationDetails.VerifyPeerCertificate = common.Bool(true)
+		genericSSLConfigurationDetails.VerifyDepth = common.Int(DefaultSSLVerifyDepth)
+		if extractCipherSuite != nil && extractCipherSuite.VerifyDepth != nil {
+			genericSSLConfigurationDetails.VerifyDepth = extractCipherSuite.VerifyDepth
+		}
+	}
 	if extractCipherSuite != nil {
 		genericSSLConfigurationDetails.CipherSuiteName = extractCipherSuite.CipherSuiteName
 		genericSSLConfigurationDetails.Protocols = extractCipherSuite.Protocols
@@ -1090,6 +1333,11 @@ func getListenersOciLoadBalancer(svc *v1.Service, sslCfg *SSLConfig) (map[string
 		slices.Sort(rs)
 	}
 
+	routing, err := getListenerRouting(svc)
+	if err != nil {
+		return nil, err
+	}
+
 	listeners := make(map[string]client.GenericListener)
 	for _, servicePort := range svc.Spec.Ports {
 		protocol := string(servicePort.Protocol)
@@ -1107,13 +1355,11 @@ func getListenersOciLoadBalancer(svc *v1.Service, sslCfg *SSLConfig) (map[string
 		}
 		port := int(servicePort.Port)
 
-		var secretName string
 		var err error
 		var sslConfiguration *client.GenericSslConfigurationDetails
-		if sslCfg != nil && len(sslCfg.ListenerSSLSecretName) != 0 {
-			secretName = sslCfg.ListenerSSLSecretName
+		if sslCfg != nil && !sslCfg.listenerCertificates().isEmpty() {
 			listenerCipherSuiteAnnotation, _ := svc.Annotations[ServiceAnnotationLoadbalancerListenerSSLConfig]
-			sslConfiguration, err = getSSLConfiguration(sslCfg, secretName, port, listenerCipherSuiteAnnotation)
+			sslConfiguration, err = getSSLConfiguration(sslCfg, sslCfg.listenerCertificates(), port, listenerCipherSuiteAnnotation)
 			if err != nil {
 				return nil, err
 			}
@@ -1138,6 +1384,15 @@ func getListenersOciLoadBalancer(svc *v1.Service, sslCfg *SSLConfig) (map[string
 			SslConfiguration:      sslConfiguration,
 		}
 
+		if r, ok := routing[port]; ok {
+			if strings.EqualFold(protocol, "TCP") {
+				return nil, fmt.Errorf("invalid annotation %s. Hostnames, path route sets and routing policies are not supported by TCP listeners, port %d", ServiceAnnotationLoadBalancerListenerRouting, port)
+			}
+			listener.HostnameNames = r.HostnameNames
+			listener.PathRouteSetName = r.PathRouteSetName
+			listener.RoutingPolicyName = r.RoutingPolicyName
+		}
+
 		// If proxy protocol has been set, we also need to set connectionIdleTimeout
 		// because it's a required parameter as per the LB API contract.
 		// The default value is dependent on the protocol used for the listener.
@@ -1443,6 +1698,85 @@ func getLoadBalancerPolicy(svc *v1.Service) (string, error) {
 	return "", fmt.Errorf("loadbalancer policy \"%s\" is not valid", annotationValue)
 }
 
+// getSessionPersistenceConfiguration returns the application cookie or the load balancer cookie session persistence
+// configuration of the backend sets of a load balancer, of which at most one is set.
+func getSessionPersistenceConfiguration(svc *v1.Service) (*client.GenericSessionPersistenceConfiguration, *client.GenericLbCookieSessionPersistenceConfiguration, error) {
+	persistenceType, ok := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistence]
+	if !ok {
+		for _, annotation := range []string{
+			ServiceAnnotationLoadBalancerSessionPersistenceCookieName,
+			ServiceAnnotationLoadBalancerSessionPersistenceCookieDomain,
+			ServiceAnnotationLoadBalancerSessionPersistenceCookiePath,
+			ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge,
+			ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback,
+		} {
+			if _, ok := svc.Annotations[annotation]; ok {
+				return nil, nil, fmt.Errorf("service annotation %s requires service annotation %s", annotation, ServiceAnnotationLoadBalancerSessionPersistence)
+			}
+		}
+		return nil, nil, nil
+	}
+	if getLoadBalancerType(svc) == NLB {
+		return nil, nil, fmt.Errorf("session persistence is not supported for network load balancers")
+	}
+
+	disableFallback := false
+	if annotationValue, ok := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback]; ok {
+		var err error
+		disableFallback, err = strconv.ParseBool(annotationValue)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing service annotation: %s=%s", ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback, annotationValue)
+		}
+	}
+	cookieName := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceCookieName]
+
+	switch persistenceType {
+	case AppCookieSessionPersistence:
+		if cookieName == "" {
+			return nil, nil, fmt.Errorf("service annotation %s is required for %s session persistence", ServiceAnnotationLoadBalancerSessionPersistenceCookieName, AppCookieSessionPersistence)
+		}
+		for _, annotation := range []string{
+			ServiceAnnotationLoadBalancerSessionPersistenceCookieDomain,
+			ServiceAnnotationLoadBalancerSessionPersistenceCookiePath,
+			ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge,
+		} {
+			if _, ok := svc.Annotations[annotation]; ok {
+				return nil, nil, fmt.Errorf("service annotation %s is only supported for %s session persistence", annotation, LBCookieSessionPersistence)
+			}
+		}
+		return &client.GenericSessionPersistenceConfiguration{
+			CookieName:      common.String(cookieName),
+			DisableFallback: common.Bool(disableFallback),
+		}, nil, nil
+	case LBCookieSessionPersistence:
+		if cookieName == "" {
+			cookieName = DefaultLBCookieName
+		}
+		cookiePath := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceCookiePath]
+		if cookiePath == "" {
+			cookiePath = DefaultLBCookiePath
+		}
+		config := &client.GenericLbCookieSessionPersistenceConfiguration{
+			CookieName:      common.String(cookieName),
+			Path:            common.String(cookiePath),
+			DisableFallback: common.Bool(disableFallback),
+		}
+		if domain := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceCookieDomain]; domain != "" {
+			config.Domain = common.String(domain)
+		}
+		if annotationValue, ok := svc.Annotations[ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge]; ok {
+			maxAge, err := strconv.Atoi(annotationValue)
+			if err != nil || maxAge < 1 {
+				return nil, nil, fmt.Errorf("error parsing service annotation: %s=%s", ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge, annotationValue)
+			}
+			config.MaxAgeInSeconds = common.Int(maxAge)
+		}
+		return nil, config, nil
+	}
+
+	return nil, nil, fmt.Errorf("session persistence \"%s\" is not valid, supported values are %s and %s", persistenceType, AppCookieSessionPersistence, LBCookieSessionPersistence)
+}
+
 func getLoadBalancerIP(svc *v1.Service) (string, error) {
 	// There are no changes here wrt NLB since NLB doesn't support private ip reservation
 
@@ -1713,6 +2047,208 @@ func getRuleSets(svc *v1.Service) (rs map[string]loadbalancer.RuleSetDetails, er
 	return rs, err
 }
 
+func getHostnames(svc *v1.Service) (map[string]loadbalancer.HostnameDetails, error) {
+	annotation, exists := svc.Annotations[ServiceAnnotationLoadBalancerHostnames]
+	if !exists {
+		return nil, nil
+	}
+
+	if getLoadBalancerType(svc) == NLB {
+		return nil, fmt.Errorf("invalid annotation %s. Hostnames are not supported by Network Load Balancer", ServiceAnnotationLoadBalancerHostnames)
+	}
+
+	if annotation == "" {
+		annotation = "{}"
+	}
+	var hostnames map[string]string
+	if err := json.NewDecoder(strings.NewReader(annotation)).Decode(&hostnames); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse annotation %s", ServiceAnnotationLoadBalancerHostnames)
+	}
+
+	hn := make(map[string]loadbalancer.HostnameDetails, len(hostnames))
+	for name, hostname := range hostnames {
+		if hostname == "" {
+			return nil, fmt.Errorf("invalid annotation %s. Hostname %q is empty", ServiceAnnotationLoadBalancerHostnames, name)
+		}
+		hn[name] = loadbalancer.HostnameDetails{
+			Name:     common.String(name),
+			Hostname: common.String(hostname),
+		}
+	}
+	return hn, nil
+}
+
+func getPathRouteSets(svc *v1.Service) (prs map[string]loadbalancer.PathRouteSetDetails, err error) {
+	annotation, exists := svc.Annotations[ServiceAnnotationLoadBalancerPathRouteSets]
+	if !exists {
+		return nil, nil
+	}
+
+	if getLoadBalancerType(svc) == NLB {
+		return prs, fmt.Errorf("invalid annotation %s. Path Route Sets are not supported by Network Load Balancer", ServiceAnnotationLoadBalancerPathRouteSets)
+	}
+
+	if annotation == "" {
+		annotation = "{}"
+	}
+	err = json.NewDecoder(strings.NewReader(annotation)).Decode(&prs)
+	return prs, err
+}
+
+func getRoutingPolicies(svc *v1.Service) (rp map[string]loadbalancer.RoutingPolicyDetails, err error) {
+	annotation, exists := svc.Annotations[ServiceAnnotationLoadBalancerRoutingPolicies]
+	if !exists {
+		return nil, nil
+	}
+
+	if getLoadBalancerType(svc) == NLB {
+		return rp, fmt.Errorf("invalid annotation %s. Routing Policies are not supported by Network Load Balancer", ServiceAnnotationLoadBalancerRoutingPolicies)
+	}
+
+	if annotation == "" {
+		annotation = "{}"
+	}
+	err = json.NewDecoder(strings.NewReader(annotation)).Decode(&rp)
+	return rp, err
+}
+
+// getListenerRouting returns the hostnames and the path route set or routing
+// policy of the load balancer listeners by port. They must be declared by the
+// hostnames, path route sets and routing policies annotations of the service.
+func getListenerRouting(svc *v1.Service) (map[int]listenerRouting, error) {
+	annotation, exists := svc.Annotations[ServiceAnnotationLoadBalancerListenerRouting]
+	if !exists {
+		return nil, nil
+	}
+
+	if getLoadBalancerType(svc) == NLB {
+		return nil, fmt.Errorf("invalid annotation %s. Listener routing is not supported by Network Load Balancer", ServiceAnnotationLoadBalancerListenerRouting)
+	}
+
+	if annotation == "" {
+		annotation = "{}"
+	}
+	var routingByPort map[string]listenerRouting
+	if err := json.NewDecoder(strings.NewReader(annotation)).Decode(&routingByPort); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse annotation %s", ServiceAnnotationLoadBalancerListenerRouting)
+	}
+
+	hostnames, err := getHostnames(svc)
+	if err != nil {
+		return nil, err
+	}
+	pathRouteSets, err := getPathRouteSets(svc)
+	if err != nil {
+		return nil, err
+	}
+	routingPolicies, err := getRoutingPolicies(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePorts := sets.NewInt()
+	for _, servicePort := range svc.Spec.Ports {
+		servicePorts.Insert(int(servicePort.Port))
+	}
+
+	routing := make(map[int]listenerRouting, len(routingByPort))
+	for p, r := range routingByPort {
+		port, err := strconv.Atoi(p)
+		if err != nil || !servicePorts.Has(port) {
+			return nil, fmt.Errorf("invalid annotation %s. %q is not a port of the service", ServiceAnnotationLoadBalancerListenerRouting, p)
+		}
+		for _, name := range r.HostnameNames {
+			if _, ok := hostnames[name]; !ok {
+				return nil, fmt.Errorf("invalid annotation %s. Hostname %q is not declared in annotation %s", ServiceAnnotationLoadBalancerListenerRouting, name, ServiceAnnotationLoadBalancerHostnames)
+			}
+		}
+		if r.PathRouteSetName != nil && r.RoutingPolicyName != nil {
+			return nil, fmt.Errorf("invalid annotation %s. Port %d can not use both a path route set and a routing policy", ServiceAnnotationLoadBalancerListenerRouting, port)
+		}
+		if r.PathRouteSetName != nil {
+			if _, ok := pathRouteSets[*r.PathRouteSetName]; !ok {
+				return nil, fmt.Errorf("invalid annotation %s. Path route set %q is not declared in annotation %s", ServiceAnnotationLoadBalancerListenerRouting, *r.PathRouteSetName, ServiceAnnotationLoadBalancerPathRouteSets)
+			}
+		}
+		if r.RoutingPolicyName != nil {
+			if _, ok := routingPolicies[*r.RoutingPolicyName]; !ok {
+				return nil, fmt.Errorf("invalid annotation %s. Routing policy %q is not declared in annotation %s", ServiceAnnotationLoadBalancerListenerRouting, *r.RoutingPolicyName, ServiceAnnotationLoadBalancerRoutingPolicies)
+			}
+		}
+		if len(r.HostnameNames) > 0 {
+			slices.Sort(r.HostnameNames)
+		}
+		routing[port] = r
+	}
+	return routing, nil
+}
+
+// getSharedLoadBalancerGroup returns the shared load balancer group of the
+// service, if any.
+func getSharedLoadBalancerGroup(svc *v1.Service) (string, bool) {
+	group, ok := svc.Annotations[ServiceAnnotationLoadBalancerSharedGroup]
+	return group, ok
+}
+
+func validateSharedLoadBalancer(svc *v1.Service) error {
+	group, shared := getSharedLoadBalancerGroup(svc)
+	if !shared {
+		return nil
+	}
+	if getLoadBalancerType(svc) == NLB {
+		return fmt.Errorf("invalid annotation %s. Shared load balancers are not supported by Network Load Balancer", ServiceAnnotationLoadBalancerSharedGroup)
+	}
+	if group == "" {
+		return fmt.Errorf("invalid annotation %s. The shared load balancer group can not be empty", ServiceAnnotationLoadBalancerSharedGroup)
+	}
+	mode, _, err := getRuleManagementMode(svc)
+	if err != nil {
+		return err
+	}
+	if mode == NSG {
+		return fmt.Errorf("invalid annotation %s. Shared load balancers are not supported with security rule management mode %s", ServiceAnnotationLoadBalancerSharedGroup, RuleManagementModeNsg)
+	}
+	return nil
+}
+
+// sharedLoadBalancerSettings are the annotations configuring the load balancer
+// itself rather than its listeners. The services of a shared load balancer
+// group must declare them like the service owning the load balancer.
+var sharedLoadBalancerSettings = []string{
+	util.CompartmentIDAnnotation,
+	ServiceAnnotationLoadBalancerInternal,
+	ServiceAnnotationLoadBalancerShape,
+	ServiceAnnotationLoadBalancerShapeFlexMin,
+	ServiceAnnotationLoadBalancerShapeFlexMax,
+	ServiceAnnotationLoadBalancerSubnet1,
+	ServiceAnnotationLoadBalancerSubnet2,
+	ServiceAnnotationLoadBalancerNetworkSecurityGroups,
+	ServiceAnnotationLoadBalancerInitialDefinedTagsOverride,
+	ServiceAnnotationLoadBalancerInitialFreeformTagsOverride,
+}
+
+// validateSharedLoadBalancerSettings returns an error when the service
+// declares load balancer settings other than those of the owner of its shared
+// load balancer.
+func validateSharedLoadBalancerSettings(svc, owner *v1.Service) error {
+	if svc.UID == owner.UID {
+		return nil
+	}
+	var differences []string
+	for _, annotation := range sharedLoadBalancerSettings {
+		if svc.Annotations[annotation] != owner.Annotations[annotation] {
+			differences = append(differences, fmt.Sprintf("annotation %s", annotation))
+		}
+	}
+	if svc.Spec.LoadBalancerIP != owner.Spec.LoadBalancerIP {
+		differences = append(differences, "spec.loadBalancerIP")
+	}
+	if len(differences) > 0 {
+		return fmt.Errorf("%s must match service %s/%s, which owns the shared load balancer", strings.Join(differences, ", "), owner.Namespace, owner.Name)
+	}
+	return nil
+}
+
 func getAssignedPrivateIP(logger *zap.SugaredLogger, svc *v1.Service) (ipV4Adress, ipV6Adress *string, err error) {
 	getIpAddress := func(key string) *string {
 		address, exists := svc.Annotations[key]
@@ -7189,6 +7189,31 @@ func TestNewSSLConfig(t *testing.T) {
 				sslSecretReader:              &mockSSLSecretReader{},
 			},
 		},
+		"Certificate and CA bundle OCIDs are read from the service annotations": {
+			secretBackendSetString: "backendSetSecretName",
+			service: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSCertificateId:         "ocid1.certificate.oc1..a",
+						ServiceAnnotationLoadBalancerTLSCABundleIds:           "ocid1.cabundle.oc1..a",
+						ServiceAnnotationLoadBalancerTLSBackendSetCABundleIds: "ocid1.cabundle.oc1..a, ocid1.cabundle.oc1..b,ocid1.cabundle.oc1..a",
+					},
+				},
+			},
+			ports: []int{443},
+			ssr:   &mockSSLSecretReader{},
+
+			expectedResult: &SSLConfig{
+				Ports:                                    sets.NewInt(443),
+				BackendSetSSLSecretName:                  "backendSetSecretName",
+				BackendSetSSLSecretNamespace:             "default",
+				ListenerCertificateIds:                   []string{"ocid1.certificate.oc1..a"},
+				ListenerTrustedCertificateAuthorityIds:   []string{"ocid1.cabundle.oc1..a"},
+				BackendSetTrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..a", "ocid1.cabundle.oc1..b"},
+				sslSecretReader:                          &mockSSLSecretReader{},
+			},
+		},
 		"Empty secret string results in empty name and namespace": {
 			ports: []int{8080},
 			ssr:   &mockSSLSecretReader{},
@@ -8686,6 +8711,344 @@ func Test_getHealthChecker(t *testing.T) {
 	}
 }
 
+func Test_getSessionPersistenceConfiguration(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+		appCookie   *client.GenericSessionPersistenceConfiguration
+		lbCookie    *client.GenericLbCookieSessionPersistenceConfiguration
+		err         error
+	}{
+		"no session persistence": {
+			annotations: map[string]string{},
+		},
+		"app cookie": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence:                AppCookieSessionPersistence,
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieName:      "JSESSIONID",
+				ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback: "true",
+			},
+			appCookie: &client.GenericSessionPersistenceConfiguration{
+				CookieName:      common.String("JSESSIONID"),
+				DisableFallback: common.Bool(true),
+			},
+		},
+		"lb cookie defaults": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence: LBCookieSessionPersistence,
+			},
+			lbCookie: &client.GenericLbCookieSessionPersistenceConfiguration{
+				CookieName:      common.String(DefaultLBCookieName),
+				Path:            common.String(DefaultLBCookiePath),
+				DisableFallback: common.Bool(false),
+			},
+		},
+		"lb cookie": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence:             LBCookieSessionPersistence,
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieName:   "route",
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieDomain: "example.com",
+				ServiceAnnotationLoadBalancerSessionPersistenceCookiePath:   "/app",
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge: "3600",
+			},
+			lbCookie: &client.GenericLbCookieSessionPersistenceConfiguration{
+				CookieName:      common.String("route"),
+				Domain:          common.String("example.com"),
+				Path:            common.String("/app"),
+				MaxAgeInSeconds: common.Int(3600),
+				DisableFallback: common.Bool(false),
+			},
+		},
+		"app cookie without cookie name": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence: AppCookieSessionPersistence,
+			},
+			err: fmt.Errorf("service annotation %s is required for app-cookie session persistence", ServiceAnnotationLoadBalancerSessionPersistenceCookieName),
+		},
+		"app cookie with lb cookie max age": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence:             AppCookieSessionPersistence,
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieName:   "JSESSIONID",
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge: "3600",
+			},
+			err: fmt.Errorf("service annotation %s is only supported for lb-cookie session persistence", ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge),
+		},
+		"invalid max age": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence:             LBCookieSessionPersistence,
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge: "0",
+			},
+			err: fmt.Errorf("error parsing service annotation: %s=0", ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge),
+		},
+		"invalid disable fallback": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence:                LBCookieSessionPersistence,
+				ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback: "yes please",
+			},
+			err: fmt.Errorf("error parsing service annotation: %s=yes please", ServiceAnnotationLoadBalancerSessionPersistenceDisableFallback),
+		},
+		"invalid type": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistence: "source-ip",
+			},
+			err: fmt.Errorf("session persistence \"source-ip\" is not valid, supported values are app-cookie and lb-cookie"),
+		},
+		"cookie annotation without type": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSessionPersistenceCookieName: "JSESSIONID",
+			},
+			err: fmt.Errorf("service annotation %s requires service annotation %s", ServiceAnnotationLoadBalancerSessionPersistenceCookieName, ServiceAnnotationLoadBalancerSessionPersistence),
+		},
+		"nlb": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:               "nlb",
+				ServiceAnnotationLoadBalancerSessionPersistence: LBCookieSessionPersistence,
+			},
+			err: fmt.Errorf("session persistence is not supported for network load balancers"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+			appCookie, lbCookie, err := getSessionPersistenceConfiguration(svc)
+
+			if tc.err != nil && err == nil {
+				t.Errorf("Error: expected\n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if err != nil && (tc.err == nil || err.Error() != tc.err.Error()) {
+				t.Errorf("Error: expected\n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if !reflect.DeepEqual(appCookie, tc.appCookie) {
+				t.Errorf("Expected \n%+v\nbut got\n%+v", tc.appCookie, appCookie)
+			}
+			if !reflect.DeepEqual(lbCookie, tc.lbCookie) {
+				t.Errorf("Expected \n%+v\nbut got\n%+v", tc.lbCookie, lbCookie)
+			}
+		})
+	}
+}
+
+func Test_getListenerRouting(t *testing.T) {
+	routingAnnotations := map[string]string{
+		ServiceAnnotationLoadBalancerHostnames:       `{"app": "app.example.com", "api": "api.example.com"}`,
+		ServiceAnnotationLoadBalancerPathRouteSets:   `{"paths": {"pathRoutes": [{"path": "/api", "backendSetName": "HTTP-8080", "pathMatchType": {"matchType": "PREFIX_MATCH"}}]}}`,
+		ServiceAnnotationLoadBalancerRoutingPolicies: `{"policy": {"rules": [{"name": "api", "condition": "http.request.url.path sw '/api'", "actions": [{"name": "FORWARD_TO_BACKENDSET", "backendSetName": "HTTP-8080"}]}]}}`,
+	}
+	withRouting := func(routing string) map[string]string {
+		annotations := map[string]string{ServiceAnnotationLoadBalancerListenerRouting: routing}
+		for k, v := range routingAnnotations {
+			annotations[k] = v
+		}
+		return annotations
+	}
+
+	testCases := map[string]struct {
+		annotations map[string]string
+		expected    map[int]listenerRouting
+		err         error
+	}{
+		"no listener routing": {
+			annotations: routingAnnotations,
+		},
+		"hostnames and path route set": {
+			annotations: withRouting(`{"80": {"hostnameNames": ["app", "api"], "pathRouteSetName": "paths"}}`),
+			expected: map[int]listenerRouting{
+				80: {
+					HostnameNames:    []string{"api", "app"},
+					PathRouteSetName: common.String("paths"),
+				},
+			},
+		},
+		"routing policy": {
+			annotations: withRouting(`{"80": {"routingPolicyName": "policy"}}`),
+			expected: map[int]listenerRouting{
+				80: {
+					RoutingPolicyName: common.String("policy"),
+				},
+			},
+		},
+		"unknown port": {
+			annotations: withRouting(`{"8443": {"hostnameNames": ["app"]}}`),
+			err:         fmt.Errorf("invalid annotation %s. \"8443\" is not a port of the service", ServiceAnnotationLoadBalancerListenerRouting),
+		},
+		"undeclared hostname": {
+			annotations: withRouting(`{"80": {"hostnameNames": ["www"]}}`),
+			err:         fmt.Errorf("invalid annotation %s. Hostname \"www\" is not declared in annotation %s", ServiceAnnotationLoadBalancerListenerRouting, ServiceAnnotationLoadBalancerHostnames),
+		},
+		"path route set and routing policy": {
+			annotations: withRouting(`{"80": {"pathRouteSetName": "paths", "routingPolicyName": "policy"}}`),
+			err:         fmt.Errorf("invalid annotation %s. Port 80 can not use both a path route set and a routing policy", ServiceAnnotationLoadBalancerListenerRouting),
+		},
+		"undeclared routing policy": {
+			annotations: withRouting(`{"80": {"routingPolicyName": "other"}}`),
+			err:         fmt.Errorf("invalid annotation %s. Routing policy \"other\" is not declared in annotation %s", ServiceAnnotationLoadBalancerListenerRouting, ServiceAnnotationLoadBalancerRoutingPolicies),
+		},
+		"nlb": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:            "nlb",
+				ServiceAnnotationLoadBalancerListenerRouting: `{"80": {"hostnameNames": ["app"]}}`,
+			},
+			err: fmt.Errorf("invalid annotation %s. Listener routing is not supported by Network Load Balancer", ServiceAnnotationLoadBalancerListenerRouting),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{
+							Protocol: v1.ProtocolTCP,
+							Port:     int32(80),
+						},
+					},
+				},
+			}
+			routing, err := getListenerRouting(svc)
+
+			if tc.err != nil && err == nil {
+				t.Errorf("Error: expected\n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if err != nil && (tc.err == nil || err.Error() != tc.err.Error()) {
+				t.Errorf("Error: expected\n%+v\nbut got\n%+v", tc.err, err)
+			}
+			if !reflect.DeepEqual(routing, tc.expected) {
+				t.Errorf("Expected \n%+v\nbut got\n%+v", tc.expected, routing)
+			}
+		})
+	}
+}
+
+func Test_validateSharedLoadBalancer(t *testing.T) {
+	testCases := map[string]struct {
+		annotations map[string]string
+		err         error
+	}{
+		"not shared": {
+			annotations: map[string]string{},
+		},
+		"shared": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSharedGroup: "web",
+			},
+		},
+		"empty group": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSharedGroup: "",
+			},
+			err: fmt.Errorf("invalid annotation %s. The shared load balancer group can not be empty", ServiceAnnotationLoadBalancerSharedGroup),
+		},
+		"nlb": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerType:        "nlb",
+				ServiceAnnotationLoadBalancerSharedGroup: "web",
+			},
+			err: fmt.Errorf("invalid annotation %s. Shared load balancers are not supported by Network Load Balancer", ServiceAnnotationLoadBalancerSharedGroup),
+		},
+		"nsg rule management": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSecurityRuleManagementMode: "NSG",
+				ServiceAnnotationLoadBalancerSharedGroup:                "web",
+			},
+			err: fmt.Errorf("invalid annotation %s. Shared load balancers are not supported with security rule management mode NSG", ServiceAnnotationLoadBalancerSharedGroup),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+			err := validateSharedLoadBalancer(svc)
+			if tc.err == nil && err != nil {
+				t.Errorf("Expected no error but got %v", err)
+			}
+			if tc.err != nil && (err == nil || err.Error() != tc.err.Error()) {
+				t.Errorf("Error: expected\n%+v\nbut got\n%+v", tc.err, err)
+			}
+		})
+	}
+}
+
+func Test_validateSharedLoadBalancerSettings(t *testing.T) {
+	owner := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "web",
+			UID:       "web",
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSharedGroup: "web",
+				ServiceAnnotationLoadBalancerShape:       "flexible",
+				ServiceAnnotationLoadBalancerInternal:    "true",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		annotations    map[string]string
+		loadBalancerIP string
+		owner          bool
+		err            error
+	}{
+		"owner": {
+			annotations: map[string]string{ServiceAnnotationLoadBalancerShape: "10Mbps"},
+			owner:       true,
+		},
+		"same settings": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerShape:      "flexible",
+				ServiceAnnotationLoadBalancerInternal:   "true",
+				ServiceAnnotationLoadBalancerBEProtocol: "HTTP",
+			},
+		},
+		"different shape": {
+			annotations: map[string]string{
+				ServiceAnnotationLoadBalancerShape:    "10Mbps",
+				ServiceAnnotationLoadBalancerInternal: "true",
+			},
+			err: fmt.Errorf("annotation %s must match service default/web, which owns the shared load balancer", ServiceAnnotationLoadBalancerShape),
+		},
+		"missing settings and reserved IP": {
+			annotations:    map[string]string{},
+			loadBalancerIP: "10.0.0.1",
+			err: fmt.Errorf("annotation %s, annotation %s, spec.loadBalancerIP must match service default/web, which owns the shared load balancer",
+				ServiceAnnotationLoadBalancerInternal, ServiceAnnotationLoadBalancerShape),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "api",
+					UID:         "api",
+					Annotations: tc.annotations,
+				},
+				Spec: v1.ServiceSpec{LoadBalancerIP: tc.loadBalancerIP},
+			}
+			if tc.owner {
+				svc.UID = owner.UID
+			}
+			err := validateSharedLoadBalancerSettings(svc, owner)
+			if tc.err == nil && err != nil {
+				t.Errorf("Expected no error but got %v", err)
+			}
+			if tc.err != nil && (err == nil || err.Error() != tc.err.Error()) {
+				t.Errorf("Error: expected\n%+v\nbut got\n%+v", tc.err, err)
+			}
+		})
+	}
+}
+
 func Test_getListeners(t *testing.T) {
 	var tests = []struct {
 		service                  *v1.Service
@@ -8789,6 +9152,45 @@ func Test_getListeners(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ssl configuration with certificate and CA bundle OCIDs",
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{
+							Protocol: v1.Protocol("TCP"),
+							Port:     int32(443),
+						},
+					},
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadbalancerListenerSSLConfig: `{"verifyDepth": 2}`,
+						ServiceAnnotationLoadBalancerSSLPorts:          "443",
+					},
+				},
+			},
+			listenerBackendIpVersion: []string{IPv4},
+			sslConfig: &SSLConfig{
+				Ports:                                  sets.NewInt(443),
+				ListenerCertificateIds:                 []string{"ocid1.certificate.oc1..a"},
+				ListenerTrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..a"},
+			},
+			want: map[string]client.GenericListener{
+				"TCP-443": {
+					Name:                  common.String("TCP-443"),
+					Port:                  common.Int(443),
+					Protocol:              common.String("TCP"),
+					DefaultBackendSetName: common.String("TCP-443"),
+					SslConfiguration: &client.GenericSslConfigurationDetails{
+						CertificateIds:                 []string{"ocid1.certificate.oc1..a"},
+						TrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..a"},
+						VerifyDepth:                    common.Int(2),
+						VerifyPeerCertificate:          common.Bool(true),
+					},
+				},
+			},
+		},
 		{
 			name: "Listeners with ssl configuration information",
 			service: &v1.Service{
@@ -9526,6 +9928,75 @@ func Test_validateService(t *testing.T) {
 			},
 			err: fmt.Errorf("OCI only supports SessionAffinity \"None\" currently"),
 		},
+		"lb with certificate OCIDs": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityNone,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSCertificateId:           "ocid1.certificate.oc1..a",
+						ServiceAnnotationLoadBalancerTLSBackendSetCABundleIds:   "ocid1.cabundle.oc1..a, ocid1.cabundle.oc1..b",
+						ServiceAnnotationLoadBalancerTLSBackendSetCertificateId: "ocid1.certificate.oc1..b",
+					},
+				},
+			},
+			err: nil,
+		},
+		"lb with invalid CA bundle OCID": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityNone,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSCertificateId: "ocid1.certificate.oc1..a",
+						ServiceAnnotationLoadBalancerTLSCABundleIds:   "ocid1.cabundle.oc1..a,cabundle",
+					},
+				},
+			},
+			err: fmt.Errorf("invalid OCID: [cabundle] provided for annotation: oci.oraclecloud.com/oci-load-balancer-tls-ca-bundle-ids"),
+		},
+		"lb with listener CA bundle OCIDs and no certificate": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityNone,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSCABundleIds: "ocid1.cabundle.oc1..a",
+					},
+				},
+			},
+			err: fmt.Errorf("annotation oci.oraclecloud.com/oci-load-balancer-tls-ca-bundle-ids requires annotation oci.oraclecloud.com/oci-load-balancer-tls-certificate-id or service.beta.kubernetes.io/oci-load-balancer-tls-secret"),
+		},
+		"lb with several certificate OCIDs": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityNone,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSCertificateId: "ocid1.certificate.oc1..a,ocid1.certificate.oc1..b",
+					},
+				},
+			},
+			err: fmt.Errorf("only one certificate OCID can be provided for annotation: oci.oraclecloud.com/oci-load-balancer-tls-certificate-id"),
+		},
+		"lb with certificate OCID and TLS secret": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityNone,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerTLSBackendSetCertificateId: "ocid1.certificate.oc1..a",
+						ServiceAnnotationLoadBalancerTLSBackendSetSecret:        "backend-secret",
+					},
+				},
+			},
+			err: fmt.Errorf("annotations oci.oraclecloud.com/oci-load-balancer-tls-backendset-certificate-id and service.beta.kubernetes.io/oci-load-balancer-tls-backendset-secret can not be used together"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -11945,6 +12416,71 @@ func Test_getBackendSets(t *testing.T) {
 			},
 			err: nil,
 		},
+		"LB cookie session persistence": {
+			service: &v1.Service{
+				Spec: v1.ServiceSpec{
+					SessionAffinity: v1.ServiceAffinityNone,
+					Ports: []v1.ServicePort{
+						{
+							Protocol: v1.ProtocolTCP,
+							Port:     int32(67),
+							NodePort: 36667,
+						},
+					},
+					IPFamilies: []v1.IPFamily{v1.IPFamily(IPv4)},
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceAnnotationLoadBalancerSessionPersistence:             LBCookieSessionPersistence,
+						ServiceAnnotationLoadBalancerSessionPersistenceCookieMaxAge: "3600",
+					},
+				},
+			},
+			provisionedNodes: []*v1.Node{
+				{
+					Spec: v1.NodeSpec{
+						ProviderID: testNodeString,
+					},
+					Status: v1.NodeStatus{
+						Addresses: []v1.NodeAddress{
+							{
+								Address: "10.0.0.1",
+								Type:    "InternalIP",
+							},
+						},
+					},
+				},
+			},
+			listenerBackendIpVersion: []string{IPv4},
+			wantBackendSets: map[string]client.GenericBackendSetDetails{
+				"TCP-67": {
+					Name:   &testThreeBackendSetNameIPv4,
+					Policy: common.String("ROUND_ROBIN"),
+					HealthChecker: &client.GenericHealthChecker{
+						Protocol:         "HTTP",
+						IsForcePlainText: common.Bool(false),
+						Port:             common.Int(10256),
+						UrlPath:          common.String("/healthz"),
+						Retries:          common.Int(3),
+						TimeoutInMillis:  common.Int(3000),
+						IntervalInMillis: common.Int(10000),
+						ReturnCode:       common.Int(http.StatusOK),
+					},
+					Backends: []client.GenericBackend{
+						{IpAddress: common.String("10.0.0.1"), Port: common.Int(36667), Weight: common.Int(1), TargetId: &testNodeString},
+					},
+					LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+						CookieName:      common.String(DefaultLBCookieName),
+						Path:            common.String(DefaultLBCookiePath),
+						MaxAgeInSeconds: common.Int(3600),
+						DisableFallback: common.Bool(false),
+					},
+					IpVersion:        GenericIpVersion(client.GenericIPv4),
+					IsPreserveSource: common.Bool(false),
+				},
+			},
+			err: nil,
+		},
 	}
 	for name, tc := range testCases {
 		logger := zap.L()
@@ -11987,6 +12523,16 @@ func Test_getBackendSets(t *testing.T) {
 						got, _ := json.Marshal(gotBackendSet.SslConfiguration)
 						t.Errorf("backendSetDetails SslConfiguration failed want: %s \n got: %s \n", want, got)
 					}
+					if !reflect.DeepEqual(backendSetDetails.SessionPersistenceConfiguration, gotBackendSet.SessionPersistenceConfiguration) {
+						want, _ := json.Marshal(backendSetDetails.SessionPersistenceConfiguration)
+						got, _ := json.Marshal(gotBackendSet.SessionPersistenceConfiguration)
+						t.Errorf("backendSetDetails SessionPersistenceConfiguration failed want: %s \n got: %s \n", want, got)
+					}
+					if !reflect.DeepEqual(backendSetDetails.LbCookieSessionPersistenceConfiguration, gotBackendSet.LbCookieSessionPersistenceConfiguration) {
+						want, _ := json.Marshal(backendSetDetails.LbCookieSessionPersistenceConfiguration)
+						got, _ := json.Marshal(gotBackendSet.LbCookieSessionPersistenceConfiguration)
+						t.Errorf("backendSetDetails LbCookieSessionPersistenceConfiguration failed want: %s \n got: %s \n", want, got)
+					}
 				}
 			}
 		})
@@ -29,9 +29,13 @@ import (
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	testclient "k8s.io/client-go/kubernetes/fake"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
@@ -2393,3 +2397,223 @@ func TestLoadBalancerToStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSharedLoadBalancerMembers(t *testing.T) {
+	newService := func(namespace, name, group string, lbType string) *v1.Service {
+		svc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				UID:         types.UID(namespace + "/" + name),
+				Annotations: map[string]string{ServiceAnnotationLoadBalancerSharedGroup: group},
+			},
+			Spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer},
+		}
+		if lbType != "" {
+			svc.Annotations[ServiceAnnotationLoadBalancerType] = lbType
+		}
+		return svc
+	}
+	service := newService("default", "web", "shared", "")
+	deleted := newService("default", "deleted", "shared", "")
+	deleted.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	clusterIP := newService("default", "cluster-ip", "shared", "")
+	clusterIP.Spec.Type = v1.ServiceTypeClusterIP
+
+	serviceCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, svc := range []*v1.Service{
+		service,
+		newService("default", "api", "shared", ""),
+		newService("default", "other-group", "other", ""),
+		newService("default", "nlb", "shared", "nlb"),
+		newService("other", "api", "shared", ""),
+		deleted,
+		clusterIP,
+	} {
+		if err := serviceCache.Add(svc); err != nil {
+			t.Fatalf("failed to add service: %v", err)
+		}
+	}
+	cp := &CloudProvider{ServiceLister: listersv1.NewServiceLister(serviceCache)}
+
+	members, err := cp.getSharedLoadBalancerMembers(service)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, member := range members {
+		names = append(names, member.Namespace+"/"+member.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"default/api"}) {
+		t.Errorf("Expected the members [default/api] but got %v", names)
+	}
+}
+
+// recordingLoadBalancerClient records the objects deleted from a load
+// balancer.
+type recordingLoadBalancerClient struct {
+	MockLoadBalancerClient
+	deleted []string
+}
+
+func (c *recordingLoadBalancerClient) DeleteListener(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "listener "+name)
+	return "", nil
+}
+
+func (c *recordingLoadBalancerClient) DeleteBackendSet(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "backend set "+name)
+	return "", nil
+}
+
+func (c *recordingLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "rule set "+name)
+	return "", nil
+}
+
+func (c *recordingLoadBalancerClient) DeleteHostname(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "hostname "+name)
+	return "", nil
+}
+
+func (c *recordingLoadBalancerClient) DeletePathRouteSet(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "path route set "+name)
+	return "", nil
+}
+
+func (c *recordingLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "routing policy "+name)
+	return "", nil
+}
+
+func (c *recordingLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	c.deleted = append(c.deleted, "certificate "+name)
+	return "", nil
+}
+
+func TestDeleteSharedLoadBalancerListeners(t *testing.T) {
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "web",
+			UID:       "web",
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSharedGroup:     "web",
+				ServiceAnnotationLoadBalancerSSLPorts:        "443",
+				ServiceAnnotationLoadBalancerTLSSecret:       "web-tls",
+				ServiceAnnotationRuleSets:                    `{"web": {"items": []}, "shared": {"items": []}}`,
+				ServiceAnnotationLoadBalancerHostnames:       `{"web": "web.example.com"}`,
+				ServiceAnnotationLoadBalancerPathRouteSets:   `{"web": {"pathRoutes": []}}`,
+				ServiceAnnotationLoadBalancerRoutingPolicies: `{"web": {"rules": []}}`,
+			},
+		},
+		Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 443}}},
+	}
+	member := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "api",
+			UID:       "api",
+			Annotations: map[string]string{
+				ServiceAnnotationLoadBalancerSharedGroup: "web",
+				ServiceAnnotationLoadBalancerSSLPorts:    "8443",
+				ServiceAnnotationLoadBalancerTLSSecret:   "api-tls",
+				ServiceAnnotationRuleSets:                `{"shared": {"items": []}}`,
+			},
+		},
+		Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8443}}},
+	}
+	lb := &client.GenericLoadBalancer{
+		Id: common.String("ocid1.loadbalancer"),
+		Listeners: map[string]client.GenericListener{
+			"TCP-443":  {Port: common.Int(443)},
+			"TCP-8443": {Port: common.Int(8443)},
+		},
+		BackendSets: map[string]client.GenericBackendSetDetails{
+			"TCP-443":  {},
+			"TCP-8443": {},
+		},
+		Certificates: map[string]client.GenericCertificate{
+			"web-tls": {},
+			"api-tls": {},
+		},
+		RuleSets: map[string]loadbalancer.RuleSetDetails{
+			"web":    {},
+			"shared": {},
+		},
+		Hostnames: map[string]loadbalancer.HostnameDetails{
+			"web": {},
+		},
+		PathRouteSets: map[string]loadbalancer.PathRouteSetDetails{
+			"web": {},
+		},
+		RoutingPolicies: map[string]loadbalancer.RoutingPolicyDetails{},
+	}
+	lbClient := &recordingLoadBalancerClient{}
+	cp := &CloudProvider{}
+	lbProvider := CloudLoadBalancerProvider{lbClient: lbClient, logger: zap.S()}
+
+	if err := cp.deleteSharedLoadBalancerListeners(context.Background(), zap.S(), lbProvider, lb, service, []*v1.Service{member}, ManagementModeNone); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"listener TCP-443",
+		"path route set web",
+		"backend set TCP-443",
+		"hostname web",
+		"rule set web",
+		"certificate web-tls",
+	}
+	if !reflect.DeepEqual(lbClient.deleted, expected) {
+		t.Errorf("Expected the deletions\n%v\nbut got\n%v", expected, lbClient.deleted)
+	}
+}
+
+func TestCloudProvider_ensureClusterUID(t *testing.T) {
+	shared := &v1.Service{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "default",
+		Name:        "web",
+		Annotations: map[string]string{ServiceAnnotationLoadBalancerSharedGroup: "web"},
+	}}
+	dedicated := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"}}
+	kubeSystem := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceSystem, UID: "fake-cluster-uid"}}
+
+	tests := []struct {
+		name     string
+		service  *v1.Service
+		objects  []runtime.Object
+		wantErr  bool
+		wantHash string
+	}{
+		{
+			name:    "load balancer without group does not need the cluster UID",
+			service: dedicated,
+		},
+		{
+			name:    "shared load balancer fails without the cluster UID",
+			service: shared,
+			wantErr: true,
+		},
+		{
+			name:     "shared load balancer looks up the cluster UID",
+			service:  shared,
+			objects:  []runtime.Object{kubeSystem},
+			wantHash: "3ebcce8246131041",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterUIDHashLock.Lock()
+			clusterUIDHash = ""
+			clusterUIDHashLock.Unlock()
+			cp := &CloudProvider{kubeclient: testclient.NewSimpleClientset(tt.objects...)}
+
+			if err := cp.ensureClusterUID(context.Background(), tt.service); (err != nil) != tt.wantErr {
+				t.Fatalf("ensureClusterUID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if hash := getClusterUIDHash(); hash != tt.wantHash {
+				t.Errorf("Expected the cluster UID hash %q but got %q", tt.wantHash, hash)
+			}
+		})
+	}
+}
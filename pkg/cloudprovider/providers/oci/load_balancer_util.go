@@ -16,6 +16,8 @@ package oci
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"reflect"
@@ -23,6 +25,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.uber.org/zap"
 	api "k8s.io/api/core/v1"
@@ -31,6 +34,7 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -153,6 +157,78 @@ func (b *RuleSetAction) String() string {
 	return fmt.Sprintf("RuleSetAction:{Name: %s, Type: %v, Rules: %+v}", b.Name(), b.actionType, b.RuleSetDetails)
 }
 
+// HostnameAction denotes the action that should be taken on the given Hostname.
+type HostnameAction struct {
+	Action
+
+	actionType ActionType
+	name       string
+
+	HostnameDetails loadbalancer.HostnameDetails
+}
+
+// Type of the Action.
+func (b *HostnameAction) Type() ActionType {
+	return b.actionType
+}
+
+// Name of the action's object.
+func (b *HostnameAction) Name() string {
+	return b.name
+}
+
+func (b *HostnameAction) String() string {
+	return fmt.Sprintf("HostnameAction:{Name: %s, Type: %v, Hostname: %s}", b.Name(), b.actionType, toString(b.HostnameDetails.Hostname))
+}
+
+// PathRouteSetAction denotes the action that should be taken on the given Path Route Set.
+type PathRouteSetAction struct {
+	Action
+
+	actionType ActionType
+	name       string
+
+	PathRouteSetDetails loadbalancer.PathRouteSetDetails
+}
+
+// Type of the Action.
+func (b *PathRouteSetAction) Type() ActionType {
+	return b.actionType
+}
+
+// Name of the action's object.
+func (b *PathRouteSetAction) Name() string {
+	return b.name
+}
+
+func (b *PathRouteSetAction) String() string {
+	return fmt.Sprintf("PathRouteSetAction:{Name: %s, Type: %v, PathRoutes: %+v}", b.Name(), b.actionType, b.PathRouteSetDetails.PathRoutes)
+}
+
+// RoutingPolicyAction denotes the action that should be taken on the given Routing Policy.
+type RoutingPolicyAction struct {
+	Action
+
+	actionType ActionType
+	name       string
+
+	RoutingPolicyDetails loadbalancer.RoutingPolicyDetails
+}
+
+// Type of the Action.
+func (b *RoutingPolicyAction) Type() ActionType {
+	return b.actionType
+}
+
+// Name of the action's object.
+func (b *RoutingPolicyAction) Name() string {
+	return b.name
+}
+
+func (b *RoutingPolicyAction) String() string {
+	return fmt.Sprintf("RoutingPolicyAction:{Name: %s, Type: %v, Rules: %+v}", b.Name(), b.actionType, b.RoutingPolicyDetails.Rules)
+}
+
 func toBool(b *bool) bool {
 	if b == nil {
 		return false
@@ -274,6 +350,8 @@ func hasBackendSetChanged(logger *zap.SugaredLogger, actual client.GenericBacken
 	}
 
 	backendSetChanges = append(backendSetChanges, getSSLConfigurationChanges(actual.SslConfiguration, desired.SslConfiguration)...)
+	backendSetChanges = append(backendSetChanges, getSessionPersistenceConfigurationChanges(actual.SessionPersistenceConfiguration, desired.SessionPersistenceConfiguration)...)
+	backendSetChanges = append(backendSetChanges, getLbCookieSessionPersistenceConfigurationChanges(actual.LbCookieSessionPersistenceConfiguration, desired.LbCookieSessionPersistenceConfiguration)...)
 	nameFormat := "%s:%d"
 
 	desiredSet := sets.NewString()
@@ -399,12 +477,13 @@ func getBackendSetChanges(logger *zap.SugaredLogger, actual map[string]client.Ge
 			backendSetActions = append(backendSetActions, &BackendSetAction{
 				name: *actualBackendSet.Name,
 				BackendSet: client.GenericBackendSetDetails{
-					HealthChecker:                   healthCheckerToDetails(actualBackendSet.HealthChecker),
-					Policy:                          actualBackendSet.Policy,
-					Backends:                        backendsToBackendDetails(actualBackendSet.Backends),
-					SessionPersistenceConfiguration: actualBackendSet.SessionPersistenceConfiguration,
-					SslConfiguration:                sslConfigurationToDetails(actualBackendSet.SslConfiguration),
-					IpVersion:                       actualBackendSet.IpVersion,
+					HealthChecker:                           healthCheckerToDetails(actualBackendSet.HealthChecker),
+					Policy:                                  actualBackendSet.Policy,
+					Backends:                                backendsToBackendDetails(actualBackendSet.Backends),
+					SessionPersistenceConfiguration:         actualBackendSet.SessionPersistenceConfiguration,
+					LbCookieSessionPersistenceConfiguration: actualBackendSet.LbCookieSessionPersistenceConfiguration,
+					SslConfiguration:                        sslConfigurationToDetails(actualBackendSet.SslConfiguration),
+					IpVersion:                               actualBackendSet.IpVersion,
 				},
 				Ports:      portsFromBackendSet(logger, *actualBackendSet.Name, &actualBackendSet),
 				actionType: Delete,
@@ -454,9 +533,16 @@ func getSSLConfigurationChanges(actual *client.GenericSslConfigurationDetails, d
 		return sslConfigurationChanges
 	}
 
-	if toString(actual.CertificateName) != toString(desired.CertificateName) {
+	// The certificate name is only managed for certificates created from secrets.
+	if len(desired.CertificateIds) == 0 && toString(actual.CertificateName) != toString(desired.CertificateName) {
 		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateName", toString(actual.CertificateName), toString(desired.CertificateName)))
 	}
+	if !sets.NewString(actual.CertificateIds...).Equal(sets.NewString(desired.CertificateIds...)) {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateIds", strings.Join(actual.CertificateIds, ","), strings.Join(desired.CertificateIds, ",")))
+	}
+	if !sets.NewString(actual.TrustedCertificateAuthorityIds...).Equal(sets.NewString(desired.TrustedCertificateAuthorityIds...)) {
+		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:TrustedCertificateAuthorityIds", strings.Join(actual.TrustedCertificateAuthorityIds, ","), strings.Join(desired.TrustedCertificateAuthorityIds, ",")))
+	}
 	if toInt(actual.VerifyDepth) != toInt(desired.VerifyDepth) {
 		sslConfigurationChanges = append(sslConfigurationChanges, fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:VerifyDepth", toInt(actual.VerifyDepth), toInt(desired.VerifyDepth)))
 	}
@@ -476,7 +562,62 @@ func getSSLConfigurationChanges(actual *client.GenericSslConfigurationDetails, d
 	return sslConfigurationChanges
 }
 
-func hasListenerChanged(logger *zap.SugaredLogger, actual client.GenericListener, desired client.GenericListener, ruleSets map[string]loadbalancer.RuleSetDetails) bool {
+func getSessionPersistenceConfigurationChanges(actual *client.GenericSessionPersistenceConfiguration, desired *client.GenericSessionPersistenceConfiguration) []string {
+	var sessionPersistenceChanges []string
+	if actual == nil && desired == nil {
+		return sessionPersistenceChanges
+	}
+	if actual == nil && desired != nil {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration", "NOT_PRESENT", "PRESENT"))
+		return sessionPersistenceChanges
+	}
+	if actual != nil && desired == nil {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration", "PRESENT", "NOT_PRESENT"))
+		return sessionPersistenceChanges
+	}
+
+	if toString(actual.CookieName) != toString(desired.CookieName) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration:CookieName", toString(actual.CookieName), toString(desired.CookieName)))
+	}
+	if toBool(actual.DisableFallback) != toBool(desired.DisableFallback) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:SessionPersistenceConfiguration:DisableFallback", toBool(actual.DisableFallback), toBool(desired.DisableFallback)))
+	}
+	return sessionPersistenceChanges
+}
+
+func getLbCookieSessionPersistenceConfigurationChanges(actual *client.GenericLbCookieSessionPersistenceConfiguration, desired *client.GenericLbCookieSessionPersistenceConfiguration) []string {
+	var sessionPersistenceChanges []string
+	if actual == nil && desired == nil {
+		return sessionPersistenceChanges
+	}
+	if actual == nil && desired != nil {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration", "NOT_PRESENT", "PRESENT"))
+		return sessionPersistenceChanges
+	}
+	if actual != nil && desired == nil {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration", "PRESENT", "NOT_PRESENT"))
+		return sessionPersistenceChanges
+	}
+
+	if toString(actual.CookieName) != toString(desired.CookieName) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:CookieName", toString(actual.CookieName), toString(desired.CookieName)))
+	}
+	if toString(actual.Domain) != toString(desired.Domain) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:Domain", toString(actual.Domain), toString(desired.Domain)))
+	}
+	if toString(actual.Path) != toString(desired.Path) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:Path", toString(actual.Path), toString(desired.Path)))
+	}
+	if toInt(actual.MaxAgeInSeconds) != toInt(desired.MaxAgeInSeconds) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:MaxAgeInSeconds", toInt(actual.MaxAgeInSeconds), toInt(desired.MaxAgeInSeconds)))
+	}
+	if toBool(actual.DisableFallback) != toBool(desired.DisableFallback) {
+		sessionPersistenceChanges = append(sessionPersistenceChanges, fmt.Sprintf(changeFmtStr, "BackEndSet:LbCookieSessionPersistenceConfiguration:DisableFallback", toBool(actual.DisableFallback), toBool(desired.DisableFallback)))
+	}
+	return sessionPersistenceChanges
+}
+
+func hasListenerChanged(logger *zap.SugaredLogger, actual client.GenericListener, desired client.GenericListener, ruleSets map[string]loadbalancer.RuleSetDetails, manageRouting bool) bool {
 	logger = logger.With("ListenerName", toString(actual.Name))
 	var listenerChanges []string
 	if toString(actual.DefaultBackendSetName) != toString(desired.DefaultBackendSetName) {
@@ -494,6 +635,17 @@ func hasListenerChanged(logger *zap.SugaredLogger, actual client.GenericListener
 	if ruleSets != nil && !sets.NewString(actual.RuleSetNames...).Equal(sets.NewString(desired.RuleSetNames...)) {
 		listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:RuleSetNames", actual.RuleSetNames, desired.RuleSetNames))
 	}
+	if manageRouting {
+		if !sets.NewString(actual.HostnameNames...).Equal(sets.NewString(desired.HostnameNames...)) {
+			listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:HostnameNames", actual.HostnameNames, desired.HostnameNames))
+		}
+		if toString(actual.PathRouteSetName) != toString(desired.PathRouteSetName) {
+			listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:PathRouteSetName", toString(actual.PathRouteSetName), toString(desired.PathRouteSetName)))
+		}
+		if toString(actual.RoutingPolicyName) != toString(desired.RoutingPolicyName) {
+			listenerChanges = append(listenerChanges, fmt.Sprintf(changeFmtStr, "Listener:RoutingPolicyName", toString(actual.RoutingPolicyName), toString(desired.RoutingPolicyName)))
+		}
+	}
 
 	listenerChanges = append(listenerChanges, getSSLConfigurationChanges(actual.SslConfiguration, desired.SslConfiguration)...)
 	listenerChanges = append(listenerChanges, getConnectionConfigurationChanges(actual.ConnectionConfiguration, desired.ConnectionConfiguration)...)
@@ -529,7 +681,7 @@ func getConnectionConfigurationChanges(actual *client.GenericConnectionConfigura
 	return connectionConfigurationChanges
 }
 
-func getListenerChanges(logger *zap.SugaredLogger, actual map[string]client.GenericListener, desired map[string]client.GenericListener, ruleSets map[string]loadbalancer.RuleSetDetails) []Action {
+func getListenerChanges(logger *zap.SugaredLogger, actual map[string]client.GenericListener, desired map[string]client.GenericListener, ruleSets map[string]loadbalancer.RuleSetDetails, manageRouting bool) []Action {
 	var listenerActions []Action
 
 	// set to keep track of desired listeners that already exist and should not be created
@@ -561,7 +713,7 @@ func getListenerChanges(logger *zap.SugaredLogger, actual map[string]client.Gene
 			continue
 		}
 		exists.Insert(getSanitizedName(name))
-		if hasListenerChanged(logger, actualListener, desiredListener, ruleSets) {
+		if hasListenerChanged(logger, actualListener, desiredListener, ruleSets, manageRouting) {
 			listenerActions = append(listenerActions, &ListenerAction{
 				Listener:   desiredListener,
 				name:       name,
@@ -624,6 +776,103 @@ func getRuleSetChanges(actual map[string]loadbalancer.RuleSetDetails, desired ma
 	return ruleSetActions
 }
 
+func getHostnameChanges(actual map[string]loadbalancer.HostnameDetails, desired map[string]loadbalancer.HostnameDetails) []Action {
+	var hostnameActions []Action
+
+	for name, a := range actual {
+		if _, ok := desired[name]; !ok {
+			hostnameActions = append(hostnameActions, &HostnameAction{
+				name:            name,
+				HostnameDetails: a,
+				actionType:      Delete,
+			})
+		}
+	}
+
+	for name, desiredHostname := range desired {
+		actualHostname, ok := actual[name]
+		if !ok {
+			hostnameActions = append(hostnameActions, &HostnameAction{
+				name:            name,
+				HostnameDetails: desiredHostname,
+				actionType:      Create,
+			})
+		} else if toString(actualHostname.Hostname) != toString(desiredHostname.Hostname) {
+			hostnameActions = append(hostnameActions, &HostnameAction{
+				name:            name,
+				HostnameDetails: desiredHostname,
+				actionType:      Update,
+			})
+		}
+	}
+
+	return hostnameActions
+}
+
+func getPathRouteSetChanges(actual map[string]loadbalancer.PathRouteSetDetails, desired map[string]loadbalancer.PathRouteSetDetails) []Action {
+	var pathRouteSetActions []Action
+
+	for name, a := range actual {
+		if _, ok := desired[name]; !ok {
+			pathRouteSetActions = append(pathRouteSetActions, &PathRouteSetAction{
+				name:                name,
+				PathRouteSetDetails: a,
+				actionType:          Delete,
+			})
+		}
+	}
+
+	for name, desiredPathRouteSet := range desired {
+		if _, ok := actual[name]; !ok {
+			pathRouteSetActions = append(pathRouteSetActions, &PathRouteSetAction{
+				name:                name,
+				PathRouteSetDetails: desiredPathRouteSet,
+				actionType:          Create,
+			})
+		} else if !reflect.DeepEqual(actual[name], desired[name]) {
+			pathRouteSetActions = append(pathRouteSetActions, &PathRouteSetAction{
+				name:                name,
+				PathRouteSetDetails: desiredPathRouteSet,
+				actionType:          Update,
+			})
+		}
+	}
+
+	return pathRouteSetActions
+}
+
+func getRoutingPolicyChanges(actual map[string]loadbalancer.RoutingPolicyDetails, desired map[string]loadbalancer.RoutingPolicyDetails) []Action {
+	var routingPolicyActions []Action
+
+	for name, a := range actual {
+		if _, ok := desired[name]; !ok {
+			routingPolicyActions = append(routingPolicyActions, &RoutingPolicyAction{
+				name:                 name,
+				RoutingPolicyDetails: a,
+				actionType:           Delete,
+			})
+		}
+	}
+
+	for name, desiredRoutingPolicy := range desired {
+		if _, ok := actual[name]; !ok {
+			routingPolicyActions = append(routingPolicyActions, &RoutingPolicyAction{
+				name:                 name,
+				RoutingPolicyDetails: desiredRoutingPolicy,
+				actionType:           Create,
+			})
+		} else if !reflect.DeepEqual(actual[name], desired[name]) {
+			routingPolicyActions = append(routingPolicyActions, &RoutingPolicyAction{
+				name:                 name,
+				RoutingPolicyDetails: desiredRoutingPolicy,
+				actionType:           Update,
+			})
+		}
+	}
+
+	return routingPolicyActions
+}
+
 func hasLoadbalancerShapeChanged(ctx context.Context, spec *LBSpec, lb *client.GenericLoadBalancer) bool {
 	if *lb.ShapeName != spec.Shape {
 		return true
@@ -682,6 +931,179 @@ func getListenerName(protocol string, port int) string {
 	return fmt.Sprintf("%s-%d", protocol, port)
 }
 
+// getPortFromName returns the port of a listener or backend set name such as
+// TCP-80 or HTTP-443-IPv6.
+func getPortFromName(name string) (int, bool) {
+	fields := strings.Split(getSanitizedName(name), "-")
+	if len(fields) < 2 {
+		return 0, false
+	}
+	port, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, false
+	}
+	return port, true
+}
+
+// getLoadBalancerLockKey returns the key serialising the load balancer
+// operations of the service. Services sharing a load balancer are serialised
+// by the name of the load balancer.
+func getLoadBalancerLockKey(service *api.Service) string {
+	if _, shared := getSharedLoadBalancerGroup(service); shared && getLoadBalancerType(service) == LB {
+		return GetLoadBalancerName(service)
+	}
+	return fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+}
+
+// sortSharedLoadBalancerServices returns the services of a shared load
+// balancer group from the oldest to the newest. The oldest service owns the
+// load balancer.
+func sortSharedLoadBalancerServices(services []*api.Service) []*api.Service {
+	sorted := make([]*api.Service, len(services))
+	copy(sorted, services)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].CreationTimestamp.Equal(&sorted[j].CreationTimestamp) {
+			return sorted[i].CreationTimestamp.Before(&sorted[j].CreationTimestamp)
+		}
+		return fmt.Sprintf("%s/%s", sorted[i].Namespace, sorted[i].Name) < fmt.Sprintf("%s/%s", sorted[j].Namespace, sorted[j].Name)
+	})
+	return sorted
+}
+
+// getSharedLoadBalancerPortOwners returns the service owning each listener
+// port of a shared load balancer. When several services of the group declare
+// the same port, the oldest service owns it.
+func getSharedLoadBalancerPortOwners(services []*api.Service) map[int]*api.Service {
+	owners := make(map[int]*api.Service)
+	for _, svc := range sortSharedLoadBalancerServices(services) {
+		for _, servicePort := range svc.Spec.Ports {
+			if _, ok := owners[int(servicePort.Port)]; !ok {
+				owners[int(servicePort.Port)] = svc
+			}
+		}
+	}
+	return owners
+}
+
+// sharedLoadBalancerObjects holds the names of the certificates, rule sets,
+// hostnames, path route sets and routing policies declared by services of a
+// shared load balancer group.
+type sharedLoadBalancerObjects struct {
+	certificates    sets.String
+	ruleSets        sets.String
+	hostnames       sets.String
+	pathRouteSets   sets.String
+	routingPolicies sets.String
+}
+
+// getSharedLoadBalancerObjects returns the objects declared by the annotations
+// of the services.
+func getSharedLoadBalancerObjects(services []*api.Service) (*sharedLoadBalancerObjects, error) {
+	objects := &sharedLoadBalancerObjects{
+		certificates:    sets.NewString(),
+		ruleSets:        sets.NewString(),
+		hostnames:       sets.NewString(),
+		pathRouteSets:   sets.NewString(),
+		routingPolicies: sets.NewString(),
+	}
+	for _, svc := range services {
+		if requiresCertificate(svc) {
+			for _, annotation := range []string{ServiceAnnotationLoadBalancerTLSSecret, ServiceAnnotationLoadBalancerTLSBackendSetSecret} {
+				if name, _ := getSecretParts(svc.Annotations[annotation], svc); name != "" {
+					objects.certificates.Insert(name)
+				}
+			}
+		}
+		ruleSets, err := getRuleSets(svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule sets of service %s/%s", svc.Namespace, svc.Name)
+		}
+		for name := range ruleSets {
+			objects.ruleSets.Insert(name)
+		}
+		hostnames, err := getHostnames(svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "hostnames of service %s/%s", svc.Namespace, svc.Name)
+		}
+		for name := range hostnames {
+			objects.hostnames.Insert(name)
+		}
+		pathRouteSets, err := getPathRouteSets(svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "path route sets of service %s/%s", svc.Namespace, svc.Name)
+		}
+		for name := range pathRouteSets {
+			objects.pathRouteSets.Insert(name)
+		}
+		routingPolicies, err := getRoutingPolicies(svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "routing policies of service %s/%s", svc.Namespace, svc.Name)
+		}
+		for name := range routingPolicies {
+			objects.routingPolicies.Insert(name)
+		}
+	}
+	return objects, nil
+}
+
+// declares returns whether the object of the action is declared.
+func (o *sharedLoadBalancerObjects) declares(a Action) bool {
+	switch a.(type) {
+	case *RuleSetAction:
+		return o.ruleSets.Has(a.Name())
+	case *HostnameAction:
+		return o.hostnames.Has(a.Name())
+	case *PathRouteSetAction:
+		return o.pathRouteSets.Has(a.Name())
+	case *RoutingPolicyAction:
+		return o.routingPolicies.Has(a.Name())
+	}
+	return false
+}
+
+// filterLoadBalancerListeners returns a copy of the load balancer with only
+// the listeners and backend sets of the ports accepted by keep.
+func filterLoadBalancerListeners(lb *client.GenericLoadBalancer, keep func(port int) bool) *client.GenericLoadBalancer {
+	filtered := *lb
+	filtered.Listeners = make(map[string]client.GenericListener)
+	for name, listener := range lb.Listeners {
+		if listener.Port != nil && keep(*listener.Port) {
+			filtered.Listeners[name] = listener
+		}
+	}
+	filtered.BackendSets = make(map[string]client.GenericBackendSetDetails)
+	for name, bs := range lb.BackendSets {
+		if port, ok := getPortFromName(name); ok && keep(port) {
+			filtered.BackendSets[name] = bs
+		}
+	}
+	return &filtered
+}
+
+var (
+	// clusterUIDHash tells apart the shared load balancers of the clusters
+	// sharing a compartment. It is set from the UID of the kube-system
+	// namespace the first time a shared load balancer is reconciled.
+	clusterUIDHash     string
+	clusterUIDHashLock sync.RWMutex
+)
+
+// setClusterUID sets the cluster component of the names of shared load balancers.
+func setClusterUID(clusterUID string) {
+	hash := sha256.Sum256([]byte(clusterUID))
+	clusterUIDHashLock.Lock()
+	defer clusterUIDHashLock.Unlock()
+	clusterUIDHash = hex.EncodeToString(hash[:])[:16]
+}
+
+// getClusterUIDHash returns the cluster component of the names of shared load
+// balancers, or an empty string until it is set.
+func getClusterUIDHash() string {
+	clusterUIDHashLock.RLock()
+	defer clusterUIDHashLock.RUnlock()
+	return clusterUIDHash
+}
+
 // GetLoadBalancerName gets the name of the load balancer based on the service
 func GetLoadBalancerName(service *api.Service) string {
 	lbType := getLoadBalancerType(service)
@@ -698,7 +1120,12 @@ func GetLoadBalancerName(service *api.Service) string {
 				// Add the trailing hyphen if it's missing
 				prefix += "-"
 			}
-			name = fmt.Sprintf("%s%s", prefix, service.UID)
+			if group, shared := getSharedLoadBalancerGroup(service); shared {
+				// Groups are scoped to the namespace of the service and the cluster.
+				name = fmt.Sprintf("%sshared-%s-%s-%s", prefix, getClusterUIDHash(), service.Namespace, group)
+			} else {
+				name = fmt.Sprintf("%s%s", prefix, service.UID)
+			}
 		}
 	}
 	if len(name) > 1024 {
@@ -771,11 +1198,12 @@ func parseSecretString(secretString string) (string, string) {
 	return "", secretString
 }
 
-// sortAndCombineActions combines three slices of Actions and then sorts them to
+// sortAndCombineActions combines four slices of Actions and then sorts them to
 // ensure that BackendSets are created prior to their associated Listeners but
 // deleted after their associated Listeners. Rule Sets are created/updated before any listener changes
-// and deleted after listener changes.
-func sortAndCombineActions(logger *zap.SugaredLogger, backendSetActions []Action, listenerActions []Action, ruleSetActions []Action) []Action {
+// and deleted after listener changes. Hostnames, Path Route Sets and Routing Policies are placed by
+// orderRoutingActions.
+func sortAndCombineActions(logger *zap.SugaredLogger, backendSetActions []Action, listenerActions []Action, ruleSetActions []Action, routingActions []Action) []Action {
 	actions := append(backendSetActions, listenerActions...)
 	sort.SliceStable(actions, func(i, j int) bool {
 		a1 := actions[i]
@@ -818,6 +1246,8 @@ func sortAndCombineActions(logger *zap.SugaredLogger, backendSetActions []Action
 		return false
 	})
 
+	actions = orderRoutingActions(actions, routingActions)
+
 	for _, a := range ruleSetActions {
 		if a.Type() == Delete { // Rule Set can only be deleted if it's not attached to any Listener
 			actions = append(actions, a)
@@ -829,6 +1259,58 @@ func sortAndCombineActions(logger *zap.SugaredLogger, backendSetActions []Action
 	return actions
 }
 
+// withoutDeclaredDeleteActions returns the actions except those deleting an
+// object declared by the other services of a shared load balancer.
+func withoutDeclaredDeleteActions(actions []Action, declared *sharedLoadBalancerObjects) []Action {
+	var filtered []Action
+	for _, a := range actions {
+		if a.Type() != Delete || !declared.declares(a) {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
+
+// orderRoutingActions places the Hostname, Path Route Set and Routing Policy
+// actions among the sorted BackendSet and Listener actions. Path Route Sets and
+// Routing Policies refer to BackendSets and all of them are referred to by
+// Listeners, so they are created/updated after every BackendSet
+// creation/update but before any Listener creation/update, and deleted after
+// every Listener change but before any BackendSet deletion.
+func orderRoutingActions(actions []Action, routingActions []Action) []Action {
+	if len(routingActions) == 0 {
+		return actions
+	}
+
+	var listenerDeletes, backendSetDeletes, backendSetChanges, listenerChanges, routingChanges, routingDeletes []Action
+	for _, a := range actions {
+		_, isBackendSet := a.(*BackendSetAction)
+		switch {
+		case a.Type() == Delete && isBackendSet:
+			backendSetDeletes = append(backendSetDeletes, a)
+		case a.Type() == Delete:
+			listenerDeletes = append(listenerDeletes, a)
+		case isBackendSet:
+			backendSetChanges = append(backendSetChanges, a)
+		default:
+			listenerChanges = append(listenerChanges, a)
+		}
+	}
+	for _, a := range routingActions {
+		if a.Type() == Delete {
+			routingDeletes = append(routingDeletes, a)
+		} else {
+			routingChanges = append(routingChanges, a)
+		}
+	}
+
+	var ordered []Action
+	for _, group := range [][]Action{listenerDeletes, backendSetChanges, routingChanges, listenerChanges, routingDeletes, backendSetDeletes} {
+		ordered = append(ordered, group...)
+	}
+	return ordered
+}
+
 func getMetric(resourceType string, metricType string) string {
 	if resourceType == LB {
 		switch metricType {
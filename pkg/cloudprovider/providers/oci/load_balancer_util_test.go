@@ -20,10 +20,13 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-go-sdk/v65/common"
@@ -36,8 +39,80 @@ func TestSortAndCombineActions(t *testing.T) {
 		backendSetActions []Action
 		listenerActions   []Action
 		ruleSetActions    []Action
+		routingActions    []Action
 		expected          []Action
 	}{
+		"routing": {
+			backendSetActions: []Action{
+				&BackendSetAction{
+					name:       "HTTP-8080",
+					actionType: Create,
+					BackendSet: client.GenericBackendSetDetails{},
+				},
+				&BackendSetAction{
+					name:       "HTTP-443",
+					actionType: Delete,
+					BackendSet: client.GenericBackendSetDetails{},
+				},
+			},
+			listenerActions: []Action{
+				&ListenerAction{
+					name:       "HTTP-80",
+					actionType: Update,
+					Listener:   client.GenericListener{},
+				},
+				&ListenerAction{
+					name:       "HTTP-443",
+					actionType: Delete,
+					Listener:   client.GenericListener{},
+				},
+			},
+			ruleSetActions: []Action{},
+			routingActions: []Action{
+				&PathRouteSetAction{
+					name:                "paths",
+					actionType:          Create,
+					PathRouteSetDetails: loadbalancer.PathRouteSetDetails{},
+				},
+				&RoutingPolicyAction{
+					name:                 "policy",
+					actionType:           Delete,
+					RoutingPolicyDetails: loadbalancer.RoutingPolicyDetails{},
+				},
+			},
+			expected: []Action{
+				&ListenerAction{
+					name:       "HTTP-443",
+					actionType: Delete,
+					Listener:   client.GenericListener{},
+				},
+				&BackendSetAction{
+					name:       "HTTP-8080",
+					actionType: Create,
+					BackendSet: client.GenericBackendSetDetails{},
+				},
+				&PathRouteSetAction{
+					name:                "paths",
+					actionType:          Create,
+					PathRouteSetDetails: loadbalancer.PathRouteSetDetails{},
+				},
+				&ListenerAction{
+					name:       "HTTP-80",
+					actionType: Update,
+					Listener:   client.GenericListener{},
+				},
+				&RoutingPolicyAction{
+					name:                 "policy",
+					actionType:           Delete,
+					RoutingPolicyDetails: loadbalancer.RoutingPolicyDetails{},
+				},
+				&BackendSetAction{
+					name:       "HTTP-443",
+					actionType: Delete,
+					BackendSet: client.GenericBackendSetDetails{},
+				},
+			},
+		},
 		"create": {
 			backendSetActions: []Action{
 				&BackendSetAction{
@@ -392,7 +467,7 @@ func TestSortAndCombineActions(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := sortAndCombineActions(zap.S(), tc.backendSetActions, tc.listenerActions, tc.ruleSetActions)
+			result := sortAndCombineActions(zap.S(), tc.backendSetActions, tc.listenerActions, tc.ruleSetActions, tc.routingActions)
 			if !reflect.DeepEqual(result, tc.expected) {
 				t.Errorf("expected\n%+v\nbut got\n%+v", tc.expected, result)
 			}
@@ -926,6 +1001,79 @@ func TestGetRuleSetChanges(t *testing.T) {
 	}
 }
 
+func TestGetHostnameChanges(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		desired  map[string]loadbalancer.HostnameDetails
+		actual   map[string]loadbalancer.HostnameDetails
+		expected []Action
+	}{
+		{
+			name: "no change",
+			desired: map[string]loadbalancer.HostnameDetails{
+				"app": {Name: common.String("app"), Hostname: common.String("app.example.com")},
+			},
+			actual: map[string]loadbalancer.HostnameDetails{
+				"app": {Name: common.String("app"), Hostname: common.String("app.example.com")},
+			},
+			expected: nil,
+		},
+		{
+			name: "create hostname",
+			desired: map[string]loadbalancer.HostnameDetails{
+				"app": {Name: common.String("app"), Hostname: common.String("app.example.com")},
+			},
+			actual: map[string]loadbalancer.HostnameDetails{},
+			expected: []Action{
+				&HostnameAction{
+					name:            "app",
+					actionType:      Create,
+					HostnameDetails: loadbalancer.HostnameDetails{Name: common.String("app"), Hostname: common.String("app.example.com")},
+				},
+			},
+		},
+		{
+			name: "update hostname",
+			desired: map[string]loadbalancer.HostnameDetails{
+				"app": {Name: common.String("app"), Hostname: common.String("www.example.com")},
+			},
+			actual: map[string]loadbalancer.HostnameDetails{
+				"app": {Name: common.String("app"), Hostname: common.String("app.example.com")},
+			},
+			expected: []Action{
+				&HostnameAction{
+					name:            "app",
+					actionType:      Update,
+					HostnameDetails: loadbalancer.HostnameDetails{Name: common.String("app"), Hostname: common.String("www.example.com")},
+				},
+			},
+		},
+		{
+			name:    "delete hostname",
+			desired: map[string]loadbalancer.HostnameDetails{},
+			actual: map[string]loadbalancer.HostnameDetails{
+				"app": {Name: common.String("app"), Hostname: common.String("app.example.com")},
+			},
+			expected: []Action{
+				&HostnameAction{
+					name:            "app",
+					actionType:      Delete,
+					HostnameDetails: loadbalancer.HostnameDetails{Name: common.String("app"), Hostname: common.String("app.example.com")},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := getHostnameChanges(tt.actual, tt.desired)
+			if !reflect.DeepEqual(changes, tt.expected) {
+				t.Errorf("expected HostnameActions\n%+v\nbut got\n%+v", tt.expected, changes)
+			}
+		})
+	}
+}
+
 func TestGetListenerChanges(t *testing.T) {
 	var testCases = []struct {
 		name     string
@@ -1481,7 +1629,7 @@ func TestGetListenerChanges(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			changes := getListenerChanges(zap.S(), tt.actual, tt.desired, nil)
+			changes := getListenerChanges(zap.S(), tt.actual, tt.desired, nil, false)
 			if len(changes) == 0 && len(tt.expected) == 0 {
 				return
 			}
@@ -1614,6 +1762,17 @@ func TestGetLoadBalancerName(t *testing.T) {
 			},
 			expected: "testNamespace/networkLoadbalancer/fakeuid",
 		},
+		"shared": {
+			prefix: "testprefix",
+			service: &api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Annotations: map[string]string{ServiceAnnotationLoadBalancerSharedGroup: "web"},
+					UID:         "fakeuid",
+				},
+			},
+			expected: "testprefix-shared-3ebcce8246131041-default-web",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1623,6 +1782,7 @@ func TestGetLoadBalancerName(t *testing.T) {
 				t.Fatal(err)
 			}
 
+			setClusterUID("fake-cluster-uid")
 			result := GetLoadBalancerName(tc.service)
 			if result != tc.expected {
 				t.Errorf("Expected load balancer name `%s` but got `%s`", tc.expected, result)
@@ -1631,6 +1791,105 @@ func TestGetLoadBalancerName(t *testing.T) {
 	}
 }
 
+func TestGetSharedLoadBalancerPortOwners(t *testing.T) {
+	older := &api.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "older",
+			Namespace:         "default",
+			UID:               "older",
+			CreationTimestamp: metav1.NewTime(time.Unix(100, 0)),
+		},
+		Spec: api.ServiceSpec{
+			Ports: []api.ServicePort{{Port: 80}, {Port: 443}},
+		},
+	}
+	newer := &api.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "newer",
+			Namespace:         "default",
+			UID:               "newer",
+			CreationTimestamp: metav1.NewTime(time.Unix(200, 0)),
+		},
+		Spec: api.ServiceSpec{
+			Ports: []api.ServicePort{{Port: 443}, {Port: 8080}},
+		},
+	}
+
+	owners := getSharedLoadBalancerPortOwners([]*api.Service{newer, older})
+	expected := map[int]types.UID{80: "older", 443: "older", 8080: "newer"}
+	if len(owners) != len(expected) {
+		t.Fatalf("expected owners of %d ports but got %d", len(expected), len(owners))
+	}
+	for port, uid := range expected {
+		if owners[port].UID != uid {
+			t.Errorf("expected port %d to be owned by %q but got %q", port, uid, owners[port].UID)
+		}
+	}
+}
+
+func TestWithoutDeclaredDeleteActions(t *testing.T) {
+	member := &api.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api",
+			Namespace: "default",
+			Annotations: map[string]string{
+				ServiceAnnotationRuleSets:              `{"shared": {"items": []}}`,
+				ServiceAnnotationLoadBalancerHostnames: `{"api": "api.example.com"}`,
+			},
+		},
+	}
+	declared, err := getSharedLoadBalancerObjects([]*api.Service{member})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := []Action{
+		&RuleSetAction{name: "shared", actionType: Delete},
+		&RuleSetAction{name: "shared", actionType: Update},
+		&RuleSetAction{name: "web", actionType: Delete},
+		&HostnameAction{name: "api", actionType: Delete},
+		&PathRouteSetAction{name: "api", actionType: Delete},
+	}
+	var got []string
+	for _, a := range withoutDeclaredDeleteActions(actions, declared) {
+		got = append(got, fmt.Sprintf("%T:%s:%s", a, a.Type(), a.Name()))
+	}
+	expected := []string{
+		"*oci.RuleSetAction:update:shared",
+		"*oci.RuleSetAction:delete:web",
+		"*oci.PathRouteSetAction:delete:api",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected actions %v but got %v", expected, got)
+	}
+}
+
+func TestFilterLoadBalancerListeners(t *testing.T) {
+	lb := &client.GenericLoadBalancer{
+		Id: common.String("ocid1.loadbalancer"),
+		Listeners: map[string]client.GenericListener{
+			"TCP-80":   {Port: common.Int(80), DefaultBackendSetName: common.String("TCP-80")},
+			"HTTP-443": {Port: common.Int(443), DefaultBackendSetName: common.String("TCP-443")},
+		},
+		BackendSets: map[string]client.GenericBackendSetDetails{
+			"TCP-80":       {Name: common.String("TCP-80")},
+			"TCP-443":      {Name: common.String("TCP-443")},
+			"TCP-443-IPv6": {Name: common.String("TCP-443-IPv6")},
+		},
+	}
+
+	filtered := filterLoadBalancerListeners(lb, func(port int) bool { return port == 443 })
+	if !sets.StringKeySet(filtered.Listeners).Equal(sets.NewString("HTTP-443")) {
+		t.Errorf("expected listeners [HTTP-443] but got %v", sets.StringKeySet(filtered.Listeners).List())
+	}
+	if !sets.StringKeySet(filtered.BackendSets).Equal(sets.NewString("TCP-443", "TCP-443-IPv6")) {
+		t.Errorf("expected backend sets [TCP-443 TCP-443-IPv6] but got %v", sets.StringKeySet(filtered.BackendSets).List())
+	}
+	if len(lb.Listeners) != 2 || len(lb.BackendSets) != 3 {
+		t.Errorf("expected the load balancer to be left unchanged")
+	}
+}
+
 func TestGetSanitizedName(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -1705,11 +1964,77 @@ func TestGetListenerName(t *testing.T) {
 
 func TestHasListenerChanged(t *testing.T) {
 	var testCases = []struct {
-		name     string
-		desired  client.GenericListener
-		actual   client.GenericListener
-		expected bool
+		name          string
+		desired       client.GenericListener
+		actual        client.GenericListener
+		manageRouting bool
+		expected      bool
 	}{
+		{
+			name: "HostnameNames change",
+			desired: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+				HostnameNames:         []string{"app", "api"},
+			},
+			actual: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+				HostnameNames:         []string{"app"},
+			},
+			manageRouting: true,
+			expected:      true,
+		},
+		{
+			name: "PathRouteSetName change",
+			desired: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+				PathRouteSetName:      common.String("paths"),
+			},
+			actual: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+			},
+			manageRouting: true,
+			expected:      true,
+		},
+		{
+			name: "RoutingPolicyName change",
+			desired: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+			},
+			actual: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+				RoutingPolicyName:     common.String("policy"),
+			},
+			manageRouting: true,
+			expected:      true,
+		},
+		{
+			name: "routing not managed",
+			desired: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+			},
+			actual: client.GenericListener{
+				DefaultBackendSetName: common.String("HTTP-80"),
+				Protocol:              common.String("HTTP"),
+				Port:                  common.Int(80),
+				HostnameNames:         []string{"app"},
+				RoutingPolicyName:     common.String("policy"),
+			},
+			expected: false,
+		},
 		{
 			name: "DefaultBackendSetName changes",
 			desired: client.GenericListener{
@@ -1920,7 +2245,7 @@ func TestHasListenerChanged(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			isListenerChanged := hasListenerChanged(zap.S(), tt.actual, tt.desired, nil)
+			isListenerChanged := hasListenerChanged(zap.S(), tt.actual, tt.desired, nil, tt.manageRouting)
 			if isListenerChanged == tt.expected {
 				return
 			}
@@ -2409,6 +2734,115 @@ func TestHasBackendSetChanged(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "Session persistence added",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SessionPersistenceConfiguration: &client.GenericSessionPersistenceConfiguration{
+					CookieName:      common.String("JSESSIONID"),
+					DisableFallback: common.Bool(false),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+			},
+			expected: true,
+		},
+		{
+			name: "Session persistence cookie name changed",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SessionPersistenceConfiguration: &client.GenericSessionPersistenceConfiguration{
+					CookieName:      common.String("JSESSIONID"),
+					DisableFallback: common.Bool(false),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SessionPersistenceConfiguration: &client.GenericSessionPersistenceConfiguration{
+					CookieName:      common.String("*"),
+					DisableFallback: common.Bool(false),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Session persistence removed",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				SessionPersistenceConfiguration: &client.GenericSessionPersistenceConfiguration{
+					CookieName: common.String("JSESSIONID"),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "LB cookie session persistence unchanged",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName:      common.String("X-Oracle-BMC-LBS-Route"),
+					Path:            common.String("/"),
+					MaxAgeInSeconds: common.Int(3600),
+					DisableFallback: common.Bool(false),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName:      common.String("X-Oracle-BMC-LBS-Route"),
+					Path:            common.String("/"),
+					MaxAgeInSeconds: common.Int(3600),
+					DisableFallback: common.Bool(false),
+					IsSecure:        common.Bool(false),
+					IsHttpOnly:      common.Bool(true),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "LB cookie session persistence max age changed",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName:      common.String("X-Oracle-BMC-LBS-Route"),
+					Path:            common.String("/"),
+					MaxAgeInSeconds: common.Int(3600),
+					DisableFallback: common.Bool(false),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName:      common.String("X-Oracle-BMC-LBS-Route"),
+					Path:            common.String("/"),
+					DisableFallback: common.Bool(false),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "LB cookie session persistence domain changed",
+			desired: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName: common.String("X-Oracle-BMC-LBS-Route"),
+					Path:       common.String("/"),
+					Domain:     common.String("example.com"),
+				},
+			},
+			actual: client.GenericBackendSetDetails{
+				Policy: common.String("policy"),
+				LbCookieSessionPersistenceConfiguration: &client.GenericLbCookieSessionPersistenceConfiguration{
+					CookieName: common.String("X-Oracle-BMC-LBS-Route"),
+					Path:       common.String("/"),
+				},
+			},
+			expected: true,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -2497,6 +2931,21 @@ func TestGetSSLConfigurationChanges(t *testing.T) {
 				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateName", false, true),
 			},
 		},
+		{
+			name: "Certificate OCIDs Changed",
+			desired: client.GenericSslConfigurationDetails{
+				CertificateIds:                 []string{"ocid1.certificate.oc1..new"},
+				TrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..a"},
+			},
+			actual: client.GenericSslConfigurationDetails{
+				CertificateName:                common.String("lb-certificate"),
+				CertificateIds:                 []string{"ocid1.certificate.oc1..old"},
+				TrustedCertificateAuthorityIds: []string{"ocid1.cabundle.oc1..a"},
+			},
+			expected: []string{
+				fmt.Sprintf(changeFmtStr, "Listener:SSLConfiguration:CertificateIds", "ocid1.certificate.oc1..old", "ocid1.certificate.oc1..new"),
+			},
+		},
 		{
 			name: "Protocol Changed",
 			desired: client.GenericSslConfigurationDetails{
@@ -0,0 +1,236 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	cloudprovider "k8s.io/cloud-provider"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+// routeRuleDescriptionPrefix marks the route rules owned by the CCM. The full
+// description of an owned rule is <prefix>/<clusterName>/<nodeName>.
+const routeRuleDescriptionPrefix = "oci-ccm-route"
+
+var _ cloudprovider.Routes = &CloudProvider{}
+
+// routeRuleDescription returns the description identifying the route rule for
+// the given node in the given cluster.
+func routeRuleDescription(clusterName string, nodeName types.NodeName) string {
+	return fmt.Sprintf("%s/%s/%s", routeRuleDescriptionPrefix, clusterName, nodeName)
+}
+
+// routeRuleTargetNode returns the node targeted by the route rule if the rule
+// is owned by the given cluster.
+func routeRuleTargetNode(clusterName string, rule core.RouteRule) (types.NodeName, bool) {
+	if rule.Description == nil {
+		return "", false
+	}
+	prefix := routeRuleDescriptionPrefix + "/" + clusterName + "/"
+	if !strings.HasPrefix(*rule.Description, prefix) {
+		return "", false
+	}
+	nodeName := strings.TrimPrefix(*rule.Description, prefix)
+	if nodeName == "" || strings.Contains(nodeName, "/") {
+		return "", false
+	}
+	return types.NodeName(nodeName), true
+}
+
+// ListRoutes lists all routes that belong to the specified clusterName.
+func (cp *CloudProvider) ListRoutes(ctx context.Context, clusterName string) ([]*cloudprovider.Route, error) {
+	routeTable, _, err := cp.client.Networking(nil).GetRouteTable(ctx, cp.config.Routes.RouteTableID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get route table %q", cp.config.Routes.RouteTableID)
+	}
+
+	var routes []*cloudprovider.Route
+	for _, rule := range routeTable.RouteRules {
+		nodeName, ok := routeRuleTargetNode(clusterName, rule)
+		if !ok || rule.Destination == nil {
+			continue
+		}
+		routes = append(routes, &cloudprovider.Route{
+			Name:            *rule.Description,
+			TargetNode:      nodeName,
+			DestinationCIDR: *rule.Destination,
+		})
+	}
+	return routes, nil
+}
+
+// CreateRoute creates the described managed route. The route targets the
+// primary private IP of the node's primary VNIC.
+func (cp *CloudProvider) CreateRoute(ctx context.Context, clusterName string, nameHint string, route *cloudprovider.Route) error {
+	logger := cp.logger.With("nodeName", route.TargetNode, "destinationCIDR", route.DestinationCIDR, "routeTableID", cp.config.Routes.RouteTableID)
+
+	targetID, err := cp.getRouteTargetID(ctx, route)
+	if err != nil {
+		return err
+	}
+
+	description := routeRuleDescription(clusterName, route.TargetNode)
+	desired := core.RouteRule{
+		NetworkEntityId: common.String(targetID),
+		Destination:     common.String(route.DestinationCIDR),
+		DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+		Description:     common.String(description),
+	}
+
+	return cp.updateRouteRules(ctx, func(rules []core.RouteRule) ([]core.RouteRule, bool, error) {
+		updated := make([]core.RouteRule, 0, len(rules)+1)
+		for _, rule := range rules {
+			if rule.Destination == nil || *rule.Destination != route.DestinationCIDR {
+				updated = append(updated, rule)
+				continue
+			}
+			if _, owned := routeRuleTargetNode(clusterName, rule); !owned {
+				return nil, false, errors.Errorf("route table %q already has a rule for %q which is not managed by this cluster",
+					cp.config.Routes.RouteTableID, route.DestinationCIDR)
+			}
+			if rule.NetworkEntityId != nil && *rule.NetworkEntityId == targetID &&
+				rule.Description != nil && *rule.Description == description {
+				logger.Info("Route already exists")
+				return rules, false, nil
+			}
+			// Stale rule for the same CIDR, e.g. the node was recreated.
+			// It is replaced by the desired rule below.
+		}
+		logger.Info("Creating route")
+		return append(updated, desired), true, nil
+	})
+}
+
+// DeleteRoute deletes the specified managed route. Route should be as
+// returned by ListRoutes.
+func (cp *CloudProvider) DeleteRoute(ctx context.Context, clusterName string, route *cloudprovider.Route) error {
+	logger := cp.logger.With("nodeName", route.TargetNode, "destinationCIDR", route.DestinationCIDR, "routeTableID", cp.config.Routes.RouteTableID)
+
+	return cp.updateRouteRules(ctx, func(rules []core.RouteRule) ([]core.RouteRule, bool, error) {
+		updated := make([]core.RouteRule, 0, len(rules))
+		for _, rule := range rules {
+			if nodeName, owned := routeRuleTargetNode(clusterName, rule); owned && nodeName == route.TargetNode &&
+				rule.Destination != nil && *rule.Destination == route.DestinationCIDR {
+				continue
+			}
+			updated = append(updated, rule)
+		}
+		if len(updated) == len(rules) {
+			logger.Info("Route does not exist")
+			return rules, false, nil
+		}
+		logger.Info("Deleting route")
+		return updated, true, nil
+	})
+}
+
+// updateRouteRules applies mutate to the current rules of the configured route
+// table and writes the result back using the route table etag. The operation
+// is retried with fresh rules whenever a concurrent update wins the race.
+func (cp *CloudProvider) updateRouteRules(ctx context.Context, mutate func([]core.RouteRule) ([]core.RouteRule, bool, error)) error {
+	routeTableID := cp.config.Routes.RouteTableID
+	return retry.OnError(retry.DefaultRetry, client.IsPreconditionFailed, func() error {
+		routeTable, etag, err := cp.client.Networking(nil).GetRouteTable(ctx, routeTableID)
+		if err != nil {
+			return errors.Wrapf(err, "get route table %q", routeTableID)
+		}
+		if etag == nil {
+			return errors.Errorf("route table %q returned no etag", routeTableID)
+		}
+
+		rules, changed, err := mutate(routeTable.RouteRules)
+		if err != nil || !changed {
+			return err
+		}
+
+		_, err = cp.client.Networking(nil).UpdateRouteTable(ctx, routeTableID, *etag, rules)
+		if err != nil {
+			if client.IsPreconditionFailed(err) {
+				cp.logger.With("routeTableID", routeTableID).Info("Route table was modified concurrently, retrying")
+				return err
+			}
+			return errors.Wrapf(err, "update route table %q", routeTableID)
+		}
+		return nil
+	})
+}
+
+// getRouteTargetID returns the OCID of the primary VNIC IP that traffic for the
+// route destination should be forwarded to.
+func (cp *CloudProvider) getRouteTargetID(ctx context.Context, route *cloudprovider.Route) (string, error) {
+	_, cidr, err := net.ParseCIDR(route.DestinationCIDR)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid route destination %q", route.DestinationCIDR)
+	}
+
+	node, err := cp.NodeLister.Get(string(route.TargetNode))
+	if err != nil {
+		return "", errors.Wrapf(err, "get node %q", route.TargetNode)
+	}
+	instanceID, err := MapProviderIDToResourceID(node.Spec.ProviderID)
+	if err != nil {
+		return "", errors.Wrap(err, "MapProviderIDToResourceID")
+	}
+	compartmentID, err := cp.getCompartmentIDByInstanceID(instanceID)
+	if err != nil {
+		return "", err
+	}
+	vnic, err := cp.client.Compute().GetPrimaryVNICForInstance(ctx, compartmentID, instanceID)
+	if err != nil {
+		return "", errors.Wrap(err, "GetPrimaryVNICForInstance")
+	}
+	if vnic == nil || vnic.Id == nil {
+		return "", errors.Errorf("no primary VNIC found for node %q", route.TargetNode)
+	}
+	// Traffic for the pod CIDR is not addressed to the node itself so the VNIC
+	// drops it unless the source/destination check is disabled.
+	if vnic.SkipSourceDestCheck == nil || !*vnic.SkipSourceDestCheck {
+		return "", errors.Errorf("primary VNIC %q of node %q must skip the source/destination check to route pod traffic", *vnic.Id, route.TargetNode)
+	}
+
+	if cidr.IP.To4() == nil {
+		ipv6s, err := cp.client.Networking(nil).ListIpv6s(ctx, *vnic.Id)
+		if err != nil {
+			return "", errors.Wrap(err, "ListIpv6s")
+		}
+		for _, ipv6 := range ipv6s {
+			if ipv6.Id != nil {
+				return *ipv6.Id, nil
+			}
+		}
+		return "", errors.Errorf("no IPv6 address found on primary VNIC %q of node %q", *vnic.Id, route.TargetNode)
+	}
+
+	privateIPs, err := cp.client.Networking(nil).ListPrivateIps(ctx, *vnic.Id)
+	if err != nil {
+		return "", errors.Wrap(err, "ListPrivateIps")
+	}
+	for _, privateIP := range privateIPs {
+		if privateIP.IsPrimary != nil && *privateIP.IsPrimary && privateIP.Id != nil {
+			return *privateIP.Id, nil
+		}
+	}
+	return "", errors.Errorf("no primary private IP found on primary VNIC %q of node %q", *vnic.Id, route.TargetNode)
+}
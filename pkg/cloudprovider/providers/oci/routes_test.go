@@ -0,0 +1,318 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	cloudprovider "k8s.io/cloud-provider"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+)
+
+// fakeRouteTable is an in-memory route table that enforces etag matching on
+// update. conflicts simulates concurrent writers winning the race.
+type fakeRouteTable struct {
+	rules     []core.RouteRule
+	etag      int
+	conflicts int
+}
+
+var (
+	routeTables = map[string]*fakeRouteTable{}
+
+	vnicPrivateIps = map[string][]core.PrivateIp{
+		"ocid1.vnic.route-node": {
+			{Id: common.String("ocid1.privateip.secondary"), IsPrimary: common.Bool(false)},
+			{Id: common.String("ocid1.privateip.primary"), IsPrimary: common.Bool(true)},
+		},
+	}
+)
+
+func (c *MockVirtualNetworkClient) GetRouteTable(ctx context.Context, id string) (*core.RouteTable, *string, error) {
+	rt, ok := routeTables[id]
+	if !ok {
+		return nil, nil, mockServiceError{StatusCode: http.StatusNotFound, Code: "NotAuthorizedOrNotFound", Message: "route table not found"}
+	}
+	rules := append([]core.RouteRule{}, rt.rules...)
+	return &core.RouteTable{Id: common.String(id), RouteRules: rules}, common.String(strconv.Itoa(rt.etag)), nil
+}
+
+func (c *MockVirtualNetworkClient) UpdateRouteTable(ctx context.Context, id, etag string, routeRules []core.RouteRule) (*core.RouteTable, error) {
+	rt, ok := routeTables[id]
+	if !ok {
+		return nil, mockServiceError{StatusCode: http.StatusNotFound, Code: "NotAuthorizedOrNotFound", Message: "route table not found"}
+	}
+	if rt.conflicts > 0 {
+		rt.conflicts--
+		rt.etag++
+	}
+	if etag != strconv.Itoa(rt.etag) {
+		return nil, mockServiceError{StatusCode: http.StatusPreconditionFailed, Code: "NoEtagMatch", Message: "etag mismatch"}
+	}
+	rt.rules = routeRules
+	rt.etag++
+	return &core.RouteTable{Id: common.String(id), RouteRules: routeRules}, nil
+}
+
+func newRoutesTestCloudProvider() *CloudProvider {
+	return &CloudProvider{
+		client: MockOCIClient{},
+		config: &providercfg.Config{
+			CompartmentID: "testCompartment",
+			Routes:        &providercfg.RoutesConfig{RouteTableID: "ocid1.routetable"},
+		},
+		NodeLister: &mockNodeLister{nodes: []*v1.Node{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "route-node",
+					Annotations: map[string]string{CompartmentIDAnnotation: "default"},
+				},
+				Spec: v1.NodeSpec{ProviderID: "ocid1.route-instance"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "route-node-source-dest-check",
+					Annotations: map[string]string{CompartmentIDAnnotation: "default"},
+				},
+				Spec: v1.NodeSpec{ProviderID: "ocid1.route-instance-source-dest-check"},
+			},
+		}},
+		instanceCache: &mockInstanceCache{},
+		logger:        zap.S(),
+	}
+}
+
+func foreignRouteRule() core.RouteRule {
+	return core.RouteRule{
+		NetworkEntityId: common.String("ocid1.internetgateway"),
+		Destination:     common.String("0.0.0.0/0"),
+		DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+	}
+}
+
+func clusterRouteRule(clusterName, nodeName, cidr, target string) core.RouteRule {
+	return core.RouteRule{
+		NetworkEntityId: common.String(target),
+		Destination:     common.String(cidr),
+		DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+		Description:     common.String(routeRuleDescription(clusterName, types.NodeName(nodeName))),
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	cp := newRoutesTestCloudProvider()
+	if _, ok := cp.Routes(); !ok {
+		t.Errorf("expected routes to be supported when a route table is configured")
+	}
+
+	cp.config.Routes = nil
+	if _, ok := cp.Routes(); ok {
+		t.Errorf("expected routes to be unsupported when no route table is configured")
+	}
+}
+
+func TestListRoutes(t *testing.T) {
+	routeTables = map[string]*fakeRouteTable{
+		"ocid1.routetable": {rules: []core.RouteRule{
+			foreignRouteRule(),
+			clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			clusterRouteRule("other", "other-node", "10.245.1.0/24", "ocid1.privateip.other"),
+		}},
+	}
+	cp := newRoutesTestCloudProvider()
+
+	routes, err := cp.ListRoutes(context.Background(), "kubernetes")
+	if err != nil {
+		t.Fatalf("ListRoutes got unexpected error %v", err)
+	}
+	expected := []*cloudprovider.Route{{
+		Name:            routeRuleDescription("kubernetes", "route-node"),
+		TargetNode:      "route-node",
+		DestinationCIDR: "10.244.1.0/24",
+	}}
+	if !reflect.DeepEqual(routes, expected) {
+		t.Errorf("ListRoutes => %+v, want %+v", routes, expected)
+	}
+}
+
+func TestCreateRoute(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rules     []core.RouteRule
+		conflicts int
+		route     *cloudprovider.Route
+		expected  []core.RouteRule
+		wantErr   bool
+	}{
+		{
+			name:  "creates route to primary private ip",
+			rules: []core.RouteRule{foreignRouteRule()},
+			route: &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "10.244.1.0/24"},
+			expected: []core.RouteRule{
+				foreignRouteRule(),
+				clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+		},
+		{
+			name:      "retries on etag mismatch",
+			rules:     []core.RouteRule{foreignRouteRule()},
+			conflicts: 2,
+			route:     &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "10.244.1.0/24"},
+			expected: []core.RouteRule{
+				foreignRouteRule(),
+				clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+		},
+		{
+			name: "replaces stale route for the same cidr",
+			rules: []core.RouteRule{
+				clusterRouteRule("kubernetes", "old-node", "10.244.1.0/24", "ocid1.privateip.old"),
+			},
+			route: &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "10.244.1.0/24"},
+			expected: []core.RouteRule{
+				clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+		},
+		{
+			name: "existing route is left untouched",
+			rules: []core.RouteRule{
+				clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+			route: &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "10.244.1.0/24"},
+			expected: []core.RouteRule{
+				clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+		},
+		{
+			name:     "unmanaged rule for the same cidr",
+			rules:    []core.RouteRule{foreignRouteRule()},
+			route:    &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "0.0.0.0/0"},
+			expected: []core.RouteRule{foreignRouteRule()},
+			wantErr:  true,
+		},
+		{
+			name:     "source destination check enabled",
+			rules:    []core.RouteRule{},
+			route:    &cloudprovider.Route{TargetNode: "route-node-source-dest-check", DestinationCIDR: "10.244.2.0/24"},
+			expected: []core.RouteRule{},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown node",
+			rules:    []core.RouteRule{},
+			route:    &cloudprovider.Route{TargetNode: "missing-node", DestinationCIDR: "10.244.3.0/24"},
+			expected: []core.RouteRule{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			routeTables = map[string]*fakeRouteTable{
+				"ocid1.routetable": {rules: tt.rules, conflicts: tt.conflicts},
+			}
+			cp := newRoutesTestCloudProvider()
+
+			err := cp.CreateRoute(context.Background(), "kubernetes", "uid", tt.route)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateRoute got error %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(routeTables["ocid1.routetable"].rules, tt.expected) {
+				t.Errorf("CreateRoute rules => %+v, want %+v", routeTables["ocid1.routetable"].rules, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDeleteRoute(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rules     []core.RouteRule
+		conflicts int
+		route     *cloudprovider.Route
+		expected  []core.RouteRule
+	}{
+		{
+			name: "deletes managed route",
+			rules: []core.RouteRule{
+				foreignRouteRule(),
+				clusterRouteRule("kubernetes", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+			conflicts: 1,
+			route:     &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "10.244.1.0/24"},
+			expected:  []core.RouteRule{foreignRouteRule()},
+		},
+		{
+			name: "keeps routes of other clusters",
+			rules: []core.RouteRule{
+				clusterRouteRule("other", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+			route: &cloudprovider.Route{TargetNode: "route-node", DestinationCIDR: "10.244.1.0/24"},
+			expected: []core.RouteRule{
+				clusterRouteRule("other", "route-node", "10.244.1.0/24", "ocid1.privateip.primary"),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			routeTables = map[string]*fakeRouteTable{
+				"ocid1.routetable": {rules: tt.rules, conflicts: tt.conflicts},
+			}
+			cp := newRoutesTestCloudProvider()
+
+			if err := cp.DeleteRoute(context.Background(), "kubernetes", tt.route); err != nil {
+				t.Fatalf("DeleteRoute got unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(routeTables["ocid1.routetable"].rules, tt.expected) {
+				t.Errorf("DeleteRoute rules => %+v, want %+v", routeTables["ocid1.routetable"].rules, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRouteRuleTargetNode(t *testing.T) {
+	testCases := []struct {
+		name        string
+		description *string
+		nodeName    types.NodeName
+		owned       bool
+	}{
+		{name: "no description", description: nil},
+		{name: "unrelated description", description: common.String("default route")},
+		{name: "other cluster", description: common.String(routeRuleDescription("other", "node"))},
+		{name: "owned", description: common.String(routeRuleDescription("kubernetes", "node")), nodeName: "node", owned: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeName, owned := routeRuleTargetNode("kubernetes", core.RouteRule{Description: tt.description})
+			if nodeName != tt.nodeName || owned != tt.owned {
+				t.Errorf("routeRuleTargetNode => (%q, %v), want (%q, %v)", nodeName, owned, tt.nodeName, tt.owned)
+			}
+		})
+	}
+}
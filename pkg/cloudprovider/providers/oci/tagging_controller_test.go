@@ -194,6 +194,7 @@ func (m recordingOCIClient) FSS(*ociClient.OCIClientConfig) ociClient.FileStorag
 func (m recordingOCIClient) Identity(*ociClient.OCIClientConfig) ociClient.IdentityInterface {
 	return nil
 }
+func (m recordingOCIClient) Limits() ociClient.LimitsInterface { return nil }
 func (m recordingOCIClient) NewWorkloadIdentityClient(*zap.SugaredLogger, string, *ociClient.OCIClientConfig) ociClient.Interface {
 	return m
 }
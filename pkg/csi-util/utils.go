@@ -72,6 +72,15 @@ const (
 	BalancedPerformanceOption     = 10
 	HigherPerformanceOption       = 20
 	MaxUltraHighPerformanceOption = 120
+	UltraHighPerformanceOption    = 30
+
+	// Block Volume Auto-tune
+	// DetachedVolumeAutotune enables lowering the volume to the lower cost
+	// performance level while it is detached.
+	DetachedVolumeAutotune = "detachedVolumeAutotune"
+	// AutotuneMaxVpusPerGB enables performance based auto-tune up to the given
+	// performance level. A value of 0 disables performance based auto-tune.
+	AutotuneMaxVpusPerGB = "autotuneMaxVpusPerGB"
 
 	InTransitEncryptionPackageName = "oci-fss-utils"
 	FIPS_ENABLED_FILE_PATH         = "/host/proc/sys/crypto/fips_enabled"
@@ -283,6 +292,43 @@ func ExtractBlockVolumePerformanceLevel(attribute string) (int64, error) {
 	return vpusPerGB, nil
 }
 
+// ExtractDetachedVolumeAutotune extracts whether detached volume auto-tune is
+// enabled from given string input
+func ExtractDetachedVolumeAutotune(attribute string) (bool, error) {
+	enabled, err := strconv.ParseBool(attribute)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "unable to parse %s value %s as bool", DetachedVolumeAutotune, attribute)
+	}
+	return enabled, nil
+}
+
+// ExtractAutotuneMaxVpusPerGB extracts the maximum performance level of
+// performance based auto-tune as int64 from given string input
+func ExtractAutotuneMaxVpusPerGB(attribute string) (int64, error) {
+	maxVpusPerGB, err := strconv.ParseInt(attribute, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "unable to parse %s value %s as int64", AutotuneMaxVpusPerGB, attribute)
+	}
+	if maxVpusPerGB == 0 {
+		return 0, nil
+	}
+	if maxVpusPerGB < BalancedPerformanceOption || maxVpusPerGB > MaxUltraHighPerformanceOption {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s : %s provided. Supported values are 0 to disable "+
+			"performance based auto-tune or between %d and %d", AutotuneMaxVpusPerGB, attribute, BalancedPerformanceOption, MaxUltraHighPerformanceOption)
+	}
+	return maxVpusPerGB, nil
+}
+
+// ValidateAutotuneMaxVpusPerGB checks that performance based auto-tune, when
+// enabled, does not cap the volume below its default performance level.
+func ValidateAutotuneMaxVpusPerGB(maxVpusPerGB, vpusPerGB int64) error {
+	if maxVpusPerGB != 0 && maxVpusPerGB < vpusPerGB {
+		return status.Errorf(codes.InvalidArgument, "%s %d must not be lower than %s %d",
+			AutotuneMaxVpusPerGB, maxVpusPerGB, VpusPerGB, vpusPerGB)
+	}
+	return nil
+}
+
 func ExtractISCSIInformationFromMountPath(logger *zap.SugaredLogger, diskPath []string) (*disk.Disk, error) {
 
 	logger.Info("Getting ISCSIInfo for the mount path: ", diskPath)
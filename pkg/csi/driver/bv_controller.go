@@ -18,12 +18,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"go.uber.org/zap"
@@ -31,6 +34,7 @@ import (
 	"google.golang.org/grpc/status"
 	kubeAPI "k8s.io/api/core/v1"
 	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
 	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
@@ -59,6 +63,9 @@ const (
 	backupTypeIncremental         = "incremental"
 	backupDefinedTags             = "oci.oraclecloud.com/defined-tags"
 	backupFreeformTags            = "oci.oraclecloud.com/freeform-tags"
+	backupCopyRegion              = "backupCopyRegion"
+	backupCopyIdTag               = "BackupCopyId"
+	backupCopyRegionTag           = "BackupCopyRegion"
 	newBackupAvailableTimeout     = 45 * time.Second
 	needResize                    = "needResize"
 	newSize                       = "newSize"
@@ -70,8 +77,35 @@ const (
 	OkeSystemTagNamesapce              = "orcl-containerengine"
 	MaxDefinedTagPerVolume             = 64
 	maxVolumeAttachDetachErrorMsgBytes = 1024
+	replicaAvailabilityDomain          = "replica-availability-domain"
+	replicaKmsKey                      = "replica-kms-key-id"
+	// backupPolicy is the OCID of a volume backup policy, or the name of one
+	// of the Oracle defined policies, that is assigned to new volumes
+	backupPolicy = "backup-policy"
+	// backupPolicyId is the volume context key of the assigned backup policy
+	backupPolicyId = "backupPolicyId"
+	// scsiPersistentReservation enables SCSI-3 persistent reservations on new
+	// volumes, which are used to fence nodes off shareable volumes
+	scsiPersistentReservation = "scsi-persistent-reservation"
+	// reservationsEnabled is the volume context key set for volumes with SCSI-3
+	// persistent reservations enabled
+	reservationsEnabled = "reservationsEnabled"
+	// volumeSourceAnnotation on a PVC holds the OCID of an activated block
+	// volume replica to create the volume from. The PVC is found through the
+	// metadata added to the parameters by the csi-provisioner when it runs
+	// with --extra-create-metadata.
+	volumeSourceAnnotation = "volume.beta.kubernetes.io/oci-volume-source"
+	pvcNameKey             = "csi.storage.k8s.io/pvc/name"
+	pvcNamespaceKey        = "csi.storage.k8s.io/pvc/namespace"
+	// volumeAttachmentStuckTimeout is how long a volume attachment may be
+	// attaching before the volume is reported as abnormal
+	volumeAttachmentStuckTimeout = 10 * time.Minute
 )
 
+// clusterUIDTag is the freeform tag holding the UID of the kube-system
+// namespace of the cluster which created a volume.
+const clusterUIDTag = "ClusterUID"
+
 var (
 	// OCI Block Storage and Compute support shareable block volume attachments
 	// This driver supports Mounted (Filesystem) and Block (raw block device) AccessTypes (volumeMode in k8s)
@@ -101,6 +135,18 @@ type VolumeParameters struct {
 	definedTags map[string]map[string]interface{}
 	//volume performance units per gb describes the block volume performance level
 	vpusPerGB int64
+	// full name of the availability domain, in this or another region, the volume is replicated to
+	replicaAvailabilityDomain string
+	// KMS key that encrypts a cross region replica in the destination region
+	replicaKmsKey string
+	// OCID or Oracle defined name (gold, silver or bronze) of the backup policy assigned to the volume
+	backupPolicy string
+	// whether the volume is tuned to the lower cost performance level while detached
+	detachedVolumeAutotune bool
+	// maximum performance level of performance based auto-tune, 0 disables it
+	autotuneMaxVpusPerGB int64
+	// whether SCSI-3 persistent reservations are enabled for the volume
+	reservationsEnabled bool
 }
 
 // VolumeAttachmentOption holds config for attachments
@@ -117,6 +163,16 @@ type VolumeAttachmentOption struct {
 	maxVolumeAttachments int
 }
 
+// VolumeModifyParameters holds the mutable parameters of a block volume
+type VolumeModifyParameters struct {
+	//volume performance units per gb describes the block volume performance level
+	vpusPerGB *int64
+	// whether the volume is tuned to the lower cost performance level while detached
+	detachedVolumeAutotune *bool
+	// maximum performance level of performance based auto-tune, 0 disables it
+	autotuneMaxVpusPerGB *int64
+}
+
 type SnapshotParameters struct {
 	//backupType is the parameter which is used to decide if the backup created will be FULL or INCREMENTAL
 	backupType core.CreateVolumeBackupDetailsTypeEnum
@@ -124,6 +180,8 @@ type SnapshotParameters struct {
 	freeformTags map[string]string
 	// defined tags to add for backups
 	definedTags map[string]map[string]interface{}
+	// copyRegion is the region backups are copied to for disaster recovery
+	copyRegion string
 }
 
 func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]string) (VolumeParameters, error) {
@@ -178,9 +236,76 @@ func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]strin
 				return p, status.Error(codes.InvalidArgument, err.Error())
 			}
 			p.vpusPerGB = vpusPerGB
+
+		case replicaAvailabilityDomain:
+			p.replicaAvailabilityDomain = v
+		case replicaKmsKey:
+			p.replicaKmsKey = v
+		case backupPolicy:
+			policy, err := extractBackupPolicy(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.backupPolicy = policy
+		case csi_util.DetachedVolumeAutotune:
+			detached, err := csi_util.ExtractDetachedVolumeAutotune(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.detachedVolumeAutotune = detached
+		case csi_util.AutotuneMaxVpusPerGB:
+			maxVpusPerGB, err := csi_util.ExtractAutotuneMaxVpusPerGB(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.autotuneMaxVpusPerGB = maxVpusPerGB
+		case scsiPersistentReservation:
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return p, status.Errorf(codes.InvalidArgument, "unable to parse %s value %s as bool", scsiPersistentReservation, v)
+			}
+			p.reservationsEnabled = enabled
 		}
 
 	}
+	if p.replicaKmsKey != "" && p.replicaAvailabilityDomain == "" {
+		return p, status.Errorf(codes.InvalidArgument, "%s requires %s to be set", replicaKmsKey, replicaAvailabilityDomain)
+	}
+	if err := csi_util.ValidateAutotuneMaxVpusPerGB(p.autotuneMaxVpusPerGB, p.vpusPerGB); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// extractVolumeModifyParameters extracts the mutable parameters of a
+// VolumeAttributesClass. Only the parameters which are set are modified.
+func extractVolumeModifyParameters(parameters map[string]string) (VolumeModifyParameters, error) {
+	p := VolumeModifyParameters{}
+	for k, v := range parameters {
+		switch k {
+		case csi_util.VpusPerGB:
+			vpusPerGB, err := csi_util.ExtractBlockVolumePerformanceLevel(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.vpusPerGB = &vpusPerGB
+		case csi_util.DetachedVolumeAutotune:
+			detached, err := csi_util.ExtractDetachedVolumeAutotune(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.detachedVolumeAutotune = &detached
+		case csi_util.AutotuneMaxVpusPerGB:
+			maxVpusPerGB, err := csi_util.ExtractAutotuneMaxVpusPerGB(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.autotuneMaxVpusPerGB = &maxVpusPerGB
+		default:
+			return p, status.Errorf(codes.InvalidArgument, "parameter %s cannot be modified. supported parameters are %s, %s and %s",
+				k, csi_util.VpusPerGB, csi_util.DetachedVolumeAutotune, csi_util.AutotuneMaxVpusPerGB)
+		}
+	}
 	return p, nil
 }
 
@@ -222,6 +347,8 @@ func extractSnapshotParameters(parameters map[string]string) (SnapshotParameters
 					"in volumesnapshotclass. please check the parameters block on the volume snapshot class")
 			}
 			p.definedTags = definedTags
+		case backupCopyRegion:
+			p.copyRegion = strings.TrimSpace(v)
 		}
 	}
 	return p, nil
@@ -357,6 +484,43 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		}
 	}
 
+	srcReplicaId, err := d.getSourceReplicaID(ctx, log, req.GetParameters())
+	if err != nil {
+		return nil, err
+	}
+	if srcReplicaId != "" {
+		if volumeContentSource != nil {
+			log.With("blockVolumeReplicaID", srcReplicaId).Error("Both a volumeContentSource and a block volume replica are provided")
+			return nil, status.Errorf(codes.InvalidArgument, "a volume cannot be created from both a data source and block volume replica %s", srcReplicaId)
+		}
+
+		replica, err := d.client.BlockStorage().GetBlockVolumeReplica(ctx, srcReplicaId)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "get", "resource", "blockVolumeReplica", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Errorf("Failed to get block volume replica with ID %v", srcReplicaId)
+			if client.IsNotFound(err) {
+				return nil, status.Errorf(codes.NotFound, "Failed to get block volume replica with ID %v", srcReplicaId)
+			}
+			return nil, status.Errorf(codes.Internal, "Failed to fetch block volume replica with ID %v with error %v", srcReplicaId, err)
+		}
+		// the replica is ACTIVATING while a volume is created from it
+		if replica.LifecycleState != core.BlockVolumeReplicaLifecycleStateAvailable && replica.LifecycleState != core.BlockVolumeReplicaLifecycleStateActivating {
+			log.With("blockVolumeReplicaID", srcReplicaId, "lifecycleState", replica.LifecycleState).Error("Block volume replica is not available")
+			return nil, status.Errorf(codes.Unavailable, "block volume replica %s is in %s state", srcReplicaId, replica.LifecycleState)
+		}
+
+		availableDomainShortName = *replica.AvailabilityDomain
+		log.With("AD", availableDomainShortName, "blockVolumeReplicaID", srcReplicaId).Info("Using availability domain of block volume replica to provision volume.")
+
+		if replica.SizeInGBs != nil && *replica.SizeInGBs*client.GiB < size {
+			volumeContext[needResize] = "true"
+			volumeContext[newSize] = strconv.FormatInt(size, 10)
+		}
+		if client.IsIpv6SingleStackCluster() {
+			fullAvailabilityDomainName = availableDomainShortName
+		}
+	}
+
 	if req.AccessibilityRequirements != nil && req.AccessibilityRequirements.Preferred != nil && availableDomainShortName == "" {
 		for _, t := range req.AccessibilityRequirements.Preferred {
 			var ok bool
@@ -421,6 +585,15 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		return nil, status.Errorf(codes.InvalidArgument, "(%s) or (%s) is required in PreferredTopologies or allowedTopologies", kubeAPI.LabelTopologyZone, kubeAPI.LabelZoneFailureDomain)
 	}
 
+	backupPolicyID, err := d.resolveBackupPolicy(ctx, log, volumeParams.backupPolicy)
+	if err != nil {
+		errorType = util.GetError(err)
+		metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+		dimensionsMap[metrics.ComponentDimension] = metricDimension
+		metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
 	//make sure this method is idempotent by checking existence of volume with same name.
 	volumes, err := d.client.BlockStorage().GetVolumesByName(ctx, volumeName, d.config.CompartmentID)
 	if err != nil {
@@ -466,10 +639,30 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			fullAvailabilityDomainName = *ad.Name
 		}
 
-		bvTags := getBVTags(log, d.config.Tags, volumeParams)
+		replicas, err := d.getBlockVolumeReplicas(ctx, volumeName, volumeParams)
+		if err != nil {
+			log.With("replicaAvailabilityDomain", volumeParams.replicaAvailabilityDomain).With(zap.Error(err)).Error("Failed to get replica availability domain.")
+			errorType = util.GetError(err)
+			metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+			dimensionsMap[metrics.ComponentDimension] = metricDimension
+			metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid replica availability domain: %s", volumeParams.replicaAvailabilityDomain)
+		}
+
+		clusterUID, err := d.getClusterUID(ctx)
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to get the cluster UID.")
+			errorType = util.GetError(err)
+			metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+			dimensionsMap[metrics.ComponentDimension] = metricDimension
+			metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Unavailable, "failed to get the cluster UID: %v", err)
+		}
+		bvTags := withClusterUIDTag(getBVTags(log, d.config.Tags, volumeParams), clusterUID)
+		autotunePolicies := getAutotunePolicies(volumeParams.detachedVolumeAutotune, volumeParams.autotuneMaxVpusPerGB)
 
 		provisionedVolume, err = provision(ctx, log, d.client, volumeName, size, fullAvailabilityDomainName, d.config.CompartmentID, srcSnapshotId, srcVolumeId,
-			volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags)
+			srcReplicaId, volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, replicas, autotunePolicies, volumeParams.reservationsEnabled, bvTags)
 
 		if err != nil && client.IsSystemTagNotFoundOrNotAuthorisedError(log, errors.Unwrap(err)) {
 			log.With("Ad name", fullAvailabilityDomainName, "Compartment Id", d.config.CompartmentID).With(zap.Error(err)).Warn("New volume creation failed due to oke system tags error. sending metric & retrying without oke system tags")
@@ -481,7 +674,7 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			// retry provision without oke system tags
 			delete(bvTags.DefinedTags, OkeSystemTagNamesapce)
 			provisionedVolume, err = provision(ctx, log, d.client, volumeName, size, fullAvailabilityDomainName, d.config.CompartmentID, srcSnapshotId, srcVolumeId,
-				volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags)
+				srcReplicaId, volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, replicas, autotunePolicies, volumeParams.reservationsEnabled, bvTags)
 		}
 		if err != nil {
 			log.With("Ad name", fullAvailabilityDomainName, "Compartment Id", d.config.CompartmentID).With(zap.Error(err)).Error("New volume creation failed.")
@@ -509,6 +702,17 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		return nil, status.Errorf(codes.DeadlineExceeded, "Create volume failed with time out %v", err.Error())
 	}
 
+	if backupPolicyID != "" {
+		if err := d.assignBackupPolicy(ctx, log, *provisionedVolume.Id, backupPolicyID); err != nil {
+			errorType = util.GetError(err)
+			metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+			dimensionsMap[metrics.ComponentDimension] = metricDimension
+			metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to assign backup policy %s to volume %s: %v", backupPolicyID, *provisionedVolume.Id, err)
+		}
+		volumeContext[backupPolicyId] = backupPolicyID
+	}
+
 	volumeOCID := volumeName
 	if provisionedVolume.Id != nil {
 		volumeOCID = *provisionedVolume.Id
@@ -521,23 +725,15 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 
 	volumeContext[attachmentType] = volumeParams.attachmentParameter[attachmentType]
 	volumeContext[csi_util.VpusPerGB] = strconv.FormatInt(volumeParams.vpusPerGB, 10)
+	if volumeParams.reservationsEnabled {
+		volumeContext[reservationsEnabled] = "true"
+	}
 
 	return &csi.CreateVolumeResponse{
 		Volume: &csi.Volume{
-			VolumeId:      *provisionedVolume.Id,
-			CapacityBytes: *provisionedVolume.SizeInMBs * client.MiB,
-			AccessibleTopology: []*csi.Topology{
-				{
-					Segments: map[string]string{
-						kubeAPI.LabelTopologyZone: d.util.GetAvailableDomainInNodeLabel(*provisionedVolume.AvailabilityDomain),
-					},
-				},
-				{
-					Segments: map[string]string{
-						kubeAPI.LabelZoneFailureDomain: d.util.GetAvailableDomainInNodeLabel(*provisionedVolume.AvailabilityDomain),
-					},
-				},
-			},
+			VolumeId:           *provisionedVolume.Id,
+			CapacityBytes:      *provisionedVolume.SizeInMBs * client.MiB,
+			AccessibleTopology: d.getAccessibleTopology(*provisionedVolume.AvailabilityDomain),
 
 			VolumeContext: volumeContext,
 			ContentSource: volumeContentSource,
@@ -562,6 +758,24 @@ func (d *BlockVolumeControllerDriver) DeleteVolume(ctx context.Context, req *csi
 		return nil, status.Error(codes.InvalidArgument, "DeleteVolume Volume ID must be provided")
 	}
 
+	if !client.IsBootVolume(req.VolumeId) {
+		if err := d.disableVolumeReplication(ctx, log, req.VolumeId); err != nil {
+			errorType = util.GetError(err)
+			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, fmt.Errorf("failed to disable replication of volume, volumeId: %s, error: %v", req.VolumeId, err)
+		}
+
+		if err := d.removeBackupPolicyAssignments(ctx, log, req.VolumeId); err != nil {
+			errorType = util.GetError(err)
+			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, fmt.Errorf("failed to remove backup policy assignment of volume, volumeId: %s, error: %v", req.VolumeId, err)
+		}
+	}
+
 	log.Info("Deleting Volume")
 	err := d.client.BlockStorage().DeleteVolume(ctx, req.VolumeId)
 	if err != nil {
@@ -584,6 +798,132 @@ func (d *BlockVolumeControllerDriver) DeleteVolume(ctx context.Context, req *csi
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
+// disableVolumeReplication removes the replicas of the volume so it can be
+// deleted. A volume that cannot be found is left to DeleteVolume.
+func (d *BlockVolumeControllerDriver) disableVolumeReplication(ctx context.Context, log *zap.SugaredLogger, volumeID string) error {
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).With(zap.Error(err)).Error("Failed to get volume.")
+		return err
+	}
+	if volume == nil || len(volume.BlockVolumeReplicas) == 0 {
+		return nil
+	}
+
+	log.With("replicaCount", len(volume.BlockVolumeReplicas)).Info("Disabling replication of volume.")
+	_, err = d.client.BlockStorage().UpdateVolume(ctx, volumeID, core.UpdateVolumeDetails{
+		DisplayName:         volume.DisplayName,
+		BlockVolumeReplicas: []core.BlockVolumeReplicaDetails{},
+	})
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "update", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).With(zap.Error(err)).Error("Failed to disable replication of volume.")
+		return err
+	}
+
+	// The volume can only be deleted once its replicas are removed.
+	if _, err = d.client.BlockStorage().AwaitVolumeReplicasRemovedOrTimeout(ctx, volumeID); err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).With(zap.Error(err)).Error("Replicas of volume were not removed.")
+		return err
+	}
+	log.Info("Replication of volume is disabled.")
+	return nil
+}
+
+// extractBackupPolicy validates the backup-policy parameter, which is either
+// the OCID of a volume backup policy or the name of an Oracle defined policy.
+func extractBackupPolicy(v string) (string, error) {
+	v = strings.TrimSpace(v)
+	if v == "" || strings.HasPrefix(v, "ocid1.volumebackuppolicy.") {
+		return v, nil
+	}
+	name := strings.ToLower(v)
+	switch name {
+	case "gold", "silver", "bronze":
+		return name, nil
+	}
+	return "", fmt.Errorf("invalid %s: %s provided for storageclass. supported values are the OCID of a volume backup policy, gold, silver and bronze", backupPolicy, v)
+}
+
+// resolveBackupPolicy returns the OCID of the backup policy of the volume
+// parameters, looking the Oracle defined policies up by name.
+func (d *BlockVolumeControllerDriver) resolveBackupPolicy(ctx context.Context, log *zap.SugaredLogger, policy string) (string, error) {
+	if policy == "" || strings.HasPrefix(policy, "ocid1.volumebackuppolicy.") {
+		return policy, nil
+	}
+
+	// the Oracle defined policies are listed without a compartment
+	policies, err := d.client.BlockStorage().ListVolumeBackupPolicies(ctx, "")
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackupPolicy", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list volume backup policies.")
+		return "", status.Errorf(codes.Internal, "failed to list volume backup policies: %v", err)
+	}
+	for _, p := range policies {
+		if p.DisplayName != nil && strings.EqualFold(*p.DisplayName, policy) && p.Id != nil {
+			log.With("backupPolicy", policy, "backupPolicyID", *p.Id).Info("Resolved Oracle defined backup policy.")
+			return *p.Id, nil
+		}
+	}
+	log.With("backupPolicy", policy).Error("Backup policy not found.")
+	return "", status.Errorf(codes.InvalidArgument, "backup policy %s not found", policy)
+}
+
+// assignBackupPolicy assigns the backup policy to the volume unless it is
+// already assigned. A volume can only have one backup policy assigned.
+func (d *BlockVolumeControllerDriver) assignBackupPolicy(ctx context.Context, log *zap.SugaredLogger, volumeID, policyID string) error {
+	log = log.With("volumeID", volumeID, "backupPolicyID", policyID)
+	assignments, err := d.client.BlockStorage().GetVolumeBackupPolicyAssetAssignment(ctx, volumeID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get backup policy assignment of volume.")
+		return err
+	}
+	for _, assignment := range assignments {
+		if assignment.PolicyId != nil && *assignment.PolicyId == policyID {
+			log.Info("Backup policy is already assigned to volume.")
+			return nil
+		}
+		if assignment.PolicyId != nil {
+			return fmt.Errorf("volume already has backup policy %s assigned", *assignment.PolicyId)
+		}
+	}
+
+	if _, err := d.client.BlockStorage().CreateVolumeBackupPolicyAssignment(ctx, volumeID, policyID); err != nil {
+		log.With("service", "blockstorage", "verb", "create", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to assign backup policy to volume.")
+		return err
+	}
+	log.Info("Backup policy is assigned to volume.")
+	return nil
+}
+
+// removeBackupPolicyAssignments removes the backup policy assignments of the
+// volume so no more backups are scheduled for it.
+func (d *BlockVolumeControllerDriver) removeBackupPolicyAssignments(ctx context.Context, log *zap.SugaredLogger, volumeID string) error {
+	assignments, err := d.client.BlockStorage().GetVolumeBackupPolicyAssetAssignment(ctx, volumeID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get backup policy assignment of volume.")
+		return err
+	}
+	for _, assignment := range assignments {
+		log.With("policyAssignmentID", *assignment.Id, "backupPolicyID", *assignment.PolicyId).Info("Removing backup policy assignment of volume.")
+		err := d.client.BlockStorage().DeleteVolumeBackupPolicyAssignment(ctx, *assignment.Id)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to remove backup policy assignment of volume.")
+			return err
+		}
+	}
+	return nil
+}
+
 // ControllerPublishVolume attaches the given volume to the node
 func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Context, req *csi.ControllerPublishVolumeRequest) (*csi.ControllerPublishVolumeResponse, error) {
 	startTime := time.Now()
@@ -616,6 +956,11 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		}
 	}
 
+	// a file system cannot be mounted read/write on more than one node
+	if isShareable && req.VolumeCapability.GetBlock() == nil {
+		return nil, status.Error(codes.InvalidArgument, "MULTI_NODE_MULTI_WRITER access mode is only supported for raw block volumes")
+	}
+
 	log := d.logger.With("volumeID", req.VolumeId, "nodeId", req.NodeId, "csiOperation", "attach")
 
 	id, err := d.util.LookupNodeID(d.KubeClient, req.NodeId)
@@ -784,8 +1129,24 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 
 		// enforce isShareable volume attachment rules
 		if volumeAttachmentOptions.isShareable {
+			if len(volumeAttachments) >= volumeAttachmentOptions.maxVolumeAttachments {
+				log.Errorf("Volume already has %d attachments", len(volumeAttachments))
+				csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+				dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+				metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
+				return nil, status.Errorf(codes.ResourceExhausted, "Failed to attach volume %s to node %s. "+
+					"The volume already has the maximum of %d shareable attachments.", req.VolumeId, id, volumeAttachmentOptions.maxVolumeAttachments)
+			}
 			for _, attachment := range volumeAttachments {
-				// all existing attachments must be shareable
+				// all existing attachments must be shareable and read/write
+				if attachment.GetIsReadOnly() != nil && *attachment.GetIsReadOnly() {
+					log.Errorf("Volume attachment (ID: %s) to instance (ID: %s) is read only", *attachment.GetId(), *attachment.GetInstanceId())
+					csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+					dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+					metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
+					return nil, status.Errorf(codes.FailedPrecondition, "Failed to attach volume %s to node %s. "+
+						"The volume already has a read only attachment %s to instance %s.", req.VolumeId, id, *attachment.GetId(), *attachment.GetInstanceId())
+				}
 				if !*attachment.GetIsShareable() {
 					log.Errorf("Volume attachment (ID: %s) is not shareable and is already attached to instance (ID: %s)", *attachment.GetId(), *attachment.GetInstanceId())
 					csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
@@ -1073,14 +1434,316 @@ func (d *BlockVolumeControllerDriver) ValidateVolumeCapabilities(ctx context.Con
 	}, nil
 }
 
-// ListVolumes returns a list of all requested volumes
+// ListVolumes returns the block volumes provisioned by the driver in the
+// cluster compartment. The starting token is the offset of the first volume
+// in the list, which is ordered by creation time so that volumes created
+// between calls do not shift earlier pages.
 func (d *BlockVolumeControllerDriver) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+	log := d.logger.With("startingToken", req.StartingToken, "maxEntries", req.MaxEntries, "csiOperation", "listVolumes")
+
+	volumes, err := d.client.BlockStorage().ListVolumes(ctx, d.config.CompartmentID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list volumes.")
+		return nil, status.Errorf(codes.Internal, "failed to list volumes: %v", err)
+	}
+
+	clusterUID, err := d.getClusterUID(ctx)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the cluster UID.")
+		return nil, status.Errorf(codes.Internal, "failed to get the cluster UID: %v", err)
+	}
+
+	// The volumes created by other clusters sharing the compartment are not
+	// listed. Volumes created before they were tagged with the cluster UID are
+	// listed so that their attachments are not taken for detached.
+	csiVolumes := make([]core.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		if volume.Id == nil || volume.DisplayName == nil || !strings.HasPrefix(*volume.DisplayName, pvcPrefix+"-") {
+			continue
+		}
+		if volumeClusterUID, tagged := volume.FreeformTags[clusterUIDTag]; tagged && volumeClusterUID != clusterUID {
+			continue
+		}
+		csiVolumes = append(csiVolumes, volume)
+	}
+	sort.SliceStable(csiVolumes, func(i, j int) bool {
+		ti, tj := csiVolumes[i].TimeCreated, csiVolumes[j].TimeCreated
+		if ti != nil && tj != nil && !ti.Equal(tj.Time) {
+			return ti.Before(tj.Time)
+		}
+		return *csiVolumes[i].Id < *csiVolumes[j].Id
+	})
+
+	start, end, nextToken, err := getPage(req.StartingToken, req.MaxEntries, len(csiVolumes))
+	if err != nil {
+		return nil, err
+	}
+	page := csiVolumes[start:end]
+
+	publishedNodes, err := d.getPublishedNodeIDs(ctx, log, page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find the nodes volumes are published to: %v", err)
+	}
+
+	entries := make([]*csi.ListVolumesResponse_Entry, 0, len(page))
+	for _, volume := range page {
+		csiVolume := &csi.Volume{
+			VolumeId: *volume.Id,
+		}
+		if volume.SizeInMBs != nil {
+			csiVolume.CapacityBytes = *volume.SizeInMBs * client.MiB
+		}
+		if volume.AvailabilityDomain != nil {
+			csiVolume.AccessibleTopology = d.getAccessibleTopology(*volume.AvailabilityDomain)
+		}
+		entries = append(entries, &csi.ListVolumesResponse_Entry{
+			Volume: csiVolume,
+			Status: &csi.ListVolumesResponse_VolumeStatus{
+				PublishedNodeIds: publishedNodes[*volume.Id],
+			},
+		})
+	}
+
+	log.With("volumeCount", len(entries), "nextToken", nextToken).Info("Listed volumes.")
+	return &csi.ListVolumesResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+// getPublishedNodeIDs maps each of the given volumes to the names of the
+// cluster nodes it is attached to.
+func (d *BlockVolumeControllerDriver) getPublishedNodeIDs(ctx context.Context, log *zap.SugaredLogger, volumes []core.Volume) (map[string][]string, error) {
+	publishedNodes := make(map[string][]string, len(volumes))
+	if len(volumes) == 0 {
+		return publishedNodes, nil
+	}
+
+	volumeIDs := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		volumeIDs = append(volumeIDs, *volume.Id)
+	}
+	volumeAttachments, nodeNames, err := d.getVolumeAttachments(ctx, log, volumeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for volumeID, attachments := range volumeAttachments {
+		for _, attachment := range attachments {
+			if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateAttached || attachment.GetInstanceId() == nil {
+				continue
+			}
+			if nodeName, ok := nodeNames[*attachment.GetInstanceId()]; ok {
+				publishedNodes[volumeID] = append(publishedNodes[volumeID], nodeName)
+			}
+		}
+	}
+	return publishedNodes, nil
 }
 
-// GetCapacity returns the capacity of the storage pool
+// getVolumeAttachments returns the attachments of each of the given volumes
+// together with the names of the cluster nodes keyed by instance OCID.
+// Attachments are looked up in the compartments of the cluster nodes.
+func (d *BlockVolumeControllerDriver) getVolumeAttachments(ctx context.Context, log *zap.SugaredLogger, volumeIDs []string) (map[string][]core.VolumeAttachment, map[string]string, error) {
+	nodes, err := d.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to list nodes.")
+		return nil, nil, err
+	}
+
+	nodeNames := make(map[string]string, len(nodes.Items))
+	compartmentIDs := make([]string, 0)
+	for _, node := range nodes.Items {
+		if node.Spec.ProviderID == "" {
+			continue
+		}
+		nodeNames[client.MapProviderIDToInstanceID(node.Spec.ProviderID)] = node.Name
+
+		compartmentID := node.Annotations[util.CompartmentIDAnnotation]
+		if compartmentID == "" {
+			compartmentID = d.config.CompartmentID
+		}
+		if !slices.Contains(compartmentIDs, compartmentID) {
+			compartmentIDs = append(compartmentIDs, compartmentID)
+		}
+	}
+
+	// The attachments of a single volume are looked up by its OCID, those of
+	// several volumes are listed once per compartment.
+	volumeFilter := ""
+	if len(volumeIDs) == 1 {
+		volumeFilter = volumeIDs[0]
+	}
+	volumeAttachments := make(map[string][]core.VolumeAttachment, len(volumeIDs))
+	for _, compartmentID := range compartmentIDs {
+		attachments, err := d.client.Compute().ListVolumeAttachments(ctx, compartmentID, volumeFilter)
+		if err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			log.With("service", "compute", "verb", "list", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).With("volumeID", volumeFilter, "compartmentID", compartmentID).Error("Failed to list volume attachments.")
+			return nil, nil, err
+		}
+		for _, attachment := range attachments {
+			volumeID := volumeFilter
+			if volumeID == "" && attachment.GetVolumeId() != nil {
+				volumeID = *attachment.GetVolumeId()
+			}
+			if slices.Contains(volumeIDs, volumeID) {
+				volumeAttachments[volumeID] = append(volumeAttachments[volumeID], attachment)
+			}
+		}
+	}
+	return volumeAttachments, nodeNames, nil
+}
+
+// getAccessibleTopology returns the topology segments of a volume in the
+// given availability domain.
+func (d *BlockVolumeControllerDriver) getAccessibleTopology(availabilityDomain string) []*csi.Topology {
+	return []*csi.Topology{
+		{
+			Segments: map[string]string{
+				kubeAPI.LabelTopologyZone: d.util.GetAvailableDomainInNodeLabel(availabilityDomain),
+			},
+		},
+		{
+			Segments: map[string]string{
+				kubeAPI.LabelZoneFailureDomain: d.util.GetAvailableDomainInNodeLabel(availabilityDomain),
+			},
+		},
+	}
+}
+
+// getSourceReplicaID returns the OCID of the block volume replica in the
+// volume source annotation of the PVC the volume is created for, if any.
+func (d *BlockVolumeControllerDriver) getSourceReplicaID(ctx context.Context, log *zap.SugaredLogger, parameters map[string]string) (string, error) {
+	name, namespace := parameters[pvcNameKey], parameters[pvcNamespaceKey]
+	if name == "" || namespace == "" {
+		return "", nil
+	}
+
+	pvc, err := d.KubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.With("pvcName", name, "pvcNamespace", namespace).With(zap.Error(err)).Error("Failed to get PVC.")
+		return "", status.Errorf(codes.Internal, "failed to get PVC %s/%s: %v", namespace, name, err)
+	}
+	source := pvc.Annotations[volumeSourceAnnotation]
+	if source == "" {
+		return "", nil
+	}
+	if !client.IsBlockVolumeReplica(source) {
+		return "", status.Errorf(codes.InvalidArgument, "%s must be the OCID of a block volume replica, use the dataSource of the PVC to create a volume from a snapshot or another volume", volumeSourceAnnotation)
+	}
+	return source, nil
+}
+
+// getBlockVolumeReplicas returns the replica of a new volume requested by the
+// storage class parameters. A short availability domain name is resolved in
+// the region of the cluster.
+func (d *BlockVolumeControllerDriver) getBlockVolumeReplicas(ctx context.Context, volumeName string, volumeParams VolumeParameters) ([]core.BlockVolumeReplicaDetails, error) {
+	if volumeParams.replicaAvailabilityDomain == "" {
+		return nil, nil
+	}
+
+	availabilityDomain := volumeParams.replicaAvailabilityDomain
+	if !strings.Contains(availabilityDomain, ":") {
+		ad, err := d.client.Identity(nil).GetAvailabilityDomainByName(ctx, d.config.CompartmentID, availabilityDomain)
+		if err != nil {
+			return nil, err
+		}
+		availabilityDomain = *ad.Name
+	}
+
+	replicaName := volumeName + "-replica"
+	replica := core.BlockVolumeReplicaDetails{
+		AvailabilityDomain: &availabilityDomain,
+		DisplayName:        &replicaName,
+	}
+	if volumeParams.replicaKmsKey != "" {
+		replica.XrrKmsKeyId = &volumeParams.replicaKmsKey
+	}
+	return []core.BlockVolumeReplicaDetails{replica}, nil
+}
+
+// GetCapacity returns the block volume storage which is still available to the
+// cluster compartment in the availability domain of the accessible topology,
+// as reported by OCI service limits and compartment quotas. Without a topology
+// the capacity of all availability domains of the region is returned.
 func (d *BlockVolumeControllerDriver) GetCapacity(ctx context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+	log := d.logger.With("accessibleTopology", req.GetAccessibleTopology().GetSegments())
+
+	var availabilityDomains []string
+	if segments := req.GetAccessibleTopology().GetSegments(); len(segments) > 0 {
+		availabilityDomain, err := d.getTopologyAvailabilityDomain(ctx, segments)
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to get availability domain of topology.")
+			return nil, err
+		}
+		availabilityDomains = append(availabilityDomains, availabilityDomain)
+	} else {
+		ads, err := d.client.Identity(nil).ListAvailabilityDomains(ctx, d.config.CompartmentID)
+		if err != nil {
+			log.With("service", "identity", "verb", "list", "resource", "AD", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to list availability domains.")
+			return nil, status.Errorf(codes.Internal, "failed to list availability domains: %v", err)
+		}
+		for _, ad := range ads {
+			if ad.Name != nil {
+				availabilityDomains = append(availabilityDomains, *ad.Name)
+			}
+		}
+	}
+
+	var availableCapacity, maximumVolumeSize int64
+	for _, availabilityDomain := range availabilityDomains {
+		availability, err := d.client.Limits().GetResourceAvailability(ctx, client.BlockStorageLimitsService,
+			client.BlockStorageTotalStorageLimit, d.config.CompartmentID, availabilityDomain)
+		if err != nil {
+			log.With("service", "limits", "verb", "get", "resource", "resourceAvailability", "statusCode", util.GetHttpStatusCode(err)).
+				With("availabilityDomain", availabilityDomain).With(zap.Error(err)).Error("Failed to get block volume storage availability.")
+			return nil, status.Errorf(codes.Internal, "failed to get block volume storage availability in %s: %v", availabilityDomain, err)
+		}
+		if availability.Available == nil || *availability.Available <= 0 {
+			continue
+		}
+		available := *availability.Available * client.GiB
+		availableCapacity += available
+		maximumVolumeSize = csi_util.MaxOfInt(maximumVolumeSize, available)
+	}
+
+	if maximumVolumeSize > csi_util.MaximumVolumeSizeInBytes {
+		maximumVolumeSize = csi_util.MaximumVolumeSizeInBytes
+	}
+	log.With("availableCapacity", availableCapacity, "maximumVolumeSize", maximumVolumeSize).Debug("Block volume capacity retrieved.")
+	return &csi.GetCapacityResponse{
+		AvailableCapacity: availableCapacity,
+		MaximumVolumeSize: wrapperspb.Int64(maximumVolumeSize),
+		MinimumVolumeSize: wrapperspb.Int64(csi_util.MinimumVolumeSizeInBytes),
+	}, nil
+}
+
+// getTopologyAvailabilityDomain returns the full name of the availability
+// domain of the topology segments.
+func (d *BlockVolumeControllerDriver) getTopologyAvailabilityDomain(ctx context.Context, segments map[string]string) (string, error) {
+	if fullAvailabilityDomainName, ok := segments[csi_util.AvailabilityDomainLabel]; ok {
+		return strings.ReplaceAll(fullAvailabilityDomainName, ".", ":"), nil
+	}
+
+	availableDomainShortName, ok := segments[kubeAPI.LabelTopologyZone]
+	if !ok {
+		availableDomainShortName = segments[kubeAPI.LabelZoneFailureDomain]
+	}
+	if availableDomainShortName == "" {
+		return "", status.Errorf(codes.InvalidArgument, "(%s) or (%s) is required in the accessible topology", kubeAPI.LabelTopologyZone, kubeAPI.LabelZoneFailureDomain)
+	}
+
+	ad, err := d.client.Identity(nil).GetAvailabilityDomainByName(ctx, d.config.CompartmentID, availableDomainShortName)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid available domain: %s or compartment ID: %s", availableDomainShortName, d.config.CompartmentID)
+	}
+	return *ad.Name, nil
 }
 
 // ControllerGetCapabilities returns the capabilities of the controller service.
@@ -1101,7 +1764,14 @@ func (d *BlockVolumeControllerDriver) ControllerGetCapabilities(ctx context.Cont
 		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
 		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+		csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+		csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
+		csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+		csi.ControllerServiceCapability_RPC_GET_CAPACITY,
 	} {
 		caps = append(caps, newCap(cap))
 	}
@@ -1202,6 +1872,15 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		return nil, fmt.Errorf("duplicate snapshot %q exists", req.Name)
 	}
 
+	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
+	}
+
 	volumeBackupAvailableTimeoutCtx, cancel := csi_util.ShortenContextBeforeDeadline(ctx, 10*time.Second)
 	defer cancel()
 
@@ -1253,6 +1932,15 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 			}
 		}
 
+		if err = d.copyVolumeBackupToRegion(ctx, log, snapshot, snapshotParams.copyRegion); err != nil {
+			log.With("backupCopyRegion", snapshotParams.copyRegion).With(zap.Error(err)).Error("Failed to copy snapshot to region.")
+			errorType = util.GetError(err)
+			snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to copy snapshot %q to region %s: %v", req.Name, snapshotParams.copyRegion, err)
+		}
+
 		log.Info("Snapshot is created and available.")
 		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
 		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
@@ -1268,15 +1956,6 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		}, nil
 	}
 
-	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
-	if err != nil {
-		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
-		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
-		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
-		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
-	}
-
 	backupTags := &config.TagConfig{
 		FreeformTags: snapshotParams.freeformTags,
 		DefinedTags:  snapshotParams.definedTags,
@@ -1333,6 +2012,15 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		}
 	}
 
+	if err = d.copyVolumeBackupToRegion(ctx, log, *snapshot, snapshotParams.copyRegion); err != nil {
+		log.With("backupCopyRegion", snapshotParams.copyRegion).With(zap.Error(err)).Error("Failed to copy snapshot to region.")
+		errorType = util.GetError(err)
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to copy snapshot %q to region %s: %v", req.Name, snapshotParams.copyRegion, err)
+	}
+
 	log.Info("Snapshot is created and available.")
 	snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
 	dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
@@ -1349,6 +2037,81 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 	}, nil
 }
 
+// copyVolumeBackupToRegion copies an available volume backup to the region for
+// cross-region disaster recovery. The copy is recorded in the freeform tags of
+// the backup so that it is deleted together with the backup. A copy left by a
+// previous attempt is found by name in the destination region.
+func (d *BlockVolumeControllerDriver) copyVolumeBackupToRegion(ctx context.Context, log *zap.SugaredLogger, backup core.VolumeBackup, region string) error {
+	if region == "" {
+		return nil
+	}
+	if _, ok := backup.FreeformTags[backupCopyIdTag]; ok {
+		return nil
+	}
+
+	remote, err := d.client.BlockStorage().InRegion(region)
+	if err != nil {
+		return err
+	}
+	compartmentID := d.config.CompartmentID
+	if backup.CompartmentId != nil {
+		compartmentID = *backup.CompartmentId
+	}
+	copies, err := remote.GetVolumeBackupsByName(ctx, *backup.DisplayName, compartmentID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check existence of the copy of volume backup %s", *backup.Id)
+	}
+
+	var backupCopy *core.VolumeBackup
+	if len(copies) > 0 {
+		backupCopy = &copies[0]
+	} else {
+		backupCopy, err = d.client.BlockStorage().CopyVolumeBackup(ctx, *backup.Id, region, *backup.DisplayName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to copy volume backup %s", *backup.Id)
+		}
+	}
+
+	freeformTags := make(map[string]string, len(backup.FreeformTags)+2)
+	for k, v := range backup.FreeformTags {
+		freeformTags[k] = v
+	}
+	freeformTags[backupCopyIdTag] = *backupCopy.Id
+	freeformTags[backupCopyRegionTag] = region
+	if _, err = d.client.BlockStorage().UpdateVolumeBackup(ctx, *backup.Id, core.UpdateVolumeBackupDetails{FreeformTags: freeformTags}); err != nil {
+		return errors.Wrapf(err, "failed to record copy %s of volume backup %s", *backupCopy.Id, *backup.Id)
+	}
+
+	log.With("backupCopyId", *backupCopy.Id, "backupCopyRegion", region).Info("Snapshot is being copied to region.")
+	return nil
+}
+
+// deleteVolumeBackupCopy deletes the copy of the volume backup in another
+// region recorded by copyVolumeBackupToRegion.
+func (d *BlockVolumeControllerDriver) deleteVolumeBackupCopy(ctx context.Context, log *zap.SugaredLogger, id string) error {
+	backup, err := d.client.BlockStorage().GetVolumeBackup(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	copyID, region := backup.FreeformTags[backupCopyIdTag], backup.FreeformTags[backupCopyRegionTag]
+	if copyID == "" || region == "" {
+		return nil
+	}
+
+	remote, err := d.client.BlockStorage().InRegion(region)
+	if err != nil {
+		return err
+	}
+	if err = remote.DeleteVolumeBackup(ctx, copyID); err != nil && !client.IsNotFound(err) {
+		return err
+	}
+	log.With("backupCopyId", copyID, "backupCopyRegion", region).Info("Snapshot copy is deleted.")
+	return nil
+}
+
 // DeleteSnapshot will be called by the CO to delete a snapshot.
 func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
 	startTime := time.Now()
@@ -1367,6 +2130,16 @@ func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *c
 		return nil, status.Error(codes.InvalidArgument, "SnapshotId must be provided")
 	}
 
+	if err := d.deleteVolumeBackupCopy(ctx, log, req.SnapshotId); err != nil {
+		errorType = util.GetError(err)
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to delete snapshot copy.")
+		return nil, status.Errorf(codes.Internal, "failed to delete copy of snapshot %s: %v", req.SnapshotId, err)
+	}
+
 	err := d.client.BlockStorage().DeleteVolumeBackup(ctx, req.SnapshotId)
 	if err != nil && !k8sapierrors.IsNotFound(err) {
 		errorType = util.GetError(err)
@@ -1385,9 +2158,87 @@ func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *c
 	return &csi.DeleteSnapshotResponse{}, nil
 }
 
-// ListSnapshots returns all the matched snapshots
+// ListSnapshots returns the volume backups in the compartment of the cluster,
+// optionally filtered by snapshot ID or source volume ID. Backups which were
+// not created through the CSI driver are listed as well so they can be imported.
 func (d *BlockVolumeControllerDriver) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ListSnapshots is not supported yet")
+	log := d.logger.With("snapshotId", req.SnapshotId, "sourceVolumeId", req.SourceVolumeId, "startingToken", req.StartingToken,
+		"maxEntries", req.MaxEntries, "csiOperation", "listSnapshots")
+
+	if req.SnapshotId != "" {
+		backup, err := d.client.BlockStorage().GetVolumeBackup(ctx, req.SnapshotId)
+		if err != nil {
+			if client.IsNotFound(err) {
+				return &csi.ListSnapshotsResponse{}, nil
+			}
+			log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get volume backup.")
+			return nil, status.Errorf(codes.Internal, "failed to get snapshot %s: %v", req.SnapshotId, err)
+		}
+		if backup.LifecycleState == core.VolumeBackupLifecycleStateTerminating || backup.LifecycleState == core.VolumeBackupLifecycleStateTerminated {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		if req.SourceVolumeId != "" && (backup.VolumeId == nil || *backup.VolumeId != req.SourceVolumeId) {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		return &csi.ListSnapshotsResponse{
+			Entries: []*csi.ListSnapshotsResponse_Entry{{Snapshot: volumeBackupToCSISnapshot(*backup)}},
+		}, nil
+	}
+
+	backups, err := d.client.BlockStorage().ListVolumeBackups(ctx, d.config.CompartmentID, req.SourceVolumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list volume backups.")
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		ti, tj := backups[i].TimeCreated, backups[j].TimeCreated
+		if ti != nil && tj != nil && !ti.Equal(tj.Time) {
+			return ti.Before(tj.Time)
+		}
+		return *backups[i].Id < *backups[j].Id
+	})
+
+	start, end, nextToken, err := getPage(req.StartingToken, req.MaxEntries, len(backups))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*csi.ListSnapshotsResponse_Entry, 0, end-start)
+	for _, backup := range backups[start:end] {
+		entries = append(entries, &csi.ListSnapshotsResponse_Entry{
+			Snapshot: volumeBackupToCSISnapshot(backup),
+		})
+	}
+
+	log.With("snapshotCount", len(entries), "nextToken", nextToken).Info("Listed snapshots.")
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+// volumeBackupToCSISnapshot converts a volume backup to its CSI representation.
+// A backup is ready to use once it is available.
+func volumeBackupToCSISnapshot(backup core.VolumeBackup) *csi.Snapshot {
+	readyToUse, _ := isBlockVolumeAvailable(backup)
+	snapshot := &csi.Snapshot{
+		SnapshotId: *backup.Id,
+		ReadyToUse: readyToUse,
+	}
+	if backup.VolumeId != nil {
+		snapshot.SourceVolumeId = *backup.VolumeId
+	}
+	if backup.SizeInMBs != nil {
+		snapshot.SizeBytes = *backup.SizeInMBs * client.MiB
+	} else if backup.SizeInGBs != nil {
+		snapshot.SizeBytes = *backup.SizeInGBs * client.GiB
+	}
+	if backup.TimeCreated != nil {
+		snapshot.CreationTime = timestamppb.New(backup.TimeCreated.Time)
+	}
+	return snapshot
 }
 
 // ControllerExpandVolume returns ControllerExpandVolume request
@@ -1485,17 +2336,291 @@ func (d *BlockVolumeControllerDriver) ControllerExpandVolume(ctx context.Context
 	}, nil
 }
 
-// ControllerGetVolume returns ControllerGetVolumeResponse response
+// ControllerGetVolume returns the nodes the volume is published to and the
+// condition of the volume and its attachments as reported by OCI.
 func (d *BlockVolumeControllerDriver) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerGetVolume is not supported yet")
+	volumeID := req.GetVolumeId()
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Volume ID must be provided")
+	}
+	log := d.logger.With("volumeID", volumeID, "csiOperation", "getVolume")
+
+	var (
+		lifecycleState     string
+		sizeInMBs          *int64
+		availabilityDomain *string
+		err                error
+	)
+	if client.IsBootVolume(volumeID) {
+		var bootVolume *core.BootVolume
+		if bootVolume, err = d.client.BlockStorage().GetBootVolume(ctx, volumeID); err == nil {
+			lifecycleState, sizeInMBs, availabilityDomain = string(bootVolume.LifecycleState), bootVolume.SizeInMBs, bootVolume.AvailabilityDomain
+		}
+	} else {
+		var volume *core.Volume
+		if volume, err = d.client.BlockStorage().GetVolume(ctx, volumeID); err == nil {
+			lifecycleState, sizeInMBs, availabilityDomain = string(volume.LifecycleState), volume.SizeInMBs, volume.AvailabilityDomain
+		}
+	}
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume.")
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get volume %s: %v", volumeID, err)
+	}
+
+	volumeAttachments, nodeNames, err := d.getVolumeAttachments(ctx, log, []string{volumeID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find the nodes volume %s is published to: %v", volumeID, err)
+	}
+
+	var publishedNodeIDs []string
+	for _, attachment := range volumeAttachments[volumeID] {
+		if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateAttached || attachment.GetInstanceId() == nil {
+			continue
+		}
+		if nodeName, ok := nodeNames[*attachment.GetInstanceId()]; ok {
+			publishedNodeIDs = append(publishedNodeIDs, nodeName)
+		}
+	}
+
+	condition := getVolumeCondition(lifecycleState, volumeAttachments[volumeID], nodeNames)
+	if condition.Abnormal {
+		log.With("lifecycleState", lifecycleState).Warn(condition.Message)
+	}
+
+	csiVolume := &csi.Volume{
+		VolumeId: volumeID,
+	}
+	if sizeInMBs != nil {
+		csiVolume.CapacityBytes = *sizeInMBs * client.MiB
+	}
+	if availabilityDomain != nil {
+		csiVolume.AccessibleTopology = d.getAccessibleTopology(*availabilityDomain)
+	}
+	return &csi.ControllerGetVolumeResponse{
+		Volume: csiVolume,
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			PublishedNodeIds: publishedNodeIDs,
+			VolumeCondition:  condition,
+		},
+	}, nil
+}
+
+// getVolumeCondition reports a volume that is faulty or being terminated and
+// volume attachments which are stuck attaching, failed to log in to iSCSI or
+// attach the volume to an instance that is not a node of the cluster.
+func getVolumeCondition(lifecycleState string, attachments []core.VolumeAttachment, nodeNames map[string]string) *csi.VolumeCondition {
+	switch lifecycleState {
+	case string(core.VolumeLifecycleStateFaulty),
+		string(core.VolumeLifecycleStateTerminating),
+		string(core.VolumeLifecycleStateTerminated):
+		return abnormalVolumeCondition("volume is in %s state", lifecycleState)
+	}
+
+	for _, attachment := range attachments {
+		attachmentID, instanceID := "", ""
+		if attachment.GetId() != nil {
+			attachmentID = *attachment.GetId()
+		}
+		if attachment.GetInstanceId() != nil {
+			instanceID = *attachment.GetInstanceId()
+		}
+
+		switch attachment.GetLifecycleState() {
+		case core.VolumeAttachmentLifecycleStateAttaching:
+			if timeCreated := attachment.GetTimeCreated(); timeCreated != nil && time.Since(timeCreated.Time) > volumeAttachmentStuckTimeout {
+				return abnormalVolumeCondition("volume attachment %s to instance %s has been attaching since %s", attachmentID, instanceID, timeCreated.Time.Format(time.RFC3339))
+			}
+		case core.VolumeAttachmentLifecycleStateAttached:
+			if attachment.GetIscsiLoginState() == core.VolumeAttachmentIscsiLoginStateLoginFailed {
+				return abnormalVolumeCondition("iSCSI login of volume attachment %s to instance %s failed", attachmentID, instanceID)
+			}
+			if _, ok := nodeNames[instanceID]; !ok {
+				return abnormalVolumeCondition("volume is attached to instance %s which is not a node of the cluster", instanceID)
+			}
+		}
+	}
+	return healthyVolumeCondition()
+}
+
+// ControllerModifyVolume applies the mutable parameters of a
+// VolumeAttributesClass to an existing block volume.
+func (d *BlockVolumeControllerDriver) ControllerModifyVolume(ctx context.Context, req *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
+	startTime := time.Now()
+	volumeId := req.GetVolumeId()
+	if volumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ModifyVolume volumeId must be provided")
+	}
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "modifyVolume")
+	var errorType string
+	var csiMetricDimension string
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = volumeId
+
+	if client.IsBootVolume(volumeId) {
+		log.Error("Volume modification is not supported for Boot Volumes")
+		csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "Volume modification is not supported for Boot Volumes")
+	}
+
+	modifyParams, err := extractVolumeModifyParameters(req.GetMutableParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse mutable parameters.")
+		csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to find existence of volume")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check existence of volume %v", err)
+	}
+	log = log.With("volumeName", volume.DisplayName)
+
+	updateVolumeDetails, changed, err := getVolumeModifyDetails(volume, modifyParams)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Invalid volume modification requested.")
+		csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+	if !changed {
+		log.Info("Volume already has the requested attributes. No action needed.")
+		return &csi.ControllerModifyVolumeResponse{}, nil
+	}
+
+	_, err = d.client.BlockStorage().UpdateVolume(ctx, volumeId, updateVolumeDetails)
+	if err != nil {
+		message := fmt.Sprintf("Update volume failed %v", err)
+		log.With("service", "blockstorage", "verb", "update", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			Error(message)
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.Internal, message)
+	}
+	_, err = d.client.BlockStorage().AwaitVolumeAvailableORTimeout(ctx, volumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			Error("Volume modification failed with time out")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.DeadlineExceeded, "ControllerModifyVolume failed with time out %v", err.Error())
+	}
+
+	log.Info("Volume is modified.")
+	csiMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+	metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.ControllerModifyVolumeResponse{}, nil
+}
+
+// getVolumeModifyDetails returns the update needed to bring the volume to the
+// requested mutable parameters and whether any update is needed at all.
+func getVolumeModifyDetails(volume *core.Volume, p VolumeModifyParameters) (core.UpdateVolumeDetails, bool, error) {
+	details := core.UpdateVolumeDetails{
+		DisplayName: volume.DisplayName,
+	}
+	changed := false
+
+	if p.vpusPerGB != nil {
+		currentVpusPerGB := int64(csi_util.BalancedPerformanceOption)
+		if volume.VpusPerGB != nil {
+			currentVpusPerGB = *volume.VpusPerGB
+		}
+		// The attachment type of a volume is chosen from its performance level
+		// when it is published, so an attached volume cannot be moved across
+		// the Ultra High Performance boundary.
+		if (currentVpusPerGB >= csi_util.UltraHighPerformanceOption) != (*p.vpusPerGB >= csi_util.UltraHighPerformanceOption) {
+			return details, false, status.Errorf(codes.InvalidArgument, "changing %s from %d to %d is not supported, volumes cannot be "+
+				"modified to or from Ultra High Performance (%s >= %d)", csi_util.VpusPerGB, currentVpusPerGB, *p.vpusPerGB, csi_util.VpusPerGB, csi_util.UltraHighPerformanceOption)
+		}
+		if currentVpusPerGB != *p.vpusPerGB {
+			details.VpusPerGB = p.vpusPerGB
+			changed = true
+		}
+	}
+
+	if p.detachedVolumeAutotune != nil || p.autotuneMaxVpusPerGB != nil {
+		detached, maxVpusPerGB := getAutotuneSettings(volume.AutotunePolicies)
+		if p.detachedVolumeAutotune != nil {
+			detached = *p.detachedVolumeAutotune
+		}
+		if p.autotuneMaxVpusPerGB != nil {
+			maxVpusPerGB = *p.autotuneMaxVpusPerGB
+		}
+		vpusPerGB := volume.VpusPerGB
+		if details.VpusPerGB != nil {
+			vpusPerGB = details.VpusPerGB
+		}
+		if vpusPerGB != nil {
+			if err := csi_util.ValidateAutotuneMaxVpusPerGB(maxVpusPerGB, *vpusPerGB); err != nil {
+				return details, false, err
+			}
+		}
+		if currentDetached, currentMaxVpusPerGB := getAutotuneSettings(volume.AutotunePolicies); currentDetached != detached || currentMaxVpusPerGB != maxVpusPerGB {
+			details.AutotunePolicies = getAutotunePolicies(detached, maxVpusPerGB)
+			changed = true
+		}
+	}
+
+	return details, changed, nil
 }
 
-func (d *BlockVolumeControllerDriver) ControllerModifyVolume(ctx context.Context, request *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerModifyVolume is not supported yet")
+// getAutotuneSettings returns whether detached volume auto-tune is enabled and
+// the maximum performance level of performance based auto-tune, 0 if disabled.
+func getAutotuneSettings(policies []core.AutotunePolicy) (bool, int64) {
+	detached := false
+	maxVpusPerGB := int64(0)
+	for _, policy := range policies {
+		switch p := policy.(type) {
+		case core.DetachedVolumeAutotunePolicy:
+			detached = true
+		case core.PerformanceBasedAutotunePolicy:
+			if p.MaxVpusPerGB != nil {
+				maxVpusPerGB = *p.MaxVpusPerGB
+			}
+		}
+	}
+	return detached, maxVpusPerGB
+}
+
+// getAutotunePolicies returns the auto-tune policies for the given settings.
+// An empty list disables auto-tune.
+func getAutotunePolicies(detached bool, maxVpusPerGB int64) []core.AutotunePolicy {
+	policies := []core.AutotunePolicy{}
+	if detached {
+		policies = append(policies, core.DetachedVolumeAutotunePolicy{})
+	}
+	if maxVpusPerGB != 0 {
+		policies = append(policies, core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: &maxVpusPerGB})
+	}
+	return policies
 }
 
 func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volName string, volSize int64, availDomainName, compartmentID,
-	backupID, srcVolumeID, kmsKeyID string, vpusPerGB int64, bvTags *config.TagConfig) (core.Volume, error) {
+	backupID, srcVolumeID, srcReplicaID, kmsKeyID string, vpusPerGB int64, replicas []core.BlockVolumeReplicaDetails,
+	autotunePolicies []core.AutotunePolicy, reservationsEnabled bool, bvTags *config.TagConfig) (core.Volume, error) {
 
 	volSizeGB, minSizeGB := csi_util.RoundUpSize(volSize, 1*client.GiB), csi_util.RoundUpMinSize()
 
@@ -1515,6 +2640,20 @@ func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface,
 		volumeDetails.SourceDetails = &core.VolumeSourceFromVolumeBackupDetails{Id: &backupID}
 	} else if srcVolumeID != "" {
 		volumeDetails.SourceDetails = &core.VolumeSourceFromVolumeDetails{Id: &srcVolumeID}
+	} else if srcReplicaID != "" {
+		volumeDetails.SourceDetails = &core.VolumeSourceFromBlockVolumeReplicaDetails{Id: &srcReplicaID}
+	}
+
+	if len(replicas) > 0 {
+		volumeDetails.BlockVolumeReplicas = replicas
+	}
+
+	if len(autotunePolicies) > 0 {
+		volumeDetails.AutotunePolicies = autotunePolicies
+	}
+
+	if reservationsEnabled {
+		volumeDetails.IsReservationsEnabled = &reservationsEnabled
 	}
 
 	if kmsKeyID != "" {
@@ -1584,6 +2723,35 @@ func isBlockVolumeAvailable(backup core.VolumeBackup) (bool, error) {
 	return false, nil
 }
 
+// getClusterUID returns the UID of the kube-system namespace of the cluster.
+func (d *ControllerDriver) getClusterUID(ctx context.Context) (string, error) {
+	if d.KubeClient == nil {
+		return "", errors.New("kubernetes client is not configured")
+	}
+	return util.LookupClusterUID(ctx, d.KubeClient)
+}
+
+// withClusterUIDTag returns a copy of the tags of a volume with the UID of the
+// cluster creating it.
+func withClusterUIDTag(tags *config.TagConfig, clusterUID string) *config.TagConfig {
+	tagged := &config.TagConfig{FreeformTags: map[string]string{clusterUIDTag: clusterUID}}
+	if tags == nil {
+		return tagged
+	}
+	for k, v := range tags.FreeformTags {
+		if k != clusterUIDTag {
+			tagged.FreeformTags[k] = v
+		}
+	}
+	if tags.DefinedTags != nil {
+		tagged.DefinedTags = make(map[string]map[string]interface{}, len(tags.DefinedTags))
+		for k, v := range tags.DefinedTags {
+			tagged.DefinedTags[k] = v
+		}
+	}
+	return tagged
+}
+
 func getBVTags(logger *zap.SugaredLogger, tags *config.InitialTags, vp VolumeParameters) *config.TagConfig {
 
 	bvTags := &config.TagConfig{}
@@ -17,8 +17,10 @@ package driver
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"testing/synctest"
@@ -33,13 +35,19 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/limits"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	authv1 "k8s.io/api/authentication/v1"
 	kubeAPI "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/pointer"
 )
 
@@ -98,6 +106,123 @@ var (
 			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
 			Id:                 common.String("clone-volume-in-provisioning-state"),
 		},
+		"volume-from-replica": {
+			DisplayName:        common.String("volume-from-replica"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("volume-from-replica"),
+		},
+		"autotuned-volume": {
+			DisplayName:        common.String("autotuned-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("autotuned-volume"),
+		},
+		"backup-policy-volume": {
+			DisplayName:        common.String("backup-policy-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("backup-policy-volume"),
+		},
+		"replicated-volume": {
+			DisplayName:        common.String("replicated-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("replicated-volume"),
+			BlockVolumeReplicas: []core.BlockVolumeReplicaInfo{{
+				DisplayName:          common.String("replicated-volume-replica"),
+				BlockVolumeReplicaId: common.String("ocid1.blockvolumereplica.oc1.phx.replicated-volume"),
+				AvailabilityDomain:   common.String("NWuj:PHX-AD-3"),
+			}},
+		},
+		"replicated-volume-replicas-stuck": {
+			DisplayName:        common.String("replicated-volume-replicas-stuck"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("replicated-volume-replicas-stuck"),
+			BlockVolumeReplicas: []core.BlockVolumeReplicaInfo{{
+				DisplayName:          common.String("replicated-volume-replicas-stuck-replica"),
+				BlockVolumeReplicaId: common.String("ocid1.blockvolumereplica.oc1.phx.replicated-volume-replicas-stuck"),
+				AvailabilityDomain:   common.String("NWuj:PHX-AD-3"),
+			}},
+		},
+		"replicated-volume-update-fail": {
+			DisplayName:        common.String("replicated-volume-update-fail"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("replicated-volume-update-fail"),
+			BlockVolumeReplicas: []core.BlockVolumeReplicaInfo{{
+				DisplayName:          common.String("replicated-volume-update-fail-replica"),
+				BlockVolumeReplicaId: common.String("ocid1.blockvolumereplica.oc1.phx.replicated-volume-update-fail"),
+				AvailabilityDomain:   common.String("NWuj:PHX-AD-3"),
+			}},
+		},
+		"group-volume-1": {
+			Id:                 common.String("group-volume-1"),
+			DisplayName:        common.String("group-volume-1"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-1"),
+		},
+		"group-volume-2": {
+			Id:                 common.String("group-volume-2"),
+			DisplayName:        common.String("group-volume-2"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-1"),
+		},
+		"group-volume-other-ad": {
+			Id:                 common.String("group-volume-other-ad"),
+			DisplayName:        common.String("group-volume-other-ad"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+		},
+		"group-volume-in-group": {
+			Id:                 common.String("group-volume-in-group"),
+			DisplayName:        common.String("group-volume-in-group"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-1"),
+			VolumeGroupId:      common.String("foreign-volume-group"),
+		},
+		"csi-get-volume-healthy": {
+			DisplayName:        common.String("csi-get-volume-healthy"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-healthy"),
+		},
+		"csi-get-volume-faulty": {
+			DisplayName:        common.String("csi-get-volume-faulty"),
+			LifecycleState:     core.VolumeLifecycleStateFaulty,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-faulty"),
+		},
+		"csi-get-volume-stuck-attaching": {
+			DisplayName:        common.String("csi-get-volume-stuck-attaching"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-stuck-attaching"),
+		},
+		"csi-get-volume-login-failed": {
+			DisplayName:        common.String("csi-get-volume-login-failed"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-login-failed"),
+		},
+		"csi-get-volume-foreign-instance": {
+			DisplayName:        common.String("csi-get-volume-foreign-instance"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-foreign-instance"),
+		},
 	}
 
 	create_volume_requests = map[string]*csi.CreateVolumeRequest{
@@ -174,6 +299,65 @@ var (
 			IsMultipath:        common.Bool(true),
 			IsShareable:        common.Bool(false),
 		},
+		"csi-listed-volume-attached": {
+			DisplayName:        common.String("csi-listed-volume-attached"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-listed-volume-attached-attachment"),
+			InstanceId:         common.String("sample-provider-id"),
+			IsShareable:        common.Bool(false),
+		},
+		"csi-untagged-volume": {
+			DisplayName:        common.String("csi-untagged-volume"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-untagged-volume-attachment"),
+			InstanceId:         common.String("sample-provider-id"),
+			IsShareable:        common.Bool(false),
+		},
+		"csi-listed-volume-detaching": {
+			DisplayName:        common.String("csi-listed-volume-detaching"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateDetaching,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-listed-volume-detaching-attachment"),
+			InstanceId:         common.String("sample-provider-id"),
+			IsShareable:        common.Bool(false),
+		},
+		"csi-get-volume-healthy": {
+			DisplayName:        common.String("csi-get-volume-healthy"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-healthy-attachment"),
+			InstanceId:         common.String("sample-provider-id"),
+			IsShareable:        common.Bool(false),
+		},
+		"csi-get-volume-stuck-attaching": {
+			DisplayName:        common.String("csi-get-volume-stuck-attaching"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttaching,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-stuck-attaching-attachment"),
+			InstanceId:         common.String("sample-provider-id"),
+			IsShareable:        common.Bool(false),
+			TimeCreated:        &common.SDKTime{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		"csi-get-volume-login-failed": {
+			DisplayName:        common.String("csi-get-volume-login-failed"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-login-failed-attachment"),
+			InstanceId:         common.String("sample-provider-id"),
+			IscsiLoginState:    core.VolumeAttachmentIscsiLoginStateLoginFailed,
+			IsMultipath:        common.Bool(true),
+			IsShareable:        common.Bool(false),
+		},
+		"csi-get-volume-foreign-instance": {
+			DisplayName:        common.String("csi-get-volume-foreign-instance"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-get-volume-foreign-instance-attachment"),
+			InstanceId:         common.String("foreign-instance-id"),
+			IsShareable:        common.Bool(false),
+		},
 		"shareable-volume-with-nonshareable-attachments": {
 			DisplayName:        common.String("shareable-volume-with-nonshareable-attachments"),
 			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
@@ -184,6 +368,52 @@ var (
 		},
 	}
 
+	compartmentVolumes = []core.Volume{
+		{
+			DisplayName:        common.String("csi-listed-volume-detaching"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-listed-volume-detaching"),
+			FreeformTags:       map[string]string{clusterUIDTag: "sample-cluster-uid"},
+			TimeCreated:        &common.SDKTime{Time: time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			DisplayName:        common.String("csi-foreign-cluster-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-foreign-cluster-volume"),
+			FreeformTags:       map[string]string{clusterUIDTag: "foreign-cluster-uid"},
+			TimeCreated:        &common.SDKTime{Time: time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			DisplayName:        common.String("csi-untagged-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-untagged-volume"),
+			TimeCreated:        &common.SDKTime{Time: time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC)},
+		},
+		{
+			DisplayName:        common.String("unmanaged-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("unmanaged-volume"),
+			TimeCreated:        &common.SDKTime{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			DisplayName:        common.String("csi-listed-volume-attached"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("csi-listed-volume-attached"),
+			FreeformTags:       map[string]string{clusterUIDTag: "sample-cluster-uid"},
+			TimeCreated:        &common.SDKTime{Time: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
 	subnets = map[string]*core.Subnet{
 		"ocid1.ipv4-subnet": &core.Subnet{
 			CidrBlock: pointer.String("10.0.0.1/24"),
@@ -229,12 +459,43 @@ func (MockOCIClient) Identity(ociClientConfig *client.OCIClientConfig) client.Id
 
 type MockBlockStorageClient struct {
 	bs util.MockOCIBlockStorageClient
+	// region is set for the clients of other regions.
+	region string
 }
 
 func (c *MockBlockStorageClient) GetBootVolume(ctx context.Context, id string) (*core.BootVolume, error) {
 	return nil, nil
 }
 
+func (c *MockBlockStorageClient) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	replica := &core.BlockVolumeReplica{
+		Id:                 &id,
+		AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+		SizeInGBs:          common.Int64(50),
+	}
+	switch id {
+	case "ocid1.blockvolumereplica.oc1.phx.available":
+		replica.LifecycleState = core.BlockVolumeReplicaLifecycleStateAvailable
+	case "ocid1.blockvolumereplica.oc1.phx.provisioning":
+		replica.LifecycleState = core.BlockVolumeReplicaLifecycleStateProvisioning
+	default:
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "block volume replica not found"}
+	}
+	return replica, nil
+}
+
+// replicaRemovalsAwaited are the volumes whose replica removal was awaited.
+var replicaRemovalsAwaited []string
+
+func (c *MockBlockStorageClient) AwaitVolumeReplicasRemovedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
+	if id == "replicated-volume-replicas-stuck" {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	replicaRemovalsAwaited = append(replicaRemovalsAwaited, id)
+	return &core.Volume{Id: &id, LifecycleState: core.VolumeLifecycleStateAvailable}, nil
+}
+
 // AwaitVolumeCloneAvailableOrTimeout implements client.BlockStorageInterface.
 func (c *MockBlockStorageClient) AwaitVolumeHydratedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
 	var volClone *core.Volume
@@ -278,19 +539,182 @@ func (c *MockBlockStorageClient) CreateVolumeBackup(ctx context.Context, details
 }
 
 func (c *MockBlockStorageClient) DeleteVolumeBackup(ctx context.Context, id string) error {
+	if c.region == "" {
+		return nil
+	}
+	if id == "backup-copy-delete-error" {
+		return errors.New("internal server error")
+	}
+	deletedBackupCopies = append(deletedBackupCopies, c.region+"/"+id)
+	return nil
+}
+
+var (
+	// backupsWithCopy are volume backups copied to another region.
+	backupsWithCopy = map[string]*core.VolumeBackup{
+		"backup-with-copy": {
+			Id:             common.String("backup-with-copy"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			FreeformTags:   map[string]string{backupCopyIdTag: "backup-copy", backupCopyRegionTag: "us-ashburn-1"},
+		},
+		"backup-with-copy-delete-error": {
+			Id:             common.String("backup-with-copy-delete-error"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			FreeformTags:   map[string]string{backupCopyIdTag: "backup-copy-delete-error", backupCopyRegionTag: "us-ashburn-1"},
+		},
+	}
+
+	// copiedVolumeBackups records the volume backups copied through the mock.
+	copiedVolumeBackups []string
+	// updatedVolumeBackupTags records the freeform tags of the volume backups updated through the mock.
+	updatedVolumeBackupTags map[string]map[string]string
+	// deletedBackupCopies records the volume backups deleted in other regions through the mock.
+	deletedBackupCopies []string
+)
+
+func (c *MockBlockStorageClient) UpdateVolumeBackup(ctx context.Context, id string, details core.UpdateVolumeBackupDetails) (*core.VolumeBackup, error) {
+	if updatedVolumeBackupTags == nil {
+		updatedVolumeBackupTags = map[string]map[string]string{}
+	}
+	updatedVolumeBackupTags[id] = details.FreeformTags
+	return &core.VolumeBackup{Id: &id, FreeformTags: details.FreeformTags}, nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	if id == "backup-copy-error" {
+		return nil, errors.New("internal server error")
+	}
+	copiedVolumeBackups = append(copiedVolumeBackups, destinationRegion+"/"+id)
+	return &core.VolumeBackup{Id: common.String("copy-of-" + id), DisplayName: &displayName}, nil
+}
+
+func (c *MockBlockStorageClient) InRegion(region string) (client.BlockStorageInterface, error) {
+	return &MockBlockStorageClient{region: region}, nil
+}
+
+var (
+	// backupPolicyAssignments are the backup policy assignments of volumes.
+	backupPolicyAssignments = map[string][]core.VolumeBackupPolicyAssignment{
+		"volume-with-backup-policy": {{
+			Id:       common.String("ocid1.volumebackuppolicyassignment.oc1.phx.xxxx"),
+			AssetId:  common.String("volume-with-backup-policy"),
+			PolicyId: common.String("ocid1.volumebackuppolicy.oc1.phx.silver"),
+		}},
+	}
+
+	// createdBackupPolicyAssignments records the volume/policy pairs assigned through the mock.
+	createdBackupPolicyAssignments []string
+	// deletedBackupPolicyAssignments records the backup policy assignments deleted through the mock.
+	deletedBackupPolicyAssignments []string
+)
+
+func (c *MockBlockStorageClient) ListVolumeBackupPolicies(ctx context.Context, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	var policies []core.VolumeBackupPolicy
+	for _, name := range []string{"gold", "silver", "bronze"} {
+		policies = append(policies, core.VolumeBackupPolicy{
+			Id:          common.String("ocid1.volumebackuppolicy.oc1.phx." + name),
+			DisplayName: common.String(name),
+		})
+	}
+	return policies, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssetAssignment(ctx context.Context, assetID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	if assetID == "backup-policy-assignment-error" {
+		return nil, errors.New("internal server error")
+	}
+	return backupPolicyAssignments[assetID], nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, assetID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	createdBackupPolicyAssignments = append(createdBackupPolicyAssignments, assetID+"/"+policyID)
+	return &core.VolumeBackupPolicyAssignment{
+		Id:       common.String("ocid1.volumebackuppolicyassignment.oc1.phx." + assetID),
+		AssetId:  &assetID,
+		PolicyId: &policyID,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	deletedBackupPolicyAssignments = append(deletedBackupPolicyAssignments, id)
 	return nil
 }
 
+// volumeBackups are the volume backups listed by MockBlockStorageClient.
+var volumeBackups = map[string]*core.VolumeBackup{
+	"backup-available": {
+		Id:             common.String("backup-available"),
+		VolumeId:       common.String("backup-source-1"),
+		LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+		SizeInMBs:      common.Int64(51200),
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000100, 0)},
+	},
+	"backup-creating": {
+		Id:             common.String("backup-creating"),
+		VolumeId:       common.String("backup-source-1"),
+		LifecycleState: core.VolumeBackupLifecycleStateCreating,
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000200, 0)},
+	},
+	"backup-faulty": {
+		Id:             common.String("backup-faulty"),
+		VolumeId:       common.String("backup-source-2"),
+		LifecycleState: core.VolumeBackupLifecycleStateFaulty,
+		SizeInGBs:      common.Int64(50),
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+	},
+	"backup-terminated": {
+		Id:             common.String("backup-terminated"),
+		VolumeId:       common.String("backup-source-2"),
+		LifecycleState: core.VolumeBackupLifecycleStateTerminated,
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000300, 0)},
+	},
+}
+
 func (c *MockBlockStorageClient) GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error) {
+	if backup, ok := groupVolumeBackups[id]; ok {
+		return backup, nil
+	}
+	if backup, ok := volumeBackups[id]; ok {
+		return backup, nil
+	}
+	if backup, ok := backupsWithCopy[id]; ok {
+		return backup, nil
+	}
+	if id == "backup-not-found" {
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "volume backup not found"}
+	}
+	if id == "backup-get-error" {
+		return nil, errors.New("internal server error")
+	}
 	return &core.VolumeBackup{
 		Id: &id,
 	}, nil
 }
 
 func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
+	if c.region != "" && snapshotName == "snapshot-copied" {
+		return []core.VolumeBackup{{Id: common.String("existing-copy"), DisplayName: &snapshotName}}, nil
+	}
 	return []core.VolumeBackup{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeBackup, error) {
+	if volumeID == "backup-list-error" {
+		return nil, errors.New("internal server error")
+	}
+	backups := []core.VolumeBackup{}
+	for _, backup := range volumeBackups {
+		if volumeID != "" && *backup.VolumeId != volumeID {
+			continue
+		}
+		if backup.LifecycleState == core.VolumeBackupLifecycleStateTerminated {
+			continue
+		}
+		backups = append(backups, *backup)
+	}
+	return backups, nil
+}
+
 type MockProvisionerClient struct {
 	Storage *MockBlockStorageClient
 }
@@ -299,6 +723,10 @@ func (p *MockProvisionerClient) Lustre() client.LustreInterface {
 	return nil
 }
 
+func (p *MockProvisionerClient) Limits() client.LimitsInterface {
+	return &MockLimitsClient{}
+}
+
 func (c *MockBlockStorageClient) AwaitVolumeAvailableORTimeout(ctx context.Context, id string) (*core.Volume, error) {
 	volume := volumes[id]
 	if volume == nil {
@@ -318,6 +746,8 @@ func (c *MockBlockStorageClient) AwaitVolumeAvailableORTimeout(ctx context.Conte
 func (c *MockBlockStorageClient) GetVolume(ctx context.Context, id string) (*core.Volume, error) {
 	if id == "invalid_volume_id" {
 		return nil, fmt.Errorf("failed to find existence of volume")
+	} else if id == "csi-get-volume-not-found" {
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "volume not found"}
 	} else if id == "valid_volume_id" {
 		ad := "zkJl:US-ASHBURN-AD-1"
 		var oldSizeInBytes = int64(csi_util.MaximumVolumeSizeInBytes)
@@ -388,8 +818,30 @@ func (c *MockBlockStorageClient) GetVolumesByName(ctx context.Context, volumeNam
 	return []core.Volume{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumes(ctx context.Context, compartmentID string) ([]core.Volume, error) {
+	if compartmentID == "list-volumes-error-compartment" {
+		return nil, errors.New("list volumes failed")
+	}
+	return compartmentVolumes, nil
+}
+
 // CreateVolume mocks the BlockStorage CreateVolume implementation
 func (c *MockBlockStorageClient) CreateVolume(ctx context.Context, details core.CreateVolumeDetails) (*core.Volume, error) {
+	switch *details.DisplayName {
+	case "volume-from-replica":
+		if _, ok := details.SourceDetails.(*core.VolumeSourceFromBlockVolumeReplicaDetails); !ok {
+			return nil, fmt.Errorf("volume is not created from a block volume replica")
+		}
+	case "autotuned-volume":
+		if detached, maxVpusPerGB := getAutotuneSettings(details.AutotunePolicies); !detached || maxVpusPerGB != 30 {
+			return nil, fmt.Errorf("volume is not created with detached and performance based auto-tune up to 30")
+		}
+	case "replicated-volume":
+		if len(details.BlockVolumeReplicas) != 1 || *details.BlockVolumeReplicas[0].AvailabilityDomain != "AD1" ||
+			*details.BlockVolumeReplicas[0].DisplayName != "replicated-volume-replica" {
+			return nil, fmt.Errorf("volume is not created with a replica in AD1")
+		}
+	}
 	volume := volumes[*details.DisplayName]
 	if volume != nil {
 		return volume, nil
@@ -404,7 +856,7 @@ func (c *MockBlockStorageClient) CreateVolume(ctx context.Context, details core.
 }
 
 func (c *MockBlockStorageClient) UpdateVolume(ctx context.Context, volumeId string, details core.UpdateVolumeDetails) (*core.Volume, error) {
-	if volumeId == "valid_volume_id_valid_old_size_fail" {
+	if volumeId == "valid_volume_id_valid_old_size_fail" || volumeId == "replicated-volume-update-fail" {
 		return nil, fmt.Errorf("Update volume failed")
 	} else {
 		ad := "zkJl:US-ASHBURN-AD-1"
@@ -478,6 +930,9 @@ func (c *MockVirtualNetworkClient) GetPrivateIp(ctx context.Context, id string)
 	if id == "private-ip-fetch-error" {
 		return nil, errors.New("private IP fetch failed")
 	}
+	if id == "private-ip-phx-ad-2" {
+		return &core.PrivateIp{IpAddress: common.String("10.0.30.1")}, nil
+	}
 	privateIpAddress := "10.0.20.1"
 	return &core.PrivateIp{
 		IpAddress: &privateIpAddress,
@@ -535,6 +990,14 @@ func (c *MockVirtualNetworkClient) UpdateSecurityList(ctx context.Context, id st
 	return core.UpdateSecurityListResponse{}, nil
 }
 
+func (c *MockVirtualNetworkClient) GetRouteTable(ctx context.Context, id string) (*core.RouteTable, *string, error) {
+	return nil, nil, nil
+}
+
+func (c *MockVirtualNetworkClient) UpdateRouteTable(ctx context.Context, id, etag string, routeRules []core.RouteRule) (*core.RouteTable, error) {
+	return nil, nil
+}
+
 func (c *MockVirtualNetworkClient) IsRegionalSubnet(ctx context.Context, id string) (bool, error) {
 	return true, nil
 }
@@ -578,6 +1041,10 @@ func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, lbID str
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -614,6 +1081,42 @@ func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string,
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteHostname(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) UpdateLoadBalancerShape(context.Context, string, *client.GenericUpdateLoadBalancerShapeDetails) (string, error) {
 	return "", nil
 }
@@ -758,6 +1261,14 @@ func (c *MockComputeClient) ListVolumeAttachments(ctx context.Context, compartme
 			}
 		}
 	}
+	if volumeID == "" {
+		for id, attachment := range volume_attachments {
+			listed := *attachment
+			listed.VolumeId = common.String(id)
+			attachments = append(attachments, &listed)
+		}
+		return attachments, nil
+	}
 	if volume_attachments[volumeID] != nil {
 		attachments = append(attachments, volume_attachments[volumeID])
 	}
@@ -868,6 +1379,24 @@ func (mockClient MockIdentityClient) ListAvailabilityDomains(ctx context.Context
 	return []identity.AvailabilityDomain{*ad}, nil
 }
 
+// availableStorageInGBs is the block volume storage reported as available by
+// MockLimitsClient, by availability domain.
+var availableStorageInGBs = map[string]int64{
+	"AD1":           1024,
+	"NWuj:PHX-AD-2": 64 * 1024,
+	"NWuj:PHX-AD-3": 0,
+}
+
+type MockLimitsClient struct{}
+
+func (m *MockLimitsClient) GetResourceAvailability(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (*limits.ResourceAvailability, error) {
+	available, ok := availableStorageInGBs[availabilityDomain]
+	if !ok {
+		return nil, errors.New("limit not found")
+	}
+	return &limits.ResourceAvailability{Available: &available}, nil
+}
+
 // Identity mocks client Identity implementation
 func (p *MockProvisionerClient) Identity(ociClientConfig *client.OCIClientConfig) client.IdentityInterface {
 	return &MockIdentityClient{}
@@ -1244,32 +1773,215 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 			wantErr: errors.New("failed to check existence of volume context deadline exceeded"),
 		},
 		{
-			name:   "Create Volume times out waiting for cloned volume to become available",
-			fields: fields{},
+			name: "Create volume from an activated block volume replica",
+			fields: fields{
+				KubeClient: fake.NewSimpleClientset(replicaSourcePVC("ocid1.blockvolumereplica.oc1.phx.available"), clusterNamespace()),
+			},
 			args: args{
-				req: create_volume_requests["clone-volume-stuck-in-provisioning-state"],
+				req: &csi.CreateVolumeRequest{
+					Name:               "volume-from-replica",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{pvcNameKey: "replica-pvc", pvcNamespaceKey: "default"},
+					CapacityRange:      &csi.CapacityRange{RequiredBytes: 50 * client.GiB},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "volume-from-replica",
+					CapacityBytes: 50 * client.GiB,
+					AccessibleTopology: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-2"}},
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					},
+					VolumeContext: map[string]string{
+						"needResize":      "false",
+						"newSize":         "",
+						"vpusPerGB":       "10",
+						"attachment-type": "",
+					},
+				},
+			},
+		},
+		{
+			name: "Error for a cluster UID that can not be found",
+			fields: fields{
+				KubeClient: fake.NewSimpleClientset(replicaSourcePVC("ocid1.blockvolumereplica.oc1.phx.available")),
+			},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "volume-from-replica",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{pvcNameKey: "replica-pvc", pvcNamespaceKey: "default"},
+					CapacityRange:      &csi.CapacityRange{RequiredBytes: 50 * client.GiB},
+				},
 			},
 			want:    nil,
-			wantErr: errors.New("Create volume failed with time out timed out waiting for the condition"),
+			wantErr: errors.New("failed to get the cluster UID"),
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			synctest.Test(t, func(t *testing.T) {
-				ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
-				defer cancel()
-				d := &BlockVolumeControllerDriver{ControllerDriver{
-					KubeClient: nil,
-					logger:     zap.S(),
-					config:     &providercfg.Config{CompartmentID: ""},
-					client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
-					util:       &csi_util.Util{Logger: logging.Logger().Sugar()},
-				}}
-				got, err := d.CreateVolume(ctx, tt.args.req)
-				if tt.wantErr == nil && err != nil {
-					t.Errorf("got error %q, want none", err)
-				}
-				if tt.wantErr != nil && err == nil {
+		{
+			name: "Error for a block volume replica that is not available",
+			fields: fields{
+				KubeClient: fake.NewSimpleClientset(replicaSourcePVC("ocid1.blockvolumereplica.oc1.phx.provisioning"), clusterNamespace()),
+			},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "volume-from-replica",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{pvcNameKey: "replica-pvc", pvcNamespaceKey: "default"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("block volume replica ocid1.blockvolumereplica.oc1.phx.provisioning is in PROVISIONING state"),
+		},
+		{
+			name: "Error for a volume source annotation that is not a block volume replica",
+			fields: fields{
+				KubeClient: fake.NewSimpleClientset(replicaSourcePVC("ocid1.volumebackup.oc1.phx.xxxx"), clusterNamespace()),
+			},
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "volume-from-replica",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{pvcNameKey: "replica-pvc", pvcNamespaceKey: "default"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("must be the OCID of a block volume replica"),
+		},
+		{
+			name: "Create volume with a replica in another availability domain",
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "replicated-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{replicaAvailabilityDomain: "PHX-AD-3"},
+					CapacityRange:      &csi.CapacityRange{RequiredBytes: 50 * client.GiB},
+					AccessibilityRequirements: &csi.TopologyRequirement{Requisite: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					}},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "replicated-volume",
+					CapacityBytes: 50 * client.GiB,
+					AccessibleTopology: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-2"}},
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					},
+					VolumeContext: map[string]string{
+						"needResize":      "false",
+						"newSize":         "",
+						"vpusPerGB":       "10",
+						"attachment-type": "",
+					},
+				},
+			},
+		},
+		{
+			name: "Create volume with an Oracle defined backup policy",
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "backup-policy-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{backupPolicy: "Gold"},
+					CapacityRange:      &csi.CapacityRange{RequiredBytes: 50 * client.GiB},
+					AccessibilityRequirements: &csi.TopologyRequirement{Requisite: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					}},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "backup-policy-volume",
+					CapacityBytes: 50 * client.GiB,
+					AccessibleTopology: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-2"}},
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					},
+					VolumeContext: map[string]string{
+						"needResize":      "false",
+						"newSize":         "",
+						"vpusPerGB":       "10",
+						"attachment-type": "",
+						"backupPolicyId":  "ocid1.volumebackuppolicy.oc1.phx.gold",
+					},
+				},
+			},
+		},
+		{
+			name: "Create volume with auto-tune policies",
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "autotuned-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{csi_util.VpusPerGB: "20", csi_util.DetachedVolumeAutotune: "true", csi_util.AutotuneMaxVpusPerGB: "30"},
+					CapacityRange:      &csi.CapacityRange{RequiredBytes: 50 * client.GiB},
+					AccessibilityRequirements: &csi.TopologyRequirement{Requisite: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					}},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "autotuned-volume",
+					CapacityBytes: 50 * client.GiB,
+					AccessibleTopology: []*csi.Topology{
+						{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-2"}},
+						{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+					},
+					VolumeContext: map[string]string{
+						"needResize":      "false",
+						"newSize":         "",
+						"vpusPerGB":       "20",
+						"attachment-type": "",
+					},
+				},
+			},
+		},
+		{
+			name: "Error for an invalid backup policy",
+			args: args{
+				req: &csi.CreateVolumeRequest{
+					Name:               "backup-policy-volume",
+					VolumeCapabilities: []*csi.VolumeCapability{{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}}},
+					Parameters:         map[string]string{backupPolicy: "platinum"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("invalid backup-policy: platinum"),
+		},
+		{
+			name:   "Create Volume times out waiting for cloned volume to become available",
+			fields: fields{},
+			args: args{
+				req: create_volume_requests["clone-volume-stuck-in-provisioning-state"],
+			},
+			want:    nil,
+			wantErr: errors.New("Create volume failed with time out timed out waiting for the condition"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+				defer cancel()
+				kubeClient := tt.fields.KubeClient
+				if kubeClient == nil {
+					kubeClient = fake.NewSimpleClientset(clusterNamespace())
+				}
+				d := &BlockVolumeControllerDriver{ControllerDriver{
+					KubeClient: kubeClient,
+					logger:     zap.S(),
+					config:     &providercfg.Config{CompartmentID: ""},
+					client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+					util:       &csi_util.Util{Logger: logging.Logger().Sugar()},
+				}}
+				got, err := d.CreateVolume(ctx, tt.args.req)
+				if tt.wantErr == nil && err != nil {
+					t.Errorf("got error %q, want none", err)
+				}
+				if tt.wantErr != nil && err == nil {
 					t.Errorf("want error %q, got none", tt.wantErr)
 				} else if tt.wantErr != nil && !strings.Contains(err.Error(), tt.wantErr.Error()) {
 					t.Errorf("want error %q to include %q", err, tt.wantErr)
@@ -1282,6 +1994,24 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 	}
 }
 
+// clusterNamespace returns the kube-system namespace whose UID identifies the
+// cluster.
+func clusterNamespace() *kubeAPI.Namespace {
+	return &kubeAPI.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceSystem, UID: "sample-cluster-uid"},
+	}
+}
+
+func replicaSourcePVC(source string) *kubeAPI.PersistentVolumeClaim {
+	return &kubeAPI.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "replica-pvc",
+			Namespace:   "default",
+			Annotations: map[string]string{volumeSourceAnnotation: source},
+		},
+	}
+}
+
 func TestControllerDriver_DeleteVolume(t *testing.T) {
 	type fields struct {
 		KubeClient kubernetes.Interface
@@ -1294,12 +2024,15 @@ func TestControllerDriver_DeleteVolume(t *testing.T) {
 		ctx context.Context
 		req *csi.DeleteVolumeRequest
 	}
+	expiredCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    *csi.DeleteVolumeResponse
-		wantErr error
+		name                   string
+		fields                 fields
+		args                   args
+		want                   *csi.DeleteVolumeResponse
+		wantReplicasRemovedFor []string
+		wantErr                error
 	}{
 		{
 			name:   "Error for volume OCID missing in delete block volume",
@@ -1321,9 +2054,61 @@ func TestControllerDriver_DeleteVolume(t *testing.T) {
 			want:    &csi.DeleteVolumeResponse{},
 			wantErr: nil,
 		},
+		{
+			name:   "Disable replication and delete a replicated volume",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "replicated-volume"},
+			},
+			want:                   &csi.DeleteVolumeResponse{},
+			wantReplicasRemovedFor: []string{"replicated-volume"},
+			wantErr:                nil,
+		},
+		{
+			name:   "Error for replicas of a volume that are not removed in time",
+			fields: fields{},
+			args: args{
+				ctx: expiredCtx,
+				req: &csi.DeleteVolumeRequest{VolumeId: "replicated-volume-replicas-stuck"},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to disable replication of volume"),
+		},
+		{
+			name:   "Remove backup policy assignment and delete volume",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "volume-with-backup-policy"},
+			},
+			want:    &csi.DeleteVolumeResponse{},
+			wantErr: nil,
+		},
+		{
+			name:   "Error for backup policy assignment of a volume that cannot be read",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "backup-policy-assignment-error"},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to remove backup policy assignment of volume"),
+		},
+		{
+			name:   "Error for replication of a volume that cannot be disabled",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.DeleteVolumeRequest{VolumeId: "replicated-volume-update-fail"},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to disable replication of volume"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			replicaRemovalsAwaited = nil
 			d := &BlockVolumeControllerDriver{ControllerDriver{
 				KubeClient: nil,
 				logger:     zap.S(),
@@ -1341,6 +2126,9 @@ func TestControllerDriver_DeleteVolume(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ControllerDriver.DeleteVolume() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(replicaRemovalsAwaited, tt.wantReplicasRemovedFor) {
+				t.Errorf("ControllerDriver.DeleteVolume() awaited the removal of the replicas of %v, want %v", replicaRemovalsAwaited, tt.wantReplicasRemovedFor)
+			}
 		})
 	}
 }
@@ -1424,6 +2212,21 @@ func TestControllerDriver_ControllerPublishVolume(t *testing.T) {
 			want:    nil,
 			wantErr: errors.New("Failed to attach volume shareable-volume-with-nonshareable-attachments to node sample-provider-id. The volume already has a non-shareable attachment shareable-volume-with-nonshareable-attachments to instance sample-provider-id-2."),
 		},
+		{
+			name: "Error for multi node multi writer access to a filesystem volume",
+			args: args{
+				req: &csi.ControllerPublishVolumeRequest{
+					VolumeId: "shareable-volume-with-nonshareable-attachments",
+					NodeId:   "sample-provider-id",
+					VolumeCapability: &csi.VolumeCapability{
+						AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}},
+						AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("MULTI_NODE_MULTI_WRITER access mode is only supported for raw block volumes"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1622,51 +2425,556 @@ func TestControllerDriver_ControllerExpandVolume(t *testing.T) {
 					},
 				},
 			},
-			want: &csi.ControllerExpandVolumeResponse{
-				CapacityBytes:         int64(csi_util.MaximumVolumeSizeInBytes),
-				NodeExpansionRequired: true,
+			want: &csi.ControllerExpandVolumeResponse{
+				CapacityBytes:         int64(csi_util.MaximumVolumeSizeInBytes),
+				NodeExpansionRequired: true,
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Uhp volume expand success in ControllerExpandVolume",
+			fields: fields{},
+			args: args{
+				ctx: nil,
+				req: &csi.ControllerExpandVolumeRequest{
+					VolumeId: "uhp_volume_id",
+					CapacityRange: &csi.CapacityRange{
+						RequiredBytes: int64(csi_util.MaximumVolumeSizeInBytes),
+					},
+				},
+			},
+			want: &csi.ControllerExpandVolumeResponse{
+				CapacityBytes:         int64(csi_util.MaximumVolumeSizeInBytes),
+				NodeExpansionRequired: true,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: nil,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:       &csi_util.Util{},
+			}}
+			got, err := d.ControllerExpandVolume(tt.args.ctx, tt.args.req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("got error %q, want none", err)
+			}
+			if tt.wantErr != nil && err == nil {
+				t.Errorf("want error %q, got none", tt.wantErr)
+			} else if tt.wantErr != nil && !strings.Contains(err.Error(), tt.wantErr.Error()) {
+				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerDriver.ControllerExpandVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ListVolumes(t *testing.T) {
+	tests := []struct {
+		name              string
+		compartmentID     string
+		req               *csi.ListVolumesRequest
+		wantVolumeIDs     []string
+		wantPublishedNode map[string][]string
+		wantNextToken     string
+		noNamespace       bool
+		wantErr           codes.Code
+	}{
+		{
+			name:          "lists the csi volumes of the cluster and the untagged ones with the nodes they are published to",
+			req:           &csi.ListVolumesRequest{},
+			wantVolumeIDs: []string{"csi-listed-volume-attached", "csi-untagged-volume", "csi-listed-volume-detaching"},
+			wantPublishedNode: map[string][]string{
+				"csi-listed-volume-attached": {"sample-node"},
+				"csi-untagged-volume":        {"sample-node"},
+			},
+		},
+		{
+			name:          "first page",
+			req:           &csi.ListVolumesRequest{MaxEntries: 1},
+			wantVolumeIDs: []string{"csi-listed-volume-attached"},
+			wantPublishedNode: map[string][]string{
+				"csi-listed-volume-attached": {"sample-node"},
+			},
+			wantNextToken: "1",
+		},
+		{
+			name:          "last page",
+			req:           &csi.ListVolumesRequest{MaxEntries: 2, StartingToken: "2"},
+			wantVolumeIDs: []string{"csi-listed-volume-detaching"},
+		},
+		{
+			name:          "starting token at the end of the list",
+			req:           &csi.ListVolumesRequest{StartingToken: "3"},
+			wantVolumeIDs: []string{},
+		},
+		{
+			name:        "cluster UID not found",
+			req:         &csi.ListVolumesRequest{},
+			noNamespace: true,
+			wantErr:     codes.Internal,
+		},
+		{
+			name:    "invalid starting token",
+			req:     &csi.ListVolumesRequest{StartingToken: "abc"},
+			wantErr: codes.Aborted,
+		},
+		{
+			name:    "starting token beyond the end of the list",
+			req:     &csi.ListVolumesRequest{StartingToken: "4"},
+			wantErr: codes.Aborted,
+		},
+		{
+			name:    "negative max entries",
+			req:     &csi.ListVolumesRequest{MaxEntries: -1},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name:          "list volumes failure",
+			compartmentID: "list-volumes-error-compartment",
+			req:           &csi.ListVolumesRequest{},
+			wantErr:       codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []runtime.Object{&kubeAPI.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "sample-node",
+					Annotations: map[string]string{util.CompartmentIDAnnotation: "sample-compartment"},
+				},
+				Spec: kubeAPI.NodeSpec{ProviderID: "oci://sample-provider-id"},
+			}}
+			if !tt.noNamespace {
+				objects = append(objects, clusterNamespace())
+			}
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: fake.NewSimpleClientset(objects...),
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: tt.compartmentID},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:       &csi_util.Util{Logger: zap.S()},
+			}}
+			got, err := d.ListVolumes(context.Background(), tt.req)
+			if tt.wantErr != codes.OK {
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("ListVolumes() error = %v, want code %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListVolumes() unexpected error: %v", err)
+			}
+
+			gotVolumeIDs := []string{}
+			gotPublishedNodes := map[string][]string{}
+			for _, entry := range got.Entries {
+				gotVolumeIDs = append(gotVolumeIDs, entry.Volume.VolumeId)
+				if nodes := entry.Status.PublishedNodeIds; len(nodes) > 0 {
+					gotPublishedNodes[entry.Volume.VolumeId] = nodes
+				}
+				if entry.Volume.CapacityBytes != 50*client.GiB {
+					t.Errorf("volume %s capacity = %d, want %d", entry.Volume.VolumeId, entry.Volume.CapacityBytes, 50*client.GiB)
+				}
+			}
+			if !reflect.DeepEqual(gotVolumeIDs, tt.wantVolumeIDs) {
+				t.Errorf("ListVolumes() volumes = %v, want %v", gotVolumeIDs, tt.wantVolumeIDs)
+			}
+			if tt.wantPublishedNode == nil {
+				tt.wantPublishedNode = map[string][]string{}
+			}
+			if !reflect.DeepEqual(gotPublishedNodes, tt.wantPublishedNode) {
+				t.Errorf("ListVolumes() published nodes = %v, want %v", gotPublishedNodes, tt.wantPublishedNode)
+			}
+			if got.NextToken != tt.wantNextToken {
+				t.Errorf("ListVolumes() next token = %q, want %q", got.NextToken, tt.wantNextToken)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ControllerGetVolume(t *testing.T) {
+	tests := []struct {
+		name              string
+		volumeID          string
+		wantPublishedNode []string
+		wantAbnormal      bool
+		wantCondition     string
+		wantErr           codes.Code
+	}{
+		{
+			name:    "missing volume id",
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name:     "volume not found",
+			volumeID: "csi-get-volume-not-found",
+			wantErr:  codes.NotFound,
+		},
+		{
+			name:     "get volume failure",
+			volumeID: "invalid_volume_id",
+			wantErr:  codes.Internal,
+		},
+		{
+			name:              "healthy volume published to a node",
+			volumeID:          "csi-get-volume-healthy",
+			wantPublishedNode: []string{"sample-node"},
+			wantCondition:     "volume is healthy",
+		},
+		{
+			name:          "faulty volume",
+			volumeID:      "csi-get-volume-faulty",
+			wantAbnormal:  true,
+			wantCondition: "volume is in FAULTY state",
+		},
+		{
+			name:          "attachment stuck attaching",
+			volumeID:      "csi-get-volume-stuck-attaching",
+			wantAbnormal:  true,
+			wantCondition: "has been attaching since",
+		},
+		{
+			name:              "iscsi login failed",
+			volumeID:          "csi-get-volume-login-failed",
+			wantPublishedNode: []string{"sample-node"},
+			wantAbnormal:      true,
+			wantCondition:     "iSCSI login of volume attachment csi-get-volume-login-failed-attachment",
+		},
+		{
+			name:          "attached to an instance outside the cluster",
+			volumeID:      "csi-get-volume-foreign-instance",
+			wantAbnormal:  true,
+			wantCondition: "attached to instance foreign-instance-id which is not a node of the cluster",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: fake.NewSimpleClientset(&kubeAPI.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "sample-node",
+						Annotations: map[string]string{util.CompartmentIDAnnotation: "sample-compartment"},
+					},
+					Spec: kubeAPI.NodeSpec{ProviderID: "oci://sample-provider-id"},
+				}),
+				logger: zap.S(),
+				config: &providercfg.Config{},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:   &csi_util.Util{Logger: zap.S()},
+			}}
+			got, err := d.ControllerGetVolume(context.Background(), &csi.ControllerGetVolumeRequest{VolumeId: tt.volumeID})
+			if tt.wantErr != codes.OK {
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("ControllerGetVolume() error = %v, want code %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ControllerGetVolume() unexpected error: %v", err)
+			}
+			if got.Volume.VolumeId != tt.volumeID || got.Volume.CapacityBytes != 50*client.GiB {
+				t.Errorf("ControllerGetVolume() volume = %v, want %s with %d bytes", got.Volume, tt.volumeID, 50*client.GiB)
+			}
+			if !reflect.DeepEqual(got.Status.PublishedNodeIds, tt.wantPublishedNode) {
+				t.Errorf("ControllerGetVolume() published nodes = %v, want %v", got.Status.PublishedNodeIds, tt.wantPublishedNode)
+			}
+			if got.Status.VolumeCondition.GetAbnormal() != tt.wantAbnormal {
+				t.Errorf("ControllerGetVolume() abnormal = %v, want %v", got.Status.VolumeCondition, tt.wantAbnormal)
+			}
+			if !strings.Contains(got.Status.VolumeCondition.GetMessage(), tt.wantCondition) {
+				t.Errorf("ControllerGetVolume() condition = %q, want it to contain %q", got.Status.VolumeCondition.GetMessage(), tt.wantCondition)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_GetCapacity(t *testing.T) {
+	tests := []struct {
+		name                  string
+		segments              map[string]string
+		wantAvailableCapacity int64
+		wantMaximumVolumeSize int64
+		wantErr               codes.Code
+	}{
+		{
+			name:                  "topology zone",
+			segments:              map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-1"},
+			wantAvailableCapacity: 1024 * client.GiB,
+			wantMaximumVolumeSize: 1024 * client.GiB,
+		},
+		{
+			name:                  "failure domain zone",
+			segments:              map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-1"},
+			wantAvailableCapacity: 1024 * client.GiB,
+			wantMaximumVolumeSize: 1024 * client.GiB,
+		},
+		{
+			name:                  "maximum volume size is capped at the largest block volume",
+			segments:              map[string]string{csi_util.AvailabilityDomainLabel: "NWuj.PHX-AD-2"},
+			wantAvailableCapacity: 64 * client.TiB,
+			wantMaximumVolumeSize: csi_util.MaximumVolumeSizeInBytes,
+		},
+		{
+			name:     "no storage available",
+			segments: map[string]string{csi_util.AvailabilityDomainLabel: "NWuj.PHX-AD-3"},
+		},
+		{
+			name:                  "no topology",
+			wantAvailableCapacity: 1024 * client.GiB,
+			wantMaximumVolumeSize: 1024 * client.GiB,
+		},
+		{
+			name:     "topology without availability domain",
+			segments: map[string]string{"topology.kubernetes.io/region": "phx"},
+			wantErr:  codes.InvalidArgument,
+		},
+		{
+			name:     "limit lookup failure",
+			segments: map[string]string{csi_util.AvailabilityDomainLabel: "NWuj.PHX-AD-4"},
+			wantErr:  codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "sample-compartment"},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			req := &csi.GetCapacityRequest{}
+			if tt.segments != nil {
+				req.AccessibleTopology = &csi.Topology{Segments: tt.segments}
+			}
+			got, err := d.GetCapacity(context.Background(), req)
+			if tt.wantErr != codes.OK {
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("GetCapacity() error = %v, want code %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCapacity() unexpected error: %v", err)
+			}
+			if got.AvailableCapacity != tt.wantAvailableCapacity {
+				t.Errorf("GetCapacity() available capacity = %d, want %d", got.AvailableCapacity, tt.wantAvailableCapacity)
+			}
+			if got.GetMaximumVolumeSize().GetValue() != tt.wantMaximumVolumeSize {
+				t.Errorf("GetCapacity() maximum volume size = %d, want %d", got.GetMaximumVolumeSize().GetValue(), tt.wantMaximumVolumeSize)
+			}
+			if got.GetMinimumVolumeSize().GetValue() != csi_util.MinimumVolumeSizeInBytes {
+				t.Errorf("GetCapacity() minimum volume size = %d, want %d", got.GetMinimumVolumeSize().GetValue(), csi_util.MinimumVolumeSizeInBytes)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ControllerModifyVolume(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *csi.ControllerModifyVolumeRequest
+		wantErr codes.Code
+	}{
+		{
+			name: "modify performance level",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+		},
+		{
+			name: "modify auto-tune policies",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId: "valid_volume_id",
+				MutableParameters: map[string]string{
+					csi_util.DetachedVolumeAutotune: "true",
+					csi_util.AutotuneMaxVpusPerGB:   "30",
+				},
+			},
+		},
+		{
+			name: "no change",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "10"},
+			},
+		},
+		{
+			name: "missing volume id",
+			req: &csi.ControllerModifyVolumeRequest{
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "boot volume",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.bootvolume.oc1.phx.xxxx",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "unsupported parameter",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{kmsKey: "ocid1.key.oc1.xxxx"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "invalid performance level",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "abc"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "invalid auto-tune max performance level",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id",
+				MutableParameters: map[string]string{csi_util.AutotuneMaxVpusPerGB: "5"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "auto-tune max performance level below performance level",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "uhp_volume_id",
+				MutableParameters: map[string]string{csi_util.AutotuneMaxVpusPerGB: "20"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "modify from ultra high performance",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "uhp_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name: "get volume failure",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "invalid_volume_id",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			wantErr: codes.Internal,
+		},
+		{
+			name: "update volume failure",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "valid_volume_id_valid_old_size_fail",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			wantErr: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: ""},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:   &csi_util.Util{},
+			}}
+			_, err := d.ControllerModifyVolume(context.Background(), tt.req)
+			if status.Code(err) != tt.wantErr {
+				t.Errorf("ControllerModifyVolume() error = %v, want code %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetVolumeModifyDetails(t *testing.T) {
+	tests := []struct {
+		name        string
+		volume      *core.Volume
+		params      VolumeModifyParameters
+		want        core.UpdateVolumeDetails
+		wantChanged bool
+	}{
+		{
+			name: "performance level is updated",
+			volume: &core.Volume{
+				DisplayName: common.String("csi-volume"),
+				VpusPerGB:   common.Int64(10),
+			},
+			params: VolumeModifyParameters{vpusPerGB: common.Int64(20)},
+			want: core.UpdateVolumeDetails{
+				DisplayName: common.String("csi-volume"),
+				VpusPerGB:   common.Int64(20),
 			},
-			wantErr: nil,
+			wantChanged: true,
 		},
 		{
-			name:   "Uhp volume expand success in ControllerExpandVolume",
-			fields: fields{},
-			args: args{
-				ctx: nil,
-				req: &csi.ControllerExpandVolumeRequest{
-					VolumeId: "uhp_volume_id",
-					CapacityRange: &csi.CapacityRange{
-						RequiredBytes: int64(csi_util.MaximumVolumeSizeInBytes),
-					},
+			name: "existing auto-tune policies are kept",
+			volume: &core.Volume{
+				DisplayName:      common.String("csi-volume"),
+				VpusPerGB:        common.Int64(10),
+				AutotunePolicies: []core.AutotunePolicy{core.DetachedVolumeAutotunePolicy{}},
+			},
+			params: VolumeModifyParameters{autotuneMaxVpusPerGB: common.Int64(20)},
+			want: core.UpdateVolumeDetails{
+				DisplayName: common.String("csi-volume"),
+				AutotunePolicies: []core.AutotunePolicy{
+					core.DetachedVolumeAutotunePolicy{},
+					core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(20)},
 				},
 			},
-			want: &csi.ControllerExpandVolumeResponse{
-				CapacityBytes:         int64(csi_util.MaximumVolumeSizeInBytes),
-				NodeExpansionRequired: true,
+			wantChanged: true,
+		},
+		{
+			name: "auto-tune is disabled",
+			volume: &core.Volume{
+				DisplayName: common.String("csi-volume"),
+				VpusPerGB:   common.Int64(10),
+				AutotunePolicies: []core.AutotunePolicy{
+					core.DetachedVolumeAutotunePolicy{},
+					core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(20)},
+				},
+			},
+			params: VolumeModifyParameters{
+				detachedVolumeAutotune: common.Bool(false),
+				autotuneMaxVpusPerGB:   common.Int64(0),
+			},
+			want: core.UpdateVolumeDetails{
+				DisplayName:      common.String("csi-volume"),
+				AutotunePolicies: []core.AutotunePolicy{},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "auto-tune already matches",
+			volume: &core.Volume{
+				DisplayName:      common.String("csi-volume"),
+				VpusPerGB:        common.Int64(10),
+				AutotunePolicies: []core.AutotunePolicy{core.DetachedVolumeAutotunePolicy{}},
+			},
+			params: VolumeModifyParameters{detachedVolumeAutotune: common.Bool(true)},
+			want: core.UpdateVolumeDetails{
+				DisplayName: common.String("csi-volume"),
 			},
-			wantErr: nil,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := &BlockVolumeControllerDriver{ControllerDriver{
-				KubeClient: nil,
-				logger:     zap.S(),
-				config:     &providercfg.Config{CompartmentID: ""},
-				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
-				util:       &csi_util.Util{},
-			}}
-			got, err := d.ControllerExpandVolume(tt.args.ctx, tt.args.req)
-			if tt.wantErr == nil && err != nil {
-				t.Errorf("got error %q, want none", err)
+			got, changed, err := getVolumeModifyDetails(tt.volume, tt.params)
+			if err != nil {
+				t.Fatalf("getVolumeModifyDetails() unexpected error: %v", err)
 			}
-			if tt.wantErr != nil && err == nil {
-				t.Errorf("want error %q, got none", tt.wantErr)
-			} else if tt.wantErr != nil && !strings.Contains(err.Error(), tt.wantErr.Error()) {
-				t.Errorf("want error %q to include %q", err, tt.wantErr)
+			if changed != tt.wantChanged {
+				t.Errorf("getVolumeModifyDetails() changed = %v, want %v", changed, tt.wantChanged)
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("ControllerDriver.ControllerExpandVolume() = %v, want %v", got, tt.want)
+				t.Errorf("getVolumeModifyDetails() = %+v, want %+v", got, tt.want)
 			}
 		})
 	}
@@ -1880,6 +3188,129 @@ func TestExtractVolumeParameters(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"With replica availability domain and kms key": {
+			storageParameters: map[string]string{
+				replicaAvailabilityDomain: "PHX-AD-3",
+				replicaKmsKey:             "foo",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:         "",
+				attachmentParameter:       make(map[string]string),
+				vpusPerGB:                 10,
+				replicaAvailabilityDomain: "PHX-AD-3",
+				replicaKmsKey:             "foo",
+			},
+			wantErr: false,
+		},
+		"With backup policy OCID": {
+			storageParameters: map[string]string{
+				backupPolicy: "ocid1.volumebackuppolicy.oc1.phx.xxxx",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupPolicy:        "ocid1.volumebackuppolicy.oc1.phx.xxxx",
+			},
+			wantErr: false,
+		},
+		"With Oracle defined backup policy": {
+			storageParameters: map[string]string{
+				backupPolicy: " Silver ",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupPolicy:        "silver",
+			},
+			wantErr: false,
+		},
+		"Unknown backup policy": {
+			storageParameters: map[string]string{
+				backupPolicy: "platinum",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"With detached and performance based auto-tune": {
+			storageParameters: map[string]string{
+				csi_util.VpusPerGB:              "20",
+				csi_util.DetachedVolumeAutotune: "true",
+				csi_util.AutotuneMaxVpusPerGB:   "30",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:      "",
+				attachmentParameter:    make(map[string]string),
+				vpusPerGB:              20,
+				detachedVolumeAutotune: true,
+				autotuneMaxVpusPerGB:   30,
+			},
+			wantErr: false,
+		},
+		"Invalid detached volume auto-tune": {
+			storageParameters: map[string]string{
+				csi_util.DetachedVolumeAutotune: "yes please",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"Auto-tune max performance level lower than performance level": {
+			storageParameters: map[string]string{
+				csi_util.VpusPerGB:            "20",
+				csi_util.AutotuneMaxVpusPerGB: "10",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:    "",
+				attachmentParameter:  make(map[string]string),
+				vpusPerGB:            20,
+				autotuneMaxVpusPerGB: 10,
+			},
+			wantErr: true,
+		},
+		"With SCSI persistent reservations": {
+			storageParameters: map[string]string{
+				scsiPersistentReservation: "true",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				reservationsEnabled: true,
+			},
+			wantErr: false,
+		},
+		"Invalid SCSI persistent reservations": {
+			storageParameters: map[string]string{
+				scsiPersistentReservation: "maybe",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"Replica kms key without replica availability domain": {
+			storageParameters: map[string]string{
+				replicaKmsKey: "foo",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				replicaKmsKey:       "foo",
+			},
+			wantErr: true,
+		},
 		"if low performance level then vpusPerGB should be 0": {
 			storageParameters: map[string]string{
 				csi_util.VpusPerGB: "0",
@@ -1960,6 +3391,66 @@ func TestExtractVolumeParameters(t *testing.T) {
 	}
 }
 
+func TestAssignBackupPolicy(t *testing.T) {
+	tests := map[string]struct {
+		volumeID        string
+		policyID        string
+		wantAssignments []string
+		wantErr         bool
+	}{
+		"Assign backup policy to volume": {
+			volumeID:        "backup-policy-volume",
+			policyID:        "ocid1.volumebackuppolicy.oc1.phx.gold",
+			wantAssignments: []string{"backup-policy-volume/ocid1.volumebackuppolicy.oc1.phx.gold"},
+		},
+		"Backup policy is already assigned": {
+			volumeID: "volume-with-backup-policy",
+			policyID: "ocid1.volumebackuppolicy.oc1.phx.silver",
+		},
+		"Another backup policy is assigned": {
+			volumeID: "volume-with-backup-policy",
+			policyID: "ocid1.volumebackuppolicy.oc1.phx.gold",
+			wantErr:  true,
+		},
+		"Backup policy assignment cannot be read": {
+			volumeID: "backup-policy-assignment-error",
+			policyID: "ocid1.volumebackuppolicy.oc1.phx.gold",
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			createdBackupPolicyAssignments = nil
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			err := d.assignBackupPolicy(context.Background(), zap.S(), tt.volumeID, tt.policyID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("assignBackupPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(createdBackupPolicyAssignments, tt.wantAssignments) {
+				t.Errorf("assignBackupPolicy() assigned %v, want %v", createdBackupPolicyAssignments, tt.wantAssignments)
+			}
+		})
+	}
+}
+
+func TestRemoveBackupPolicyAssignments(t *testing.T) {
+	deletedBackupPolicyAssignments = nil
+	d := &BlockVolumeControllerDriver{ControllerDriver{
+		logger: zap.S(),
+		client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+	}}
+	if err := d.removeBackupPolicyAssignments(context.Background(), zap.S(), "volume-with-backup-policy"); err != nil {
+		t.Fatalf("removeBackupPolicyAssignments() unexpected error: %v", err)
+	}
+	want := []string{"ocid1.volumebackuppolicyassignment.oc1.phx.xxxx"}
+	if !reflect.DeepEqual(deletedBackupPolicyAssignments, want) {
+		t.Errorf("removeBackupPolicyAssignments() deleted %v, want %v", deletedBackupPolicyAssignments, want)
+	}
+}
+
 func TestExtractSnapshotParameters(t *testing.T) {
 	tests := map[string]struct {
 		inputParameters    map[string]string
@@ -2011,6 +3502,16 @@ func TestExtractSnapshotParameters(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"With copy region": {
+			inputParameters: map[string]string{
+				backupCopyRegion: " us-ashburn-1 ",
+			},
+			snapshotParameters: SnapshotParameters{
+				backupType: core.CreateVolumeBackupDetailsTypeIncremental,
+				copyRegion: "us-ashburn-1",
+			},
+			wantErr: false,
+		},
 		"With freeform tags": {
 			inputParameters: map[string]string{
 				backupFreeformTags: `{"foo":"bar"}`,
@@ -2168,6 +3669,229 @@ func TestControllerDriver_DeleteSnapshot(t *testing.T) {
 	}
 }
 
+func TestControllerDriver_ListSnapshots(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *csi.ListSnapshotsRequest
+		wantSnapshots []*csi.Snapshot
+		wantNextToken string
+		wantErr       codes.Code
+	}{
+		{
+			name: "all snapshots sorted by creation time",
+			req:  &csi.ListSnapshotsRequest{},
+			wantSnapshots: []*csi.Snapshot{
+				{SnapshotId: "backup-faulty", SourceVolumeId: "backup-source-2", SizeBytes: 50 * client.GiB},
+				{SnapshotId: "backup-available", SourceVolumeId: "backup-source-1", SizeBytes: 50 * client.GiB, ReadyToUse: true},
+				{SnapshotId: "backup-creating", SourceVolumeId: "backup-source-1"},
+			},
+		},
+		{
+			name: "first page",
+			req:  &csi.ListSnapshotsRequest{MaxEntries: 2},
+			wantSnapshots: []*csi.Snapshot{
+				{SnapshotId: "backup-faulty", SourceVolumeId: "backup-source-2", SizeBytes: 50 * client.GiB},
+				{SnapshotId: "backup-available", SourceVolumeId: "backup-source-1", SizeBytes: 50 * client.GiB, ReadyToUse: true},
+			},
+			wantNextToken: "2",
+		},
+		{
+			name: "last page",
+			req:  &csi.ListSnapshotsRequest{MaxEntries: 2, StartingToken: "2"},
+			wantSnapshots: []*csi.Snapshot{
+				{SnapshotId: "backup-creating", SourceVolumeId: "backup-source-1"},
+			},
+		},
+		{
+			name:    "invalid starting token",
+			req:     &csi.ListSnapshotsRequest{StartingToken: "next"},
+			wantErr: codes.Aborted,
+		},
+		{
+			name: "source volume filter",
+			req:  &csi.ListSnapshotsRequest{SourceVolumeId: "backup-source-1"},
+			wantSnapshots: []*csi.Snapshot{
+				{SnapshotId: "backup-available", SourceVolumeId: "backup-source-1", SizeBytes: 50 * client.GiB, ReadyToUse: true},
+				{SnapshotId: "backup-creating", SourceVolumeId: "backup-source-1"},
+			},
+		},
+		{
+			name:    "list failure",
+			req:     &csi.ListSnapshotsRequest{SourceVolumeId: "backup-list-error"},
+			wantErr: codes.Internal,
+		},
+		{
+			name: "snapshot id filter",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "backup-available"},
+			wantSnapshots: []*csi.Snapshot{
+				{SnapshotId: "backup-available", SourceVolumeId: "backup-source-1", SizeBytes: 50 * client.GiB, ReadyToUse: true},
+			},
+		},
+		{
+			name:          "snapshot id of another source volume",
+			req:           &csi.ListSnapshotsRequest{SnapshotId: "backup-available", SourceVolumeId: "backup-source-2"},
+			wantSnapshots: []*csi.Snapshot{},
+		},
+		{
+			name:          "terminated snapshot",
+			req:           &csi.ListSnapshotsRequest{SnapshotId: "backup-terminated"},
+			wantSnapshots: []*csi.Snapshot{},
+		},
+		{
+			name:          "snapshot not found",
+			req:           &csi.ListSnapshotsRequest{SnapshotId: "backup-not-found"},
+			wantSnapshots: []*csi.Snapshot{},
+		},
+		{
+			name:    "get snapshot failure",
+			req:     &csi.ListSnapshotsRequest{SnapshotId: "backup-get-error"},
+			wantErr: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "sample-compartment"},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			got, err := d.ListSnapshots(context.Background(), tt.req)
+			if tt.wantErr != codes.OK {
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("ListSnapshots() error = %v, want code %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListSnapshots() unexpected error: %v", err)
+			}
+			if len(got.Entries) != len(tt.wantSnapshots) {
+				t.Fatalf("ListSnapshots() returned %d snapshots, want %d: %v", len(got.Entries), len(tt.wantSnapshots), got.Entries)
+			}
+			for i, want := range tt.wantSnapshots {
+				snapshot := got.Entries[i].Snapshot
+				if snapshot.SnapshotId != want.SnapshotId || snapshot.SourceVolumeId != want.SourceVolumeId ||
+					snapshot.SizeBytes != want.SizeBytes || snapshot.ReadyToUse != want.ReadyToUse {
+					t.Errorf("ListSnapshots() snapshot %d = %v, want %v", i, snapshot, want)
+				}
+				if snapshot.CreationTime == nil {
+					t.Errorf("ListSnapshots() snapshot %s has no creation time", snapshot.SnapshotId)
+				}
+			}
+			if got.NextToken != tt.wantNextToken {
+				t.Errorf("ListSnapshots() next token = %q, want %q", got.NextToken, tt.wantNextToken)
+			}
+		})
+	}
+}
+
+func TestCopyVolumeBackupToRegion(t *testing.T) {
+	tests := []struct {
+		name       string
+		backup     core.VolumeBackup
+		region     string
+		wantCopied []string
+		wantTags   map[string]string
+		wantErr    bool
+	}{
+		{
+			name:   "no copy region",
+			backup: core.VolumeBackup{Id: common.String("backup-1"), DisplayName: common.String("snapshot-1")},
+		},
+		{
+			name: "already copied",
+			backup: core.VolumeBackup{Id: common.String("backup-1"), DisplayName: common.String("snapshot-1"),
+				FreeformTags: map[string]string{backupCopyIdTag: "backup-copy", backupCopyRegionTag: "us-ashburn-1"}},
+			region: "us-ashburn-1",
+		},
+		{
+			name: "copy",
+			backup: core.VolumeBackup{Id: common.String("backup-1"), DisplayName: common.String("snapshot-1"),
+				FreeformTags: map[string]string{"team": "storage"}},
+			region:     "us-ashburn-1",
+			wantCopied: []string{"us-ashburn-1/backup-1"},
+			wantTags:   map[string]string{"team": "storage", backupCopyIdTag: "copy-of-backup-1", backupCopyRegionTag: "us-ashburn-1"},
+		},
+		{
+			name:     "copy left by a previous attempt",
+			backup:   core.VolumeBackup{Id: common.String("backup-1"), DisplayName: common.String("snapshot-copied")},
+			region:   "us-ashburn-1",
+			wantTags: map[string]string{backupCopyIdTag: "existing-copy", backupCopyRegionTag: "us-ashburn-1"},
+		},
+		{
+			name:    "copy failure",
+			backup:  core.VolumeBackup{Id: common.String("backup-copy-error"), DisplayName: common.String("snapshot-1")},
+			region:  "us-ashburn-1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			copiedVolumeBackups, updatedVolumeBackupTags = nil, nil
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "sample-compartment"},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			err := d.copyVolumeBackupToRegion(context.Background(), zap.S(), tt.backup, tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("copyVolumeBackupToRegion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(copiedVolumeBackups, tt.wantCopied) {
+				t.Errorf("copyVolumeBackupToRegion() copied %v, want %v", copiedVolumeBackups, tt.wantCopied)
+			}
+			if !reflect.DeepEqual(updatedVolumeBackupTags[*tt.backup.Id], tt.wantTags) {
+				t.Errorf("copyVolumeBackupToRegion() tags = %v, want %v", updatedVolumeBackupTags[*tt.backup.Id], tt.wantTags)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_DeleteSnapshotWithCopy(t *testing.T) {
+	tests := []struct {
+		name        string
+		snapshotID  string
+		wantDeleted []string
+		wantErr     codes.Code
+	}{
+		{
+			name:        "snapshot and its copy are deleted",
+			snapshotID:  "backup-with-copy",
+			wantDeleted: []string{"us-ashburn-1/backup-copy"},
+		},
+		{
+			name:       "snapshot without copy",
+			snapshotID: "backup-available",
+		},
+		{
+			name:       "snapshot already deleted",
+			snapshotID: "backup-not-found",
+		},
+		{
+			name:       "copy deletion failure",
+			snapshotID: "backup-with-copy-delete-error",
+			wantErr:    codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deletedBackupCopies = nil
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "sample-compartment"},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			_, err := d.DeleteSnapshot(context.Background(), &csi.DeleteSnapshotRequest{SnapshotId: tt.snapshotID})
+			if status.Code(err) != tt.wantErr {
+				t.Fatalf("DeleteSnapshot() error = %v, want code %v", err, tt.wantErr)
+			}
+			if !slices.Equal(deletedBackupCopies, tt.wantDeleted) {
+				t.Errorf("DeleteSnapshot() deleted copies %v, want %v", deletedBackupCopies, tt.wantDeleted)
+			}
+		})
+	}
+}
+
 func TestGetAttachmentOptions(t *testing.T) {
 	tests := map[string]struct {
 		attachmentType         string
@@ -2430,6 +4154,26 @@ func TestGetBVTags(t *testing.T) {
 	}
 }
 
+func TestWithClusterUIDTag(t *testing.T) {
+	tags := &providercfg.TagConfig{
+		FreeformTags: map[string]string{"key1": "value1"},
+		DefinedTags:  map[string]map[string]interface{}{"ns1": {"key1": "value1"}},
+	}
+	expected := &providercfg.TagConfig{
+		FreeformTags: map[string]string{"key1": "value1", clusterUIDTag: "sample-cluster-uid"},
+		DefinedTags:  map[string]map[string]interface{}{"ns1": {"key1": "value1"}},
+	}
+	if got := withClusterUIDTag(tags, "sample-cluster-uid"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("withClusterUIDTag() = %v, want %v", got, expected)
+	}
+	if _, ok := tags.FreeformTags[clusterUIDTag]; ok {
+		t.Errorf("withClusterUIDTag() modified the tags of the config: %v", tags)
+	}
+	if got := withClusterUIDTag(nil, "sample-cluster-uid"); !reflect.DeepEqual(got.FreeformTags, map[string]string{clusterUIDTag: "sample-cluster-uid"}) {
+		t.Errorf("withClusterUIDTag() = %v, want only the cluster UID tag", got)
+	}
+}
+
 func TestClient_GetAvailabilityDomainByName(t *testing.T) {
 	tests := []struct {
 		name       string
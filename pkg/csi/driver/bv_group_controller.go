@@ -0,0 +1,423 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// A VolumeGroupSnapshot of block volumes is a volume group backup. OCI takes
+// the backups of all volumes in a volume group at the same point in time, so
+// the driver puts the source volumes into a volume group named after the group
+// snapshot, backs the volume group up and removes the volume group again once
+// the point in time of the backup has been captured. Removing a volume group
+// does not delete its volumes.
+
+// GroupControllerGetCapabilities returns the supported capabilities of the group controller service.
+func (d *BlockVolumeControllerDriver) GroupControllerGetCapabilities(ctx context.Context, req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// CreateVolumeGroupSnapshot creates a crash consistent snapshot of the source
+// volumes as a volume group backup. The function is idempotent.
+func (d *BlockVolumeControllerDriver) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	startTime := time.Now()
+	log := d.logger.With("groupSnapshotName", req.Name, "sourceVolumeIds", req.SourceVolumeIds, "csiOperation", "createVolumeGroupSnapshot")
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.Name
+
+	if err := validateVolumeGroupSnapshotRequest(req); err != nil {
+		log.With(zap.Error(err)).Error("Invalid volume group snapshot request.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
+	groupBackups, err := d.client.BlockStorage().GetVolumeGroupBackupsByName(ctx, req.Name, d.config.CompartmentID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the volume group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to check existence of volume group snapshot %v", err)
+	}
+	if len(groupBackups) > 1 {
+		log.Errorf("Duplicate volume group snapshot %q exists", req.Name)
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "duplicate volume group snapshot %q exists", req.Name)
+	}
+
+	var groupBackupId string
+	if len(groupBackups) > 0 {
+		groupBackupId = *groupBackups[0].Id
+		log.Info("Volume group snapshot already created, checking if it has been committed")
+	} else {
+		snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to parse volumegroupsnapshotclass parameters.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumegroupsnapshotclass parameters %v", err)
+		}
+
+		volumeGroupId, err := d.getSnapshotVolumeGroup(ctx, log, req.Name, req.SourceVolumeIds, snapshotParams)
+		if err != nil {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+
+		groupBackup, err := d.client.BlockStorage().CreateVolumeGroupBackup(ctx, core.CreateVolumeGroupBackupDetails{
+			VolumeGroupId: &volumeGroupId,
+			DisplayName:   &req.Name,
+			Type:          core.CreateVolumeGroupBackupDetailsTypeEnum(snapshotParams.backupType),
+			FreeformTags:  snapshotParams.freeformTags,
+			DefinedTags:   snapshotParams.definedTags,
+		})
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Could not create volume group snapshot.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "Could not create volume group snapshot %q: %v", req.Name, err)
+		}
+		groupBackupId = *groupBackup.Id
+	}
+	log = log.With("volumeGroupBackupId", groupBackupId)
+	dimensionsMap[metrics.ResourceOCIDDimension] = groupBackupId
+
+	groupBackupCommittedTimeoutCtx, cancel := csi_util.ShortenContextBeforeDeadline(ctx, 10*time.Second)
+	defer cancel()
+
+	groupBackup, err := d.client.BlockStorage().AwaitVolumeGroupBackupCommittedOrTimeout(groupBackupCommittedTimeoutCtx, groupBackupId)
+	if err != nil {
+		if strings.Contains(err.Error(), "timed out") {
+			log.Info("Volume group backup has not been committed yet, controller will retry")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Unavailable, "volume group snapshot %q has not been committed yet", req.Name)
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Volume group backup was not committed.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "volume group snapshot %q was not committed: %v", req.Name, err)
+	}
+
+	groupSnapshot, err := d.getVolumeGroupSnapshot(ctx, groupBackup)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the snapshots of the volume group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to get the snapshots of volume group snapshot %q: %v", req.Name, err)
+	}
+
+	var sourceVolumeIds []string
+	for _, snapshot := range groupSnapshot.Snapshots {
+		sourceVolumeIds = append(sourceVolumeIds, snapshot.SourceVolumeId)
+	}
+	if !sameVolumeIds(sourceVolumeIds, req.SourceVolumeIds) {
+		log.Errorf("Volume group snapshot %s exists for other volumes %v", req.Name, sourceVolumeIds)
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.AlreadyExists, "volume group snapshot %s exists for other volumes %v", req.Name, sourceVolumeIds)
+	}
+
+	// The point in time of the backup has been captured, the volumes can leave
+	// the volume group. A failure is retried on the next call or on deletion.
+	if groupBackup.VolumeGroupId != nil {
+		if err := d.deleteSnapshotVolumeGroup(ctx, log, *groupBackup.VolumeGroupId, req.Name); err != nil {
+			log.With(zap.Error(err)).Warn("Failed to delete the volume group of the volume group snapshot.")
+		}
+	}
+
+	if groupSnapshot.ReadyToUse {
+		log.Info("Volume group snapshot is created and available.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	} else {
+		log.Info("Volume group snapshot is committed, controller will retry until it is available.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+	}
+	metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: groupSnapshot}, nil
+}
+
+// DeleteVolumeGroupSnapshot deletes the volume group backup of a volume group
+// snapshot together with the backups of its volumes.
+func (d *BlockVolumeControllerDriver) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	startTime := time.Now()
+	log := d.logger.With("groupSnapshotId", req.GroupSnapshotId, "csiOperation", "deleteVolumeGroupSnapshot")
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.GroupSnapshotId
+
+	if req.GroupSnapshotId == "" {
+		log.Error("GroupSnapshotId is empty")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "GroupSnapshotId must be provided")
+	}
+
+	groupBackup, err := d.client.BlockStorage().GetVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if err != nil {
+		if client.IsNotFound(err) {
+			log.Info("Volume group snapshot is already deleted.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to get volume group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	if groupBackup.VolumeGroupId != nil {
+		if err := d.deleteSnapshotVolumeGroup(ctx, log, *groupBackup.VolumeGroupId, *groupBackup.DisplayName); err != nil {
+			log.With(zap.Error(err)).Error("Failed to delete the volume group of the volume group snapshot.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to delete volume group %s of volume group snapshot %s: %v", *groupBackup.VolumeGroupId, req.GroupSnapshotId, err)
+		}
+	}
+
+	if groupBackup.LifecycleState == core.VolumeGroupBackupLifecycleStateTerminating ||
+		groupBackup.LifecycleState == core.VolumeGroupBackupLifecycleStateTerminated {
+		log.Info("Volume group snapshot is already being deleted.")
+	} else if err := d.client.BlockStorage().DeleteVolumeGroupBackup(ctx, req.GroupSnapshotId); err != nil && !client.IsNotFound(err) {
+		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to delete volume group snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to delete volume group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	log.Info("Volume group snapshot is deleted.")
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+// GetVolumeGroupSnapshot returns the volume group snapshot with the snapshots of its volumes.
+func (d *BlockVolumeControllerDriver) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	log := d.logger.With("groupSnapshotId", req.GroupSnapshotId, "csiOperation", "getVolumeGroupSnapshot")
+
+	if req.GroupSnapshotId == "" {
+		return nil, status.Error(codes.InvalidArgument, "GroupSnapshotId must be provided")
+	}
+
+	groupBackup, err := d.client.BlockStorage().GetVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume group snapshot %s not found", req.GroupSnapshotId)
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume group snapshot.")
+		return nil, status.Errorf(codes.Internal, "failed to get volume group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	if len(req.SnapshotIds) > 0 && !sameVolumeIds(req.SnapshotIds, groupBackup.VolumeBackupIds) {
+		return nil, status.Errorf(codes.InvalidArgument, "snapshots %v are not the snapshots %v of volume group snapshot %s",
+			req.SnapshotIds, groupBackup.VolumeBackupIds, req.GroupSnapshotId)
+	}
+
+	groupSnapshot, err := d.getVolumeGroupSnapshot(ctx, groupBackup)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the snapshots of the volume group snapshot.")
+		return nil, status.Errorf(codes.Internal, "failed to get the snapshots of volume group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: groupSnapshot}, nil
+}
+
+func validateVolumeGroupSnapshotRequest(req *csi.CreateVolumeGroupSnapshotRequest) error {
+	if req.Name == "" {
+		return status.Error(codes.InvalidArgument, "Volume group snapshot name must be provided")
+	}
+	if len(req.SourceVolumeIds) == 0 {
+		return status.Error(codes.InvalidArgument, "Volume group snapshot source volume IDs must be provided")
+	}
+	seen := make(map[string]bool)
+	for _, volumeId := range req.SourceVolumeIds {
+		if client.IsBootVolume(volumeId) {
+			return status.Errorf(codes.InvalidArgument, "Volume group snapshot feature not available for boot volume %s", volumeId)
+		}
+		if seen[volumeId] {
+			return status.Errorf(codes.InvalidArgument, "Volume %s is listed more than once", volumeId)
+		}
+		seen[volumeId] = true
+	}
+	return nil
+}
+
+// getSnapshotVolumeGroup returns the ID of the volume group named after the
+// group snapshot which holds exactly the source volumes, creating it if needed.
+func (d *BlockVolumeControllerDriver) getSnapshotVolumeGroup(ctx context.Context, log *zap.SugaredLogger, name string, volumeIds []string, snapshotParams SnapshotParameters) (string, error) {
+	var availabilityDomain, volumeGroupId string
+	for _, volumeId := range volumeIds {
+		volume, err := d.client.BlockStorage().GetVolume(ctx, volumeId)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Errorf("Failed to get source volume %s.", volumeId)
+			if client.IsNotFound(err) {
+				return "", status.Errorf(codes.NotFound, "source volume %s not found", volumeId)
+			}
+			return "", status.Errorf(codes.Internal, "failed to get source volume %s: %v", volumeId, err)
+		}
+		if volume == nil {
+			return "", status.Errorf(codes.NotFound, "source volume %s not found", volumeId)
+		}
+
+		if availabilityDomain == "" {
+			availabilityDomain = *volume.AvailabilityDomain
+		} else if *volume.AvailabilityDomain != availabilityDomain {
+			return "", status.Errorf(codes.InvalidArgument, "source volumes of a volume group snapshot must be in the same availability domain, volume %s is in %s and not in %s",
+				volumeId, *volume.AvailabilityDomain, availabilityDomain)
+		}
+
+		if volume.VolumeGroupId == nil || *volume.VolumeGroupId == "" {
+			continue
+		}
+		volumeGroup, err := d.client.BlockStorage().GetVolumeGroup(ctx, *volume.VolumeGroupId)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Errorf("Failed to get volume group %s.", *volume.VolumeGroupId)
+			return "", status.Errorf(codes.Internal, "failed to get volume group %s of volume %s: %v", *volume.VolumeGroupId, volumeId, err)
+		}
+		// A volume group left behind by a previous attempt to create this
+		// group snapshot is reused.
+		if *volumeGroup.DisplayName != name || !sameVolumeIds(volumeGroup.VolumeIds, volumeIds) {
+			return "", status.Errorf(codes.FailedPrecondition, "volume %s belongs to volume group %s, a volume can only be in one volume group at a time",
+				volumeId, *volume.VolumeGroupId)
+		}
+		volumeGroupId = *volumeGroup.Id
+	}
+
+	if volumeGroupId == "" {
+		volumeGroup, err := d.client.BlockStorage().CreateVolumeGroup(ctx, core.CreateVolumeGroupDetails{
+			AvailabilityDomain: &availabilityDomain,
+			CompartmentId:      &d.config.CompartmentID,
+			DisplayName:        &name,
+			SourceDetails:      core.VolumeGroupSourceFromVolumesDetails{VolumeIds: volumeIds},
+			FreeformTags:       snapshotParams.freeformTags,
+			DefinedTags:        snapshotParams.definedTags,
+		})
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to create volume group.")
+			return "", status.Errorf(codes.Internal, "failed to create volume group %q: %v", name, err)
+		}
+		volumeGroupId = *volumeGroup.Id
+	}
+	log = log.With("volumeGroupId", volumeGroupId)
+
+	volumeGroupAvailableTimeoutCtx, cancel := csi_util.ShortenContextBeforeDeadline(ctx, 10*time.Second)
+	defer cancel()
+	if _, err := d.client.BlockStorage().AwaitVolumeGroupAvailableOrTimeout(volumeGroupAvailableTimeoutCtx, volumeGroupId); err != nil {
+		log.With(zap.Error(err)).Error("Volume group did not become available.")
+		if strings.Contains(err.Error(), "timed out") {
+			return "", status.Errorf(codes.Unavailable, "volume group %s of volume group snapshot %q is not available yet", volumeGroupId, name)
+		}
+		return "", status.Errorf(codes.Internal, "volume group %s did not become available: %v", volumeGroupId, err)
+	}
+	return volumeGroupId, nil
+}
+
+// deleteSnapshotVolumeGroup deletes the volume group created for the group
+// snapshot with the given name. Volume groups the driver did not create for
+// the group snapshot are left alone.
+func (d *BlockVolumeControllerDriver) deleteSnapshotVolumeGroup(ctx context.Context, log *zap.SugaredLogger, volumeGroupId, name string) error {
+	volumeGroup, err := d.client.BlockStorage().GetVolumeGroup(ctx, volumeGroupId)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if volumeGroup == nil || *volumeGroup.DisplayName != name ||
+		volumeGroup.LifecycleState == core.VolumeGroupLifecycleStateTerminating ||
+		volumeGroup.LifecycleState == core.VolumeGroupLifecycleStateTerminated {
+		return nil
+	}
+
+	if err := d.client.BlockStorage().DeleteVolumeGroup(ctx, volumeGroupId); err != nil && !client.IsNotFound(err) {
+		return err
+	}
+	log.With("volumeGroupId", volumeGroupId).Info("Deleted the volume group of the volume group snapshot.")
+	return nil
+}
+
+// getVolumeGroupSnapshot returns the CSI volume group snapshot of a volume
+// group backup, with the volume backups in it as its snapshots.
+func (d *BlockVolumeControllerDriver) getVolumeGroupSnapshot(ctx context.Context, groupBackup *core.VolumeGroupBackup) (*csi.VolumeGroupSnapshot, error) {
+	groupSnapshot := &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: *groupBackup.Id,
+		CreationTime:    timestamppb.New(groupBackup.TimeCreated.Time),
+		ReadyToUse:      groupBackup.LifecycleState == core.VolumeGroupBackupLifecycleStateAvailable,
+	}
+	for _, backupId := range groupBackup.VolumeBackupIds {
+		backup, err := d.client.BlockStorage().GetVolumeBackup(ctx, backupId)
+		if err != nil {
+			return nil, err
+		}
+		snapshot := &csi.Snapshot{
+			SnapshotId:      backupId,
+			CreationTime:    groupSnapshot.CreationTime,
+			ReadyToUse:      backup.LifecycleState == core.VolumeBackupLifecycleStateAvailable,
+			GroupSnapshotId: *groupBackup.Id,
+		}
+		if backup.VolumeId != nil {
+			snapshot.SourceVolumeId = *backup.VolumeId
+		}
+		if backup.SizeInMBs != nil {
+			snapshot.SizeBytes = *backup.SizeInMBs * client.MiB
+		}
+		groupSnapshot.Snapshots = append(groupSnapshot.Snapshots, snapshot)
+	}
+	return groupSnapshot, nil
+}
+
+func sameVolumeIds(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,398 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	groupBackups = map[string]*core.VolumeGroupBackup{
+		"group-backup-new-group-snapshot": {
+			Id:              common.String("group-backup-new-group-snapshot"),
+			DisplayName:     common.String("new-group-snapshot"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateCommitted,
+			TimeCreated:     &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			VolumeGroupId:   common.String("volume-group-new-group-snapshot"),
+			VolumeBackupIds: []string{"backup-group-volume-1", "backup-group-volume-2"},
+		},
+		"existing-group-backup": {
+			Id:              common.String("existing-group-backup"),
+			DisplayName:     common.String("existing-group-snapshot"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateAvailable,
+			TimeCreated:     &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			VolumeGroupId:   common.String("volume-group-existing-group-snapshot"),
+			VolumeBackupIds: []string{"backup-existing-1", "backup-existing-2"},
+		},
+		"group-backup-delete-fail": {
+			Id:              common.String("group-backup-delete-fail"),
+			DisplayName:     common.String("delete-fail-group-snapshot"),
+			LifecycleState:  core.VolumeGroupBackupLifecycleStateAvailable,
+			TimeCreated:     &common.SDKTime{Time: time.Unix(1700000000, 0)},
+			VolumeBackupIds: []string{"backup-existing-1", "backup-existing-2"},
+		},
+	}
+
+	groupVolumeBackups = map[string]*core.VolumeBackup{
+		"backup-group-volume-1": {
+			Id:             common.String("backup-group-volume-1"),
+			VolumeId:       common.String("group-volume-1"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+		},
+		"backup-group-volume-2": {
+			Id:             common.String("backup-group-volume-2"),
+			VolumeId:       common.String("group-volume-2"),
+			LifecycleState: core.VolumeBackupLifecycleStateCreating,
+		},
+		"backup-existing-1": {
+			Id:             common.String("backup-existing-1"),
+			VolumeId:       common.String("group-volume-1"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+		},
+		"backup-existing-2": {
+			Id:             common.String("backup-existing-2"),
+			VolumeId:       common.String("group-volume-2"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+		},
+	}
+
+	// deletedVolumeGroups records the volume groups deleted through the mock.
+	deletedVolumeGroups []string
+)
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return &core.VolumeGroup{Id: &id, LifecycleState: core.VolumeGroupLifecycleStateAvailable}, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	source, ok := details.SourceDetails.(core.VolumeGroupSourceFromVolumesDetails)
+	if !ok || details.AvailabilityDomain == nil || *details.AvailabilityDomain != "NWuj:PHX-AD-1" {
+		return nil, fmt.Errorf("volume group is not created from volumes in NWuj:PHX-AD-1")
+	}
+	return &core.VolumeGroup{
+		Id:             common.String("volume-group-" + *details.DisplayName),
+		DisplayName:    details.DisplayName,
+		LifecycleState: core.VolumeGroupLifecycleStateProvisioning,
+		VolumeIds:      source.VolumeIds,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	deletedVolumeGroups = append(deletedVolumeGroups, id)
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	switch id {
+	case "foreign-volume-group":
+		return &core.VolumeGroup{
+			Id:             &id,
+			DisplayName:    common.String("database"),
+			LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+			VolumeIds:      []string{"group-volume-in-group"},
+		}, nil
+	case "volume-group-new-group-snapshot":
+		return &core.VolumeGroup{
+			Id:             &id,
+			DisplayName:    common.String("new-group-snapshot"),
+			LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+			VolumeIds:      []string{"group-volume-1", "group-volume-2"},
+		}, nil
+	}
+	return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "volume group not found"}
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupBackupCommittedOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if backup, ok := groupBackups[id]; ok {
+		return backup, nil
+	}
+	return nil, fmt.Errorf("timed out waiting for the condition")
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	if details.VolumeGroupId == nil || *details.VolumeGroupId != "volume-group-"+*details.DisplayName {
+		return nil, fmt.Errorf("volume group backup is not created from the volume group of the group snapshot")
+	}
+	return &core.VolumeGroupBackup{
+		Id:             common.String("group-backup-" + *details.DisplayName),
+		DisplayName:    details.DisplayName,
+		LifecycleState: core.VolumeGroupBackupLifecycleStateRequestReceived,
+		VolumeGroupId:  details.VolumeGroupId,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	if id == "group-backup-delete-fail" {
+		return fmt.Errorf("delete volume group backup failed")
+	}
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if backup, ok := groupBackups[id]; ok {
+		return backup, nil
+	}
+	return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "volume group backup not found"}
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	switch groupSnapshotName {
+	case "existing-group-snapshot":
+		return []core.VolumeGroupBackup{*groupBackups["existing-group-backup"]}, nil
+	case "duplicate-group-snapshot":
+		return []core.VolumeGroupBackup{*groupBackups["existing-group-backup"], *groupBackups["existing-group-backup"]}, nil
+	}
+	return nil, nil
+}
+
+func newGroupControllerDriver() *BlockVolumeControllerDriver {
+	return &BlockVolumeControllerDriver{ControllerDriver{
+		logger: zap.S(),
+		config: &providercfg.Config{CompartmentID: "compartment"},
+		client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+	}}
+}
+
+func TestControllerDriver_CreateVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name                    string
+		req                     *csi.CreateVolumeGroupSnapshotRequest
+		wantCode                codes.Code
+		wantGroupSnapshotId     string
+		wantReady               bool
+		wantSnapshots           map[string]string
+		wantDeletedVolumeGroups []string
+	}{
+		{
+			name:     "missing name",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{SourceVolumeIds: []string{"group-volume-1"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing source volumes",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "boot volume",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"ocid1.bootvolume.oc1.phx.xxxx"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "duplicate source volume",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-1"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid backup type",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-2"}, Parameters: map[string]string{backupType: "foo"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "source volume not found",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "csi-get-volume-not-found"}},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "source volumes in different availability domains",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-other-ad"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "source volume in another volume group",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-in-group"}},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "duplicate volume group snapshot",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "duplicate-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-2"}},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "volume group backup not committed yet",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "uncommitted-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-2"}},
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:                "new volume group snapshot",
+			req:                 &csi.CreateVolumeGroupSnapshotRequest{Name: "new-group-snapshot", SourceVolumeIds: []string{"group-volume-2", "group-volume-1"}},
+			wantGroupSnapshotId: "group-backup-new-group-snapshot",
+			wantReady:           false,
+			wantSnapshots: map[string]string{
+				"backup-group-volume-1": "group-volume-1",
+				"backup-group-volume-2": "group-volume-2",
+			},
+			wantDeletedVolumeGroups: []string{"volume-group-new-group-snapshot"},
+		},
+		{
+			name:                "existing volume group snapshot",
+			req:                 &csi.CreateVolumeGroupSnapshotRequest{Name: "existing-group-snapshot", SourceVolumeIds: []string{"group-volume-1", "group-volume-2"}},
+			wantGroupSnapshotId: "existing-group-backup",
+			wantReady:           true,
+			wantSnapshots: map[string]string{
+				"backup-existing-1": "group-volume-1",
+				"backup-existing-2": "group-volume-2",
+			},
+		},
+		{
+			name:     "existing volume group snapshot of other volumes",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "existing-group-snapshot", SourceVolumeIds: []string{"group-volume-1"}},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deletedVolumeGroups = nil
+			resp, err := newGroupControllerDriver().CreateVolumeGroupSnapshot(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			groupSnapshot := resp.GroupSnapshot
+			if groupSnapshot.GroupSnapshotId != tt.wantGroupSnapshotId || groupSnapshot.ReadyToUse != tt.wantReady {
+				t.Fatalf("expected group snapshot %s ready %v, got %v", tt.wantGroupSnapshotId, tt.wantReady, groupSnapshot)
+			}
+			if len(groupSnapshot.Snapshots) != len(tt.wantSnapshots) {
+				t.Fatalf("expected snapshots %v, got %v", tt.wantSnapshots, groupSnapshot.Snapshots)
+			}
+			for _, snapshot := range groupSnapshot.Snapshots {
+				if tt.wantSnapshots[snapshot.SnapshotId] != snapshot.SourceVolumeId || snapshot.GroupSnapshotId != tt.wantGroupSnapshotId {
+					t.Fatalf("unexpected snapshot %v", snapshot)
+				}
+			}
+			if !slices.Equal(deletedVolumeGroups, tt.wantDeletedVolumeGroups) {
+				t.Fatalf("expected deleted volume groups %v, got %v", tt.wantDeletedVolumeGroups, deletedVolumeGroups)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_DeleteVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name                    string
+		req                     *csi.DeleteVolumeGroupSnapshotRequest
+		wantCode                codes.Code
+		wantDeletedVolumeGroups []string
+	}{
+		{
+			name:     "missing group snapshot id",
+			req:      &csi.DeleteVolumeGroupSnapshotRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "volume group snapshot already deleted",
+			req:  &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "deleted-group-backup"},
+		},
+		{
+			name:                    "volume group snapshot with its volume group",
+			req:                     &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-new-group-snapshot"},
+			wantDeletedVolumeGroups: []string{"volume-group-new-group-snapshot"},
+		},
+		{
+			name: "volume group snapshot without its volume group",
+			req:  &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "existing-group-backup"},
+		},
+		{
+			name:     "delete fails",
+			req:      &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-delete-fail"},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deletedVolumeGroups = nil
+			_, err := newGroupControllerDriver().DeleteVolumeGroupSnapshot(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+			if !slices.Equal(deletedVolumeGroups, tt.wantDeletedVolumeGroups) {
+				t.Fatalf("expected deleted volume groups %v, got %v", tt.wantDeletedVolumeGroups, deletedVolumeGroups)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_GetVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *csi.GetVolumeGroupSnapshotRequest
+		wantCode  codes.Code
+		wantReady bool
+	}{
+		{
+			name:     "missing group snapshot id",
+			req:      &csi.GetVolumeGroupSnapshotRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "volume group snapshot not found",
+			req:      &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "deleted-group-backup"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "snapshots of another volume group snapshot",
+			req:      &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "existing-group-backup", SnapshotIds: []string{"backup-group-volume-1"}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:      "available volume group snapshot",
+			req:       &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "existing-group-backup", SnapshotIds: []string{"backup-existing-2", "backup-existing-1"}},
+			wantReady: true,
+		},
+		{
+			name:      "committed volume group snapshot",
+			req:       &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "group-backup-new-group-snapshot"},
+			wantReady: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newGroupControllerDriver().GetVolumeGroupSnapshot(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if resp.GroupSnapshot.GroupSnapshotId != tt.req.GroupSnapshotId || resp.GroupSnapshot.ReadyToUse != tt.wantReady {
+				t.Fatalf("expected group snapshot %s ready %v, got %v", tt.req.GroupSnapshotId, tt.wantReady, resp.GroupSnapshot)
+			}
+			if len(resp.GroupSnapshot.Snapshots) != 2 {
+				t.Fatalf("expected 2 snapshots, got %v", resp.GroupSnapshot.Snapshots)
+			}
+		})
+	}
+}
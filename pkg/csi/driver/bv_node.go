@@ -20,9 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
@@ -35,12 +38,16 @@ import (
 	kubeAPI "k8s.io/api/core/v1"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
+	"k8s.io/mount-utils"
 )
 
 const (
 	maxVolumesPerNode               = 32
 	volumeOperationAlreadyExistsFmt = "An operation for the volume: %s already exists."
 	FSTypeXfs                       = "xfs"
+	// persistentReservationKeyFile records, inside the staging path of a raw
+	// block volume, the key the node registered with the persistent reservation.
+	persistentReservationKeyFile = "reservation-key"
 )
 
 // NodeStageVolume mounts the volume to a staging path on the node.
@@ -231,6 +238,16 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 	}
 
 	if isRawBlockVolume {
+		if fencingEnabled(req) {
+			if err := d.registerPersistentReservation(logger, req.StagingTargetPath, devicePath, multipathEnabledVolume); err != nil {
+				logger.With(zap.Error(err)).Error("failed to register SCSI-3 persistent reservation.")
+				logoutErr := mountHandler.ISCSILogoutOnFailure()
+				if logoutErr != nil {
+					return nil, status.Errorf(codes.Internal, "Failed to iscsi logout after persistent reservation failure: %v", logoutErr)
+				}
+				return nil, status.Errorf(codes.Internal, "failed to register SCSI-3 persistent reservation: %v", err)
+			}
+		}
 		err := csi_util.CreateFilePath(logger, stagingTargetFilePath)
 		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to create the stagingTargetFile.")
@@ -435,6 +452,7 @@ func (d BlockVolumeNodeDriver) NodeUnstageVolume(ctx context.Context, req *csi.N
 	var unMountErr error
 
 	if isRawBlockVolume {
+		unregisterPersistentReservation(logger, req.StagingTargetPath, devicePath)
 		unMountErr = mountHandler.UnmountPath(stagingTargetFilePath)
 	} else {
 		unMountErr = mountHandler.UnmountPath(req.StagingTargetPath)
@@ -813,7 +831,7 @@ func alreadyDeletedPathCheck(err error) bool {
 // NodeGetCapabilities returns the supported capabilities of the node server
 func (d BlockVolumeNodeDriver) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
 	var nscaps []*csi.NodeServiceCapability
-	nodeCaps := []csi.NodeServiceCapability_RPC_Type{csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME, csi.NodeServiceCapability_RPC_GET_VOLUME_STATS, csi.NodeServiceCapability_RPC_EXPAND_VOLUME}
+	nodeCaps := []csi.NodeServiceCapability_RPC_Type{csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME, csi.NodeServiceCapability_RPC_GET_VOLUME_STATS, csi.NodeServiceCapability_RPC_EXPAND_VOLUME, csi.NodeServiceCapability_RPC_VOLUME_CONDITION}
 	for _, nodeCap := range nodeCaps {
 		c := &csi.NodeServiceCapability{
 			Type: &csi.NodeServiceCapability_Rpc{
@@ -893,9 +911,14 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 	}
 
 	if isRawBlockVolume {
+		condition := getBlockVolumeCondition(logger, volumePath)
 		metricsProvider := volume.NewMetricsBlock(volumePath)
 		metrics, err := metricsProvider.GetMetrics()
 		if err != nil {
+			if condition.GetAbnormal() {
+				logger.With(zap.Error(err)).Warn(condition.Message)
+				return &csi.NodeGetVolumeStatsResponse{VolumeCondition: condition}, nil
+			}
 			logger.With(zap.Error(err)).Errorf("failed to get metrics for device at %s", volumePath)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -907,6 +930,7 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 					Total: metrics.Capacity.AsDec().UnscaledBig().Int64(),
 				},
 			},
+			VolumeCondition: condition,
 		}, nil
 	}
 
@@ -920,9 +944,17 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 		return nil, status.Errorf(codes.NotFound, "path %s does not exist", volumePath)
 	}
 
+	condition := getBlockVolumeCondition(logger, volumePath)
 	metricsProvider := volume.NewMetricsStatFS(volumePath)
 	metrics, err := metricsProvider.GetMetrics()
 	if err != nil {
+		if !condition.GetAbnormal() && strings.Contains(err.Error(), syscall.EIO.Error()) {
+			condition = abnormalVolumeCondition("file system at %s is not accessible: %v", volumePath, err)
+		}
+		if condition.GetAbnormal() {
+			logger.With(zap.Error(err)).Warn(condition.Message)
+			return &csi.NodeGetVolumeStatsResponse{VolumeCondition: condition}, nil
+		}
 		logger.With(zap.Error(err)).Errorf("failed to get block volume info on path %s: %v", volumePath, err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -942,9 +974,136 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 				Used:      metrics.InodesUsed.AsDec().UnscaledBig().Int64(),
 			},
 		},
+		VolumeCondition: condition,
 	}, nil
 }
 
+var (
+	// mountInfoPath, diskByPathDir, ext4SysfsDir and iscsiSessionActive are
+	// swapped out in tests.
+	mountInfoPath      = "/proc/self/mountinfo"
+	diskByPathDir      = "/dev/disk/by-path"
+	ext4SysfsDir       = "/sys/fs/ext4"
+	iscsiSessionActive = disk.IsISCSISessionActive
+)
+
+// getBlockVolumeCondition checks the mount at the volume path and the device
+// backing it for a missing multipath device, an iSCSI session that is down, a
+// file system remounted read-only and file system errors. It returns nil if
+// the condition cannot be determined.
+func getBlockVolumeCondition(logger *zap.SugaredLogger, volumePath string) *csi.VolumeCondition {
+	mountInfos, err := mount.ParseMountInfo(mountInfoPath)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to read mount info, unable to determine the volume condition.")
+		return nil
+	}
+
+	var mountInfo *mount.MountInfo
+	for i := range mountInfos {
+		// the last entry wins as it is the one visible at the path
+		if mountInfos[i].MountPoint == volumePath {
+			mountInfo = &mountInfos[i]
+		}
+	}
+	if mountInfo == nil {
+		return abnormalVolumeCondition("volume is not mounted at %s", volumePath)
+	}
+
+	device := mountInfo.Source
+	if mountInfo.FsType == "devtmpfs" {
+		// raw block volumes are bind mounts of the device file
+		device = filepath.Join("/dev", mountInfo.Root)
+	}
+	isMultipathEnabled := strings.HasPrefix(device, "/dev/mapper")
+
+	if _, err := os.Stat(device); os.IsNotExist(err) {
+		if isMultipathEnabled {
+			return abnormalVolumeCondition("multipath device %s of the volume is missing", device)
+		}
+		return abnormalVolumeCondition("device %s of the volume is missing", device)
+	}
+
+	if !isMultipathEnabled {
+		for _, diskByPath := range diskByPathsForDevice(logger, device) {
+			base := filepath.Base(diskByPath)
+			if strings.HasPrefix(base, "ip-") && strings.Contains(base, "-iscsi-") && !iscsiSessionActive(diskByPath, logger) {
+				return abnormalVolumeCondition("iSCSI session of device %s (%s) is not active", device, base)
+			}
+		}
+	}
+
+	if mountInfo.FsType == "devtmpfs" {
+		return healthyVolumeCondition()
+	}
+
+	// A file system mounted read-only on purpose is read-only for the mount as
+	// well, whereas errors=remount-ro only flips the superblock.
+	if slices.Contains(mountInfo.SuperOptions, "ro") && !slices.Contains(mountInfo.MountOptions, "ro") {
+		return abnormalVolumeCondition("%s file system on %s has been remounted read-only, it may have encountered errors", mountInfo.FsType, device)
+	}
+
+	if mountInfo.FsType == "ext4" {
+		if count := ext4ErrorCount(device); count > 0 {
+			return abnormalVolumeCondition("ext4 file system on %s has recorded %d errors, it needs to be checked with fsck", device, count)
+		}
+	}
+
+	return healthyVolumeCondition()
+}
+
+// diskByPathsForDevice returns the /dev/disk/by-path links to the device.
+func diskByPathsForDevice(logger *zap.SugaredLogger, device string) []string {
+	entries, err := os.ReadDir(diskByPathDir)
+	if err != nil {
+		logger.With(zap.Error(err)).Warnf("Failed to read %s", diskByPathDir)
+		return nil
+	}
+	var diskByPaths []string
+	for _, entry := range entries {
+		path := filepath.Join(diskByPathDir, entry.Name())
+		target, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			continue
+		}
+		if target == device {
+			diskByPaths = append(diskByPaths, path)
+		}
+	}
+	return diskByPaths
+}
+
+// ext4ErrorCount returns the number of errors the ext4 file system on the
+// device has recorded since it was last checked.
+func ext4ErrorCount(device string) int {
+	resolved, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return 0
+	}
+	content, err := os.ReadFile(filepath.Join(ext4SysfsDir, filepath.Base(resolved), "errors_count"))
+	if err != nil {
+		return 0
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+func abnormalVolumeCondition(format string, args ...interface{}) *csi.VolumeCondition {
+	return &csi.VolumeCondition{
+		Abnormal: true,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func healthyVolumeCondition() *csi.VolumeCondition {
+	return &csi.VolumeCondition{
+		Abnormal: false,
+		Message:  "volume is healthy",
+	}
+}
+
 // NodeExpandVolume returns the expand of the volume
 func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.NodeExpandVolumeRequest) (*csi.NodeExpandVolumeResponse, error) {
 	volumeID := req.GetVolumeId()
@@ -1056,6 +1215,49 @@ func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.No
 	}, nil
 }
 
+// fencingEnabled returns whether the node registers with the SCSI-3 persistent
+// reservation of the volume when it is staged. Only shareable raw block
+// volumes with persistent reservations enabled are fenced.
+func fencingEnabled(req *csi.NodeStageVolumeRequest) bool {
+	return req.VolumeContext[reservationsEnabled] == "true" &&
+		req.VolumeCapability.GetBlock() != nil &&
+		req.VolumeCapability.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER
+}
+
+// registerPersistentReservation registers the key of the node with the
+// persistent reservation of the device and records it in the staging path so
+// the registration is removed when the volume is unstaged.
+func (d BlockVolumeNodeDriver) registerPersistentReservation(logger *zap.SugaredLogger, stagingTargetPath, devicePath string, multipath bool) error {
+	if multipath {
+		return fmt.Errorf("SCSI-3 persistent reservations are not supported for multipath enabled volumes")
+	}
+	key := disk.PersistentReservationKey(d.nodeID)
+	if err := disk.RegisterPersistentReservation(logger, devicePath, key); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(stagingTargetPath, 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(stagingTargetPath, persistentReservationKeyFile), []byte(key), 0600)
+}
+
+// unregisterPersistentReservation removes the registration of the node with
+// the persistent reservation of the device if it was registered when the
+// volume was staged. Failures are only logged since the registration has no
+// effect once the volume is detached from the node.
+func unregisterPersistentReservation(logger *zap.SugaredLogger, stagingTargetPath, devicePath string) {
+	keyFile := filepath.Join(stagingTargetPath, persistentReservationKeyFile)
+	if _, err := os.Stat(keyFile); err != nil {
+		return
+	}
+	if err := disk.UnregisterPersistentReservation(logger, devicePath); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to unregister SCSI-3 persistent reservation.")
+	}
+	if err := os.Remove(keyFile); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to remove persistent reservation key file.")
+	}
+}
+
 // hasMountOption returns a boolean indicating whether the given
 // slice already contains a mount option. This is used to prevent
 // passing duplicate option to the mount command.
@@ -18,7 +18,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -240,6 +243,63 @@ func (m mockMounter) ISCSILogoutOnFailure() error {
 	return nil
 }
 
+func Test_fencingEnabled(t *testing.T) {
+	capability := func(accessType *csi.VolumeCapability, mode csi.VolumeCapability_AccessMode_Mode) *csi.VolumeCapability {
+		accessType.AccessMode = &csi.VolumeCapability_AccessMode{Mode: mode}
+		return accessType
+	}
+	block := func() *csi.VolumeCapability {
+		return &csi.VolumeCapability{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}}
+	}
+	mount := func() *csi.VolumeCapability {
+		return &csi.VolumeCapability{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}}
+	}
+	tests := []struct {
+		name string
+		req  *csi.NodeStageVolumeRequest
+		want bool
+	}{
+		{
+			name: "Shareable raw block volume with reservations enabled",
+			req: &csi.NodeStageVolumeRequest{
+				VolumeContext:    map[string]string{reservationsEnabled: "true"},
+				VolumeCapability: capability(block(), csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER),
+			},
+			want: true,
+		},
+		{
+			name: "Reservations not enabled",
+			req: &csi.NodeStageVolumeRequest{
+				VolumeCapability: capability(block(), csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER),
+			},
+			want: false,
+		},
+		{
+			name: "Single node writer",
+			req: &csi.NodeStageVolumeRequest{
+				VolumeContext:    map[string]string{reservationsEnabled: "true"},
+				VolumeCapability: capability(block(), csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER),
+			},
+			want: false,
+		},
+		{
+			name: "Filesystem volume",
+			req: &csi.NodeStageVolumeRequest{
+				VolumeContext:    map[string]string{reservationsEnabled: "true"},
+				VolumeCapability: capability(mount(), csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fencingEnabled(tt.req); got != tt.want {
+				t.Errorf("fencingEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNodeStageVolume(t *testing.T) {
 	multiPathDevicesJson := []byte{}
 	var err error
@@ -528,3 +588,118 @@ func TestNodeStageVolume(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeGetVolumeStats_VolumeCondition(t *testing.T) {
+	defer func(mountInfo, byPath, ext4Sysfs string, sessionActive func(string, *zap.SugaredLogger) bool) {
+		mountInfoPath, diskByPathDir, ext4SysfsDir, iscsiSessionActive = mountInfo, byPath, ext4Sysfs, sessionActive
+	}(mountInfoPath, diskByPathDir, ext4SysfsDir, iscsiSessionActive)
+
+	tests := []struct {
+		name          string
+		mountInfo     string
+		iscsiDevice   bool
+		sessionActive bool
+		ext4Errors    string
+		wantAbnormal  bool
+		wantCondition string
+	}{
+		{
+			name:          "healthy volume",
+			mountInfo:     "100 29 8:16 / {volume} rw,relatime - ext4 {device} rw",
+			wantCondition: "volume is healthy",
+		},
+		{
+			name:          "volume not mounted",
+			mountInfo:     "100 29 8:16 / /some/other/path rw,relatime - ext4 {device} rw",
+			wantAbnormal:  true,
+			wantCondition: "volume is not mounted",
+		},
+		{
+			name:          "multipath device missing",
+			mountInfo:     "100 29 252:0 / {volume} rw,relatime - xfs /dev/mapper/mpath-missing rw",
+			wantAbnormal:  true,
+			wantCondition: "multipath device /dev/mapper/mpath-missing of the volume is missing",
+		},
+		{
+			name:          "iscsi session down",
+			mountInfo:     "100 29 8:16 / {volume} rw,relatime - ext4 {device} rw",
+			iscsiDevice:   true,
+			wantAbnormal:  true,
+			wantCondition: "iSCSI session of device",
+		},
+		{
+			name:          "iscsi session active",
+			mountInfo:     "100 29 8:16 / {volume} rw,relatime - ext4 {device} rw",
+			iscsiDevice:   true,
+			sessionActive: true,
+			wantCondition: "volume is healthy",
+		},
+		{
+			name:          "file system remounted read-only",
+			mountInfo:     "100 29 8:16 / {volume} rw,relatime - ext4 {device} ro,errors=remount-ro",
+			wantAbnormal:  true,
+			wantCondition: "has been remounted read-only",
+		},
+		{
+			name:          "volume published read-only",
+			mountInfo:     "100 29 8:16 / {volume} ro,relatime - ext4 {device} ro",
+			wantCondition: "volume is healthy",
+		},
+		{
+			name:          "ext4 errors recorded",
+			mountInfo:     "100 29 8:16 / {volume} rw,relatime - ext4 {device} rw",
+			ext4Errors:    "3\n",
+			wantAbnormal:  true,
+			wantCondition: "has recorded 3 errors",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			volumePath := filepath.Join(dir, "volume")
+			device := filepath.Join(dir, "sdb")
+			diskByPathDir = filepath.Join(dir, "by-path")
+			ext4SysfsDir = filepath.Join(dir, "ext4")
+			mountInfoPath = filepath.Join(dir, "mountinfo")
+			for _, d := range []string{volumePath, diskByPathDir, filepath.Join(ext4SysfsDir, "sdb")} {
+				if err := os.MkdirAll(d, 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := os.WriteFile(device, nil, 0644); err != nil {
+				t.Fatal(err)
+			}
+			mountInfo := strings.NewReplacer("{volume}", volumePath, "{device}", device).Replace(tt.mountInfo)
+			if err := os.WriteFile(mountInfoPath, []byte(mountInfo+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if tt.iscsiDevice {
+				link := filepath.Join(diskByPathDir, "ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:5638bae3-98d1-4e33-b912-9bb567d94f59-lun-2")
+				if err := os.Symlink(device, link); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.ext4Errors != "" {
+				if err := os.WriteFile(filepath.Join(ext4SysfsDir, "sdb", "errors_count"), []byte(tt.ext4Errors), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			iscsiSessionActive = func(string, *zap.SugaredLogger) bool { return tt.sessionActive }
+
+			d := BlockVolumeNodeDriver{NodeDriver{logger: zap.S()}}
+			resp, err := d.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: volumePath})
+			if err != nil {
+				t.Fatalf("NodeGetVolumeStats() unexpected error: %v", err)
+			}
+			if len(resp.Usage) != 2 {
+				t.Errorf("NodeGetVolumeStats() usage = %v, want bytes and inodes", resp.Usage)
+			}
+			if resp.VolumeCondition.GetAbnormal() != tt.wantAbnormal {
+				t.Errorf("NodeGetVolumeStats() abnormal = %v, want %v", resp.VolumeCondition, tt.wantAbnormal)
+			}
+			if !strings.Contains(resp.VolumeCondition.GetMessage(), tt.wantCondition) {
+				t.Errorf("NodeGetVolumeStats() condition = %q, want it to contain %q", resp.VolumeCondition.GetMessage(), tt.wantCondition)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -37,6 +38,8 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
@@ -65,6 +68,35 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getPage returns the bounds of the page selected by the starting_token and
+// max_entries of a CSI list request over total sorted entries, together with
+// the token of the next page. Tokens are offsets into the sorted entries.
+func getPage(startingToken string, maxEntries int32, total int) (int, int, string, error) {
+	if maxEntries < 0 {
+		return 0, 0, "", status.Errorf(codes.InvalidArgument, "max_entries must not be negative, got %d", maxEntries)
+	}
+
+	start := 0
+	if startingToken != "" {
+		var err error
+		start, err = strconv.Atoi(startingToken)
+		if err != nil || start < 0 {
+			return 0, 0, "", status.Errorf(codes.Aborted, "invalid starting_token %q", startingToken)
+		}
+	}
+	if start > total {
+		return 0, 0, "", status.Errorf(codes.Aborted, "starting_token %q is beyond the %d entries found", startingToken, total)
+	}
+
+	end := total
+	nextToken := ""
+	if maxEntries > 0 && start+int(maxEntries) < end {
+		end = start + int(maxEntries)
+		nextToken = strconv.Itoa(end)
+	}
+	return start, end, nextToken, nil
+}
+
 const (
 
 	// BlockVolumeDriverVersion is the version of the CSI driver
@@ -115,6 +147,7 @@ type ControllerDriver struct {
 	metricPusher    *metrics.MetricPusher
 	clusterIpFamily string
 	csi.UnimplementedControllerServer
+	csi.UnimplementedGroupControllerServer
 }
 
 // BlockVolumeControllerDriver extends ControllerDriver
@@ -126,6 +159,9 @@ type BlockVolumeControllerDriver struct {
 type FSSControllerDriver struct {
 	ControllerDriver
 	serviceAccountLister listersv1.ServiceAccountLister
+	// persistentVolumeIndexer indexes the persistent volumes by the OCID of
+	// their file system.
+	persistentVolumeIndexer cache.Indexer
 }
 
 // LustreControllerDriver extends ControllerDriver for Lustre CSI Controller RPCs.
@@ -213,11 +249,17 @@ func GetControllerDriver(name string, kubeClientSet kubernetes.Interface, logger
 		factory := informers.NewSharedInformerFactory(kubeClientSet, 5*time.Minute)
 		serviceAccountInformer := factory.Core().V1().ServiceAccounts()
 		go serviceAccountInformer.Informer().Run(wait.NeverStop)
+		persistentVolumeInformer := factory.Core().V1().PersistentVolumes()
+		if err := persistentVolumeInformer.Informer().AddIndexers(cache.Indexers{fssFileSystemIndex: fssFileSystemIndexFunc}); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to index persistent volumes: %v", err))
+		}
+		go persistentVolumeInformer.Informer().Run(wait.NeverStop)
 
-		if !cache.WaitForCacheSync(wait.NeverStop, serviceAccountInformer.Informer().HasSynced) {
+		if !cache.WaitForCacheSync(wait.NeverStop, serviceAccountInformer.Informer().HasSynced, persistentVolumeInformer.Informer().HasSynced) {
 			utilruntime.HandleError(fmt.Errorf("timed out waiting for informers to sync"))
 		}
-		return &FSSControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, logger, config, c, metricPusher, clusterIpFamily), serviceAccountLister: serviceAccountInformer.Lister()}
+		return &FSSControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, logger, config, c, metricPusher, clusterIpFamily),
+			serviceAccountLister: serviceAccountInformer.Lister(), persistentVolumeIndexer: persistentVolumeInformer.Informer().GetIndexer()}
 	}
 	if name == LustreDriverName {
 		return &LustreControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, logger, config, c, metricPusher, clusterIpFamily)}
@@ -375,6 +417,9 @@ func (d *Driver) Run() error {
 	csi.RegisterIdentityServer(d.srv, d)
 	if d.enableControllerServer {
 		csi.RegisterControllerServer(d.srv, d.GetControllerDriver())
+		if d.name == BlockVolumeDriverName {
+			csi.RegisterGroupControllerServer(d.srv, d.controllerDriver.(*BlockVolumeControllerDriver))
+		}
 	} else {
 		csi.RegisterNodeServer(d.srv, d.GetNodeDriver())
 	}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,14 +29,18 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	fss "github.com/oracle/oci-go-sdk/v65/filestorage"
 	authv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -59,8 +65,25 @@ var (
 
 const (
 	serviceAccountTokenExpiry = 3600
+
+	// mountTargetIps is the volume context key of the IPs of the mount targets
+	// a file system is exported through, by availability domain.
+	mountTargetIps = "mountTargetIps"
+
+	// kerberosSecurity is the storage class parameter and volume context key
+	// of the Kerberos security flavor a file system is exported and mounted
+	// with.
+	kerberosSecurity = "kerberosSecurity"
 )
 
+// kerberosAllowedAuth maps the Kerberos security flavors to the export
+// authentication type which allows them.
+var kerberosAllowedAuth = map[string]fss.ClientOptionsAllowedAuthEnum{
+	"krb5":  fss.ClientOptionsAllowedAuthKrb5,
+	"krb5i": fss.ClientOptionsAllowedAuthKrb5i,
+	"krb5p": fss.ClientOptionsAllowedAuthKrb5p,
+}
+
 var ServiceAccountTokenExpiry = int64(serviceAccountTokenExpiry)
 
 // StorageClassParameters holds configuration
@@ -85,6 +108,31 @@ type StorageClassParameters struct {
 	scTags *config.TagConfig
 	// NsgOcids
 	nsgOcids []string
+	// additionalMountTargetAvailabilityDomains are the availability domains, other than availabilityDomain, in which
+	// a mount target is created in mountTargetSubnetOcid to export the file system through
+	additionalMountTargetAvailabilityDomains []string
+	// additionalMountTargetOcids are existing mount targets in other availability domains to export the file system through
+	additionalMountTargetOcids []string
+	// enforceQuota if enabled, the capacity of the volume is enforced with a file system quota rule
+	enforceQuota bool
+	// kerberosSecurity is the Kerberos security flavor, krb5, krb5i or krb5p, the file system is exported and mounted with
+	kerberosSecurity string
+	// kerberos is the Kerberos configuration of a new mount target
+	kerberos *fss.CreateKerberosDetails
+	// ldapIdmap is the LDAP ID mapping configuration of a new mount target
+	ldapIdmap *fss.CreateLdapIdmapDetails
+}
+
+// additionalMountTarget is a mount target, other than the one in the volume
+// ID, the file system is exported through.
+type additionalMountTarget struct {
+	availabilityDomain string
+	id                 string
+	ip                 string
+	exportSetId        string
+	// isCreated is true for mount targets created for the volume, which are
+	// deleted with it
+	isCreated bool
 }
 
 type SecretParameters struct {
@@ -167,7 +215,7 @@ func (d *FSSControllerDriver) CreateVolume(ctx context.Context, req *csi.CreateV
 	}
 
 	if err := checkForSupportedVolumeCapabilities(volumeCapabilities); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Requested Volume Capability not supported")
+		return nil, status.Errorf(codes.InvalidArgument, "Requested Volume Capability not supported: %v", err)
 	}
 
 	log, response, storageClassParameters, err, done := extractStorageClassParameters(ctx, d, log, dimensionsMap, volumeName, req.GetParameters(), startTime, identityClient)
@@ -177,14 +225,64 @@ func (d *FSSControllerDriver) CreateVolume(ctx context.Context, req *csi.CreateV
 		return response, err
 	}
 
+	quotaLimitInGBs := 0
+	if storageClassParameters.enforceQuota {
+		quotaLimitInGBs, err = extractQuotaLimitInGBs(req.GetCapacityRange())
+		if err != nil {
+			log.With(zap.Error(err)).Error("invalid capacity range for file system quota")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+		log = log.With("quotaLimitInGBs", quotaLimitInGBs)
+	}
+
+	provisionMetric := metrics.FssAllProvision
+	srcSnapshotId := ""
+	srcFilesystemOcid := ""
+	volumeContentSource := req.GetVolumeContentSource()
+	if volumeContentSource != nil {
+		switch source := volumeContentSource.GetType().(type) {
+		case *csi.VolumeContentSource_Snapshot:
+			srcSnapshotId = source.Snapshot.GetSnapshotId()
+			log = log.With("volumeSourceType", "snapshot", "sourceSnapshotId", srcSnapshotId)
+			if err := validateSourceSnapshot(ctx, log, fssClient, srcSnapshotId, storageClassParameters.availabilityDomain); err != nil {
+				dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+				metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotRestore, time.Since(startTime).Seconds(), dimensionsMap)
+				return nil, err
+			}
+			provisionMetric = metrics.FSSSnapshotRestore
+		case *csi.VolumeContentSource_Volume:
+			srcVolumeId := source.Volume.GetVolumeId()
+			log = log.With("volumeSourceType", "pvc", "sourceVolumeId", srcVolumeId)
+			srcFilesystemOcid, err = validateSourceVolume(ctx, log, fssClient, srcVolumeId, storageClassParameters.availabilityDomain)
+			if err != nil {
+				dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+				metrics.SendMetricData(d.metricPusher, metrics.FSSClone, time.Since(startTime).Seconds(), dimensionsMap)
+				return nil, err
+			}
+			provisionMetric = metrics.FSSClone
+		default:
+			log.Error("Unsupported volumeContentSource")
+			return nil, status.Error(codes.InvalidArgument, "Unsupported volumeContentSource")
+		}
+	}
+
 	log, mountTargetOCID, mountTargetIp, exportSetId, response, err, done := d.getOrCreateMountTarget(ctx, *storageClassParameters, volumeName, log, dimensionsMap, fssClient, networkingClient)
 
 	if done {
 		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
-		metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
 		return response, err
 	}
 
+	additionalMountTargets, err := d.getOrCreateAdditionalMountTargets(ctx, *storageClassParameters, volumeName, log, dimensionsMap, fssClient, networkingClient)
+	if err != nil {
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
 	isDeleteMountTarget := "true"
 
 	if storageClassParameters.mountTargetOcid != "" {
@@ -199,39 +297,245 @@ func (d *FSSControllerDriver) CreateVolume(ctx context.Context, req *csi.CreateV
 	freeformTags["isDeleteMountTarget"] = isDeleteMountTarget
 	freeformTags["mountTargetOCID"] = mountTargetOCID
 	freeformTags["exportSetId"] = exportSetId
+	if len(additionalMountTargets) > 0 {
+		var additionalMountTargetOCIDs, additionalExportSetIds []string
+		for _, mountTarget := range additionalMountTargets {
+			if mountTarget.isCreated {
+				additionalMountTargetOCIDs = append(additionalMountTargetOCIDs, mountTarget.id)
+			}
+			additionalExportSetIds = append(additionalExportSetIds, mountTarget.exportSetId)
+		}
+		freeformTags["additionalMountTargetOCIDs"] = strings.Join(additionalMountTargetOCIDs, ",")
+		freeformTags["additionalExportSetIds"] = strings.Join(additionalExportSetIds, ",")
+	}
 
-	log, filesystemOCID, response, err, done := d.getOrCreateFileSystem(ctx, *storageClassParameters, volumeName, log, dimensionsMap, fssClient)
+	if srcFilesystemOcid != "" {
+		// A volume is cloned from a snapshot of the source file system taken
+		// for this volume only. It is named after the volume so that a retried
+		// request finds the snapshot instead of creating another one.
+		srcSnapshotId, err = getOrCreateCloneSourceSnapshot(ctx, log, fssClient, srcFilesystemOcid, volumeName)
+		if err != nil {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+		log = log.With("sourceSnapshotId", srcSnapshotId)
+	}
+
+	log, filesystemOCID, response, err, done := d.getOrCreateFileSystem(ctx, *storageClassParameters, volumeName, srcSnapshotId, log, dimensionsMap, fssClient)
 	if done {
 		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
-		metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
 		return response, err
 	}
 
+	if srcFilesystemOcid != "" {
+		if _, err = fssClient.AwaitFileSystemHydrated(ctx, log, filesystemOCID); err != nil {
+			log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Await File System hydration failed with time out")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.DeadlineExceeded, "Await File System hydration failed with time out, error: %s", err.Error())
+		}
+	}
+
+	capacityBytes := int64(0)
+	if storageClassParameters.enforceQuota {
+		// The quota is set before the file system is exported so that it is
+		// never mounted without a limit.
+		quotaLimitInGBs, err = setFileSystemQuota(ctx, log, fssClient, filesystemOCID, volumeName, quotaLimitInGBs)
+		if err != nil {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to set quota of file system %s, error: %v", filesystemOCID, err)
+		}
+		capacityBytes = int64(quotaLimitInGBs) * client.GiB
+	}
+
 	log, response, err, done = d.getOrCreateExport(ctx, err, *storageClassParameters, filesystemOCID, exportSetId, log, dimensionsMap, fssClient)
 	if done {
 		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
-		metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
 		return response, err
 	}
 
+	for _, mountTarget := range additionalMountTargets {
+		_, response, err, done = d.getOrCreateExport(ctx, err, *storageClassParameters, filesystemOCID, mountTarget.exportSetId, log.With("additionalMountTargetId", mountTarget.id), dimensionsMap, fssClient)
+		if done {
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
+			return response, err
+		}
+	}
+
+	if srcFilesystemOcid != "" {
+		// The clone is detached from the source snapshot once it is hydrated,
+		// after which the snapshot is no longer needed.
+		if err = fssClient.DeleteSnapshot(ctx, srcSnapshotId); err != nil && !client.IsNotFound(err) {
+			log.With("service", "fss", "verb", "delete", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to delete clone source snapshot.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
+			if client.IsConflict(err) {
+				return nil, status.Errorf(codes.Unavailable, "clone source snapshot %s is still in use, file system %s is not detached yet", srcSnapshotId, filesystemOCID)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to delete clone source snapshot %s, error: %v", srcSnapshotId, err)
+		}
+	}
+
+	volumeContext := map[string]string{
+		"encryptInTransit": storageClassParameters.encryptInTransit,
+	}
+	if storageClassParameters.kerberosSecurity != "" {
+		volumeContext[kerberosSecurity] = storageClassParameters.kerberosSecurity
+	}
+	if len(additionalMountTargets) > 0 {
+		// Nodes mount the file system through the mount target in their own
+		// availability domain.
+		mountTargetIpsByAvailabilityDomain := map[string]string{
+			availabilityDomainShortName(storageClassParameters.availabilityDomain): mountTargetIp,
+		}
+		for _, mountTarget := range additionalMountTargets {
+			mountTargetIpsByAvailabilityDomain[availabilityDomainShortName(mountTarget.availabilityDomain)] = mountTarget.ip
+		}
+		mountTargetIpsJson, err := json.Marshal(mountTargetIpsByAvailabilityDomain)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode mount target IPs, error: %v", err)
+		}
+		volumeContext[mountTargetIps] = string(mountTargetIpsJson)
+	}
+
 	fssVolumeHandle := fmt.Sprintf("%s:%s:%s", filesystemOCID, csi_util.FormatValidIp(mountTargetIp), storageClassParameters.exportPath)
 	log.With("volumeID", fssVolumeHandle).Info("All FSS resource successfully created")
 	csiMetricDimension := util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
 	dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
 	dimensionsMap[metrics.ResourceOCIDDimension] = fssVolumeHandle
-	metrics.SendMetricData(d.metricPusher, metrics.FssAllProvision, time.Since(startTime).Seconds(), dimensionsMap)
+	metrics.SendMetricData(d.metricPusher, provisionMetric, time.Since(startTime).Seconds(), dimensionsMap)
 	return &csi.CreateVolumeResponse{
 		Volume: &csi.Volume{
 			VolumeId:      fssVolumeHandle,
-			CapacityBytes: 0,
+			CapacityBytes: capacityBytes,
 
-			VolumeContext: map[string]string{
-				"encryptInTransit": storageClassParameters.encryptInTransit,
-			},
+			VolumeContext: volumeContext,
+			ContentSource: volumeContentSource,
 		},
 	}, nil
 }
 
+// validateSourceSnapshot checks that the snapshot a volume is restored from can
+// be cloned into a file system in the given availability domain.
+func validateSourceSnapshot(ctx context.Context, log *zap.SugaredLogger, fssClient client.FileStorageInterface, snapshotId string, availabilityDomain string) error {
+	if snapshotId == "" {
+		log.Error("Error fetching snapshot from the volumeContentSource")
+		return status.Error(codes.InvalidArgument, "Error fetching snapshot from the volumeContentSource")
+	}
+
+	snapshot, err := fssClient.GetSnapshot(ctx, snapshotId)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get source snapshot.")
+		if client.IsNotFound(err) {
+			return status.Errorf(codes.NotFound, "Failed to get snapshot with ID %v", snapshotId)
+		}
+		return status.Errorf(codes.Internal, "Failed to fetch snapshot with ID %v with error %v", snapshotId, err)
+	}
+	if snapshot.LifecycleState != fss.SnapshotLifecycleStateActive {
+		log.With("lifecycleState", snapshot.LifecycleState).Error("Source snapshot is not active.")
+		return status.Errorf(codes.Unavailable, "snapshot %v is in lifecycle state %q", snapshotId, snapshot.LifecycleState)
+	}
+
+	srcFileSystem, err := fssClient.GetFileSystem(ctx, *snapshot.FileSystemId)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get file system of source snapshot.")
+		return status.Errorf(codes.Internal, "Failed to fetch file system %v of snapshot %v with error %v", *snapshot.FileSystemId, snapshotId, err)
+	}
+	// File systems can only be cloned within the availability domain of the source.
+	if srcFileSystem.AvailabilityDomain != nil && *srcFileSystem.AvailabilityDomain != availabilityDomain {
+		log.With("sourceAvailabilityDomain", *srcFileSystem.AvailabilityDomain).Error("Source snapshot is in another availability domain.")
+		return status.Errorf(codes.InvalidArgument, "snapshot %v is in availability domain %s, volume must be provisioned in the same availability domain but %s was requested",
+			snapshotId, *srcFileSystem.AvailabilityDomain, availabilityDomain)
+	}
+	return nil
+}
+
+// validateSourceVolume checks that the volume with the given ID can be cloned
+// into a file system in the given availability domain and returns the OCID of
+// its file system.
+func validateSourceVolume(ctx context.Context, log *zap.SugaredLogger, fssClient client.FileStorageInterface, volumeId string, availabilityDomain string) (string, error) {
+	filesystemOcid := csi_util.ValidateFssId(volumeId).FilesystemOcid
+	if filesystemOcid == "" {
+		log.Error("Invalid source volume ID in the volumeContentSource")
+		return "", status.Errorf(codes.InvalidArgument, "Invalid source volume ID %q in the volumeContentSource", volumeId)
+	}
+
+	srcFileSystem, err := fssClient.GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get source file system.")
+		if client.IsNotFound(err) {
+			return "", status.Errorf(codes.NotFound, "Failed to get source volume with ID %v", volumeId)
+		}
+		return "", status.Errorf(codes.Internal, "Failed to fetch source file system %v with error %v", filesystemOcid, err)
+	}
+	if srcFileSystem.LifecycleState != fss.FileSystemLifecycleStateActive {
+		log.With("lifecycleState", srcFileSystem.LifecycleState).Error("Source file system is not active.")
+		return "", status.Errorf(codes.Unavailable, "source file system %v is in lifecycle state %q", filesystemOcid, srcFileSystem.LifecycleState)
+	}
+	// File systems can only be cloned within the availability domain of the source.
+	if srcFileSystem.AvailabilityDomain != nil && *srcFileSystem.AvailabilityDomain != availabilityDomain {
+		log.With("sourceAvailabilityDomain", *srcFileSystem.AvailabilityDomain).Error("Source volume is in another availability domain.")
+		return "", status.Errorf(codes.InvalidArgument, "source volume %v is in availability domain %s, volume must be provisioned in the same availability domain but %s was requested",
+			volumeId, *srcFileSystem.AvailabilityDomain, availabilityDomain)
+	}
+	return filesystemOcid, nil
+}
+
+// getOrCreateCloneSourceSnapshot returns the ID of the active snapshot named
+// volumeName of the source file system, creating it if it does not exist yet.
+func getOrCreateCloneSourceSnapshot(ctx context.Context, log *zap.SugaredLogger, fssClient client.FileStorageInterface, srcFilesystemOcid string, volumeName string) (string, error) {
+	snapshots, err := fssClient.ListSnapshots(ctx, srcFilesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the clone source snapshot.")
+		if client.IsNotFound(err) {
+			return "", status.Errorf(codes.NotFound, "source file system %s not found", srcFilesystemOcid)
+		}
+		return "", status.Errorf(codes.Internal, "failed to check existence of clone source snapshot, error: %v", err)
+	}
+
+	snapshotId := ""
+	for _, snapshot := range snapshots {
+		if snapshot.Name != nil && *snapshot.Name == volumeName {
+			snapshotId = *snapshot.Id
+			break
+		}
+	}
+
+	if snapshotId != "" {
+		log.With("sourceSnapshotId", snapshotId).Info("Clone source snapshot already created, checking if lifecycleState is Active")
+	} else {
+		log.Info("Creating clone source snapshot")
+		snapshot, err := fssClient.CreateSnapshot(ctx, fss.CreateSnapshotDetails{
+			FileSystemId: &srcFilesystemOcid,
+			Name:         &volumeName,
+		})
+		if err != nil {
+			log.With("service", "fss", "verb", "create", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Could not create clone source snapshot.")
+			return "", status.Errorf(codes.Internal, "Could not create clone source snapshot, error: %v", err)
+		}
+		snapshotId = *snapshot.Id
+	}
+
+	if _, err = fssClient.AwaitSnapshotActive(ctx, log, snapshotId); err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Await clone source snapshot failed with time out")
+		return "", status.Errorf(codes.DeadlineExceeded, "Await clone source snapshot %s failed with time out, error: %s", snapshotId, err.Error())
+	}
+	return snapshotId, nil
+}
+
 func extractSecretParameters(log *zap.SugaredLogger, parameters map[string]string) *SecretParameters {
 
 	secretParameters := &SecretParameters{
@@ -265,10 +569,24 @@ func checkForSupportedVolumeCapabilities(volumeCaps []*csi.VolumeCapability) err
 			return err
 		}
 	}
+	return validateNFSMountOptions(volumeCaps)
+}
+
+// validateNFSMountOptions checks that the mount options of the volume
+// capabilities are supported by file storage, so that storage classes with
+// mount options which fail to mount are rejected before the volume is used.
+func validateNFSMountOptions(volumeCaps []*csi.VolumeCapability) error {
+	for _, c := range volumeCaps {
+		if mnt := c.GetMount(); mnt != nil {
+			if _, err := disk.ParseNFSMountOptions(mnt.MountFlags); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
-func (d *FSSControllerDriver) getOrCreateFileSystem(ctx context.Context, storageClassParameters StorageClassParameters, volumeName string, log *zap.SugaredLogger, dimensionsMap map[string]string, fssClient client.FileStorageInterface) (*zap.SugaredLogger, string, *csi.CreateVolumeResponse, error, bool) {
+func (d *FSSControllerDriver) getOrCreateFileSystem(ctx context.Context, storageClassParameters StorageClassParameters, volumeName string, srcSnapshotId string, log *zap.SugaredLogger, dimensionsMap map[string]string, fssClient client.FileStorageInterface) (*zap.SugaredLogger, string, *csi.CreateVolumeResponse, error, bool) {
 	startTimeFileSystem := time.Now()
 	//make sure this method is idempotent by checking existence of volume with same name.
 	log.Info("searching for existing filesystem")
@@ -308,7 +626,7 @@ func (d *FSSControllerDriver) getOrCreateFileSystem(ctx context.Context, storage
 
 	} else {
 		// Creating new file system
-		provisionedFileSystem, err = provisionFileSystem(ctx, log, d.client, volumeName, storageClassParameters, fssClient)
+		provisionedFileSystem, err = provisionFileSystem(ctx, log, d.client, volumeName, srcSnapshotId, storageClassParameters, fssClient)
 		if err != nil {
 			log.With("service", "fss", "verb", "create", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
 				With(zap.Error(err)).Error("New File System creation failed")
@@ -516,6 +834,65 @@ func (d *FSSControllerDriver) getOrCreateMountTarget(ctx context.Context, storag
 	return log, mountTargetOCID, mountTargetIp, exportSetId, nil, nil, false
 }
 
+// getOrCreateAdditionalMountTargets waits for the existing additional mount
+// targets of the storage class to be active, and creates a mount target in each
+// additional availability domain of the storage class, or finds the one created
+// by an earlier request. There is at most one mount target per availability
+// domain.
+func (d *FSSControllerDriver) getOrCreateAdditionalMountTargets(ctx context.Context, storageClassParameters StorageClassParameters, volumeName string, log *zap.SugaredLogger, dimensionsMap map[string]string, fssClient client.FileStorageInterface, networkingClient client.NetworkingInterface) ([]additionalMountTarget, error) {
+	var additionalMountTargets []additionalMountTarget
+	seen := map[string]bool{availabilityDomainShortName(storageClassParameters.availabilityDomain): true}
+
+	for _, mountTargetOcid := range storageClassParameters.additionalMountTargetOcids {
+		parameters := storageClassParameters
+		parameters.mountTargetOcid = mountTargetOcid
+		_, mountTargetOCID, mountTargetIp, exportSetId, _, err, done := d.getOrCreateMountTarget(ctx, parameters, volumeName, log.With("additionalMountTargetOcid", mountTargetOcid), dimensionsMap, fssClient, networkingClient)
+		if done {
+			return nil, err
+		}
+		mountTarget, err := fssClient.GetMountTarget(ctx, mountTargetOCID)
+		if err != nil {
+			log.With("service", "fss", "verb", "get", "resource", "mountTarget", "statusCode", util.GetHttpStatusCode(err)).
+				With("additionalMountTargetOcid", mountTargetOcid).With(zap.Error(err)).Error("Failed to get additional mount target.")
+			return nil, status.Errorf(codes.Internal, "failed to get mount target %s, error: %s", mountTargetOcid, err.Error())
+		}
+		availabilityDomain := availabilityDomainShortName(*mountTarget.AvailabilityDomain)
+		if seen[availabilityDomain] {
+			log.With("additionalMountTargetOcid", mountTargetOcid).Errorf("More than one mount target in availability domain %s", availabilityDomain)
+			return nil, status.Errorf(codes.InvalidArgument, "more than one mount target in availability domain %s, mount target %s", availabilityDomain, mountTargetOcid)
+		}
+		seen[availabilityDomain] = true
+		additionalMountTargets = append(additionalMountTargets, additionalMountTarget{
+			availabilityDomain: *mountTarget.AvailabilityDomain,
+			id:                 mountTargetOCID,
+			ip:                 mountTargetIp,
+			exportSetId:        exportSetId,
+		})
+	}
+
+	for _, availabilityDomain := range storageClassParameters.additionalMountTargetAvailabilityDomains {
+		if seen[availabilityDomainShortName(availabilityDomain)] {
+			log.Errorf("More than one mount target in availability domain %s", availabilityDomain)
+			return nil, status.Errorf(codes.InvalidArgument, "more than one mount target in availability domain %s", availabilityDomain)
+		}
+		seen[availabilityDomainShortName(availabilityDomain)] = true
+		parameters := storageClassParameters
+		parameters.availabilityDomain = availabilityDomain
+		_, mountTargetOCID, mountTargetIp, exportSetId, _, err, done := d.getOrCreateMountTarget(ctx, parameters, volumeName, log.With("additionalMountTargetAvailabilityDomain", availabilityDomain), dimensionsMap, fssClient, networkingClient)
+		if done {
+			return nil, err
+		}
+		additionalMountTargets = append(additionalMountTargets, additionalMountTarget{
+			availabilityDomain: availabilityDomain,
+			id:                 mountTargetOCID,
+			ip:                 mountTargetIp,
+			exportSetId:        exportSetId,
+			isCreated:          true,
+		})
+	}
+	return additionalMountTargets, nil
+}
+
 func (d *FSSControllerDriver) validateMountTargetSubnetWithClusterIpFamily(ctx context.Context, mountTargetSubnetId string, log *zap.SugaredLogger, networkingClient client.NetworkingInterface) error {
 
 	mountTargetSubnet, err := networkingClient.GetSubnet(ctx, mountTargetSubnetId)
@@ -677,10 +1054,59 @@ func extractStorageClassParameters(ctx context.Context, d *FSSControllerDriver,
 			log.With("nsgOcids", nsgOcids)
 			storageClassParameters.nsgOcids = nsgOcids
 		}
+
+		additionalAvailabilityDomainsStr, ok := parameters["additionalMountTargetAvailabilityDomains"]
+		if ok {
+			var additionalAvailabilityDomains []string
+			err := json.Unmarshal([]byte(additionalAvailabilityDomainsStr), &additionalAvailabilityDomains)
+			if err != nil {
+				log.Errorf("Failed to parse additionalMountTargetAvailabilityDomains provided in storage class. Please provide valid input.")
+				dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+				metrics.SendMetricData(d.metricPusher, metrics.MTProvision, time.Since(startTime).Seconds(), dimensionsMap)
+				return log, nil, nil, status.Errorf(codes.InvalidArgument, "Failed to parse additionalMountTargetAvailabilityDomains provided in storage class. Please provide valid input."), true
+			}
+			seen := map[string]bool{availabilityDomain: true}
+			for _, additionalAvailabilityDomain := range additionalAvailabilityDomains {
+				ad, err := resolveAvailabilityDomain(ctx, identityClient, compartmentId, additionalAvailabilityDomain)
+				if err == nil && seen[ad] {
+					err = status.Errorf(codes.InvalidArgument, "availability domain %s is listed more than once for mount targets", ad)
+				}
+				if err != nil {
+					log.With(zap.Error(err)).Errorf("invalid additional mount target availability domain: %s", additionalAvailabilityDomain)
+					dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+					metrics.SendMetricData(d.metricPusher, metrics.MTProvision, time.Since(startTime).Seconds(), dimensionsMap)
+					return log, nil, nil, err, true
+				}
+				seen[ad] = true
+				storageClassParameters.additionalMountTargetAvailabilityDomains = append(storageClassParameters.additionalMountTargetAvailabilityDomains, ad)
+			}
+			log = log.With("additionalMountTargetAvailabilityDomains", storageClassParameters.additionalMountTargetAvailabilityDomains)
+		}
 	} else {
 		storageClassParameters.mountTargetOcid = mountTargetOcid
 		log = log.With("mountTargetOcid", mountTargetOcid)
 		log.Info("Mount Target Ocid provided, new mount target will not be created")
+
+		if _, ok := parameters["additionalMountTargetAvailabilityDomains"]; ok {
+			log.Errorf("additionalMountTargetAvailabilityDomains provided in storage class without Mount Target Subnet Ocid")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.MTProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return log, nil, nil, status.Errorf(codes.InvalidArgument, "additionalMountTargetAvailabilityDomains requires mountTargetSubnetOcid in storage class"), true
+		}
+	}
+
+	additionalMountTargetOcidsStr, ok := parameters["additionalMountTargetOcids"]
+	if ok {
+		var additionalMountTargetOcids []string
+		err := json.Unmarshal([]byte(additionalMountTargetOcidsStr), &additionalMountTargetOcids)
+		if err != nil {
+			log.Errorf("Failed to parse additionalMountTargetOcids provided in storage class. Please provide valid input.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.MTProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return log, nil, nil, status.Errorf(codes.InvalidArgument, "Failed to parse additionalMountTargetOcids provided in storage class. Please provide valid input."), true
+		}
+		log = log.With("additionalMountTargetOcids", additionalMountTargetOcids)
+		storageClassParameters.additionalMountTargetOcids = additionalMountTargetOcids
 	}
 
 	exportPath, ok := parameters["exportPath"]
@@ -712,6 +1138,28 @@ func extractStorageClassParameters(ctx context.Context, d *FSSControllerDriver,
 		storageClassParameters.encryptInTransit = "true"
 	}
 
+	enforceQuotaStr, ok := parameters["enforceQuota"]
+	if ok {
+		enforceQuota, err := strconv.ParseBool(enforceQuotaStr)
+		if err != nil {
+			log.With(zap.Error(err)).Errorf("invalid enforceQuota provided in storage class: %s", enforceQuotaStr)
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FSSProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return log, nil, nil, status.Errorf(codes.InvalidArgument, "invalid enforceQuota provided in storage class: %s", enforceQuotaStr), true
+		}
+		storageClassParameters.enforceQuota = enforceQuota
+	}
+
+	if err := extractKerberosParameters(parameters, storageClassParameters); err != nil {
+		log.With(zap.Error(err)).Error("invalid Kerberos parameters provided in storage class")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return log, nil, nil, err, true
+	}
+	if storageClassParameters.kerberosSecurity != "" {
+		log = log.With(kerberosSecurity, storageClassParameters.kerberosSecurity)
+	}
+
 	kmsKey, ok := parameters["kmsKeyOcid"]
 	if !ok {
 		log.Info("kmsKeyOcid not provided, using oracle managed keys")
@@ -769,7 +1217,102 @@ func extractStorageClassParameters(ctx context.Context, d *FSSControllerDriver,
 	return log, nil, storageClassParameters, nil, false
 }
 
-func provisionFileSystem(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volumeName string, storageClassParameters StorageClassParameters, fssClient client.FileStorageInterface) (*fss.FileSystem, error) {
+// extractKerberosParameters sets the Kerberos security flavor the file system
+// is exported with, and the Kerberos and LDAP ID mapping configuration of new
+// mount targets. Existing mount targets must already be configured for
+// Kerberos.
+func extractKerberosParameters(parameters map[string]string, storageClassParameters *StorageClassParameters) error {
+	kerberosRealm, hasKerberosRealm := parameters["kerberosRealm"]
+	outboundConnectorOcid, hasLdapIdmap := parameters["ldapOutboundConnectorOcid"]
+	if (hasKerberosRealm || hasLdapIdmap) && storageClassParameters.mountTargetSubnetOcid == "" {
+		return status.Error(codes.InvalidArgument, "kerberosRealm and ldapOutboundConnectorOcid require mountTargetSubnetOcid in storage class")
+	}
+
+	if security, ok := parameters[kerberosSecurity]; ok {
+		allowedAuth, ok := kerberosAllowedAuth[security]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid kerberosSecurity %s provided in storage class, must be one of krb5, krb5i or krb5p", security)
+		}
+		if storageClassParameters.encryptInTransit == "true" {
+			return status.Error(codes.InvalidArgument, "kerberosSecurity can not be used with encryptInTransit")
+		}
+		if storageClassParameters.mountTargetSubnetOcid != "" && !hasKerberosRealm {
+			return status.Error(codes.InvalidArgument, "kerberosSecurity requires kerberosRealm in storage class when a mount target is created")
+		}
+		storageClassParameters.kerberosSecurity = security
+
+		// Exports allow AUTH_SYS unless told otherwise, so the flavor is
+		// added to every client option which does not set one.
+		if len(storageClassParameters.exportOptions) == 0 {
+			storageClassParameters.exportOptions = []fss.ClientOptions{{
+				Source:         common.String("0.0.0.0/0"),
+				Access:         fss.ClientOptionsAccessWrite,
+				IdentitySquash: fss.ClientOptionsIdentitySquashNone,
+			}}
+		}
+		for i := range storageClassParameters.exportOptions {
+			if len(storageClassParameters.exportOptions[i].AllowedAuth) == 0 {
+				storageClassParameters.exportOptions[i].AllowedAuth = []fss.ClientOptionsAllowedAuthEnum{allowedAuth}
+			}
+		}
+	}
+
+	if hasKerberosRealm {
+		keyTabSecretOcid, ok := parameters["kerberosKeyTabSecretOcid"]
+		if !ok {
+			return status.Error(codes.InvalidArgument, "kerberosRealm requires kerberosKeyTabSecretOcid in storage class")
+		}
+		storageClassParameters.kerberos = &fss.CreateKerberosDetails{
+			KerberosRealm:     &kerberosRealm,
+			KeyTabSecretId:    &keyTabSecretOcid,
+			IsKerberosEnabled: common.Bool(true),
+		}
+	}
+
+	if hasLdapIdmap {
+		schemaType := fss.CreateLdapIdmapDetailsSchemaTypeRfc2307
+		if schemaTypeStr, ok := parameters["ldapSchemaType"]; ok {
+			if schemaType, ok = fss.GetMappingCreateLdapIdmapDetailsSchemaTypeEnum(schemaTypeStr); !ok {
+				return status.Errorf(codes.InvalidArgument, "invalid ldapSchemaType %s provided in storage class, must be one of %s", schemaTypeStr, strings.Join(fss.GetCreateLdapIdmapDetailsSchemaTypeEnumStringValues(), ", "))
+			}
+		}
+		storageClassParameters.ldapIdmap = &fss.CreateLdapIdmapDetails{
+			SchemaType:           schemaType,
+			OutboundConnector1Id: &outboundConnectorOcid,
+		}
+		if userSearchBase, ok := parameters["ldapUserSearchBase"]; ok {
+			storageClassParameters.ldapIdmap.UserSearchBase = &userSearchBase
+		}
+		if groupSearchBase, ok := parameters["ldapGroupSearchBase"]; ok {
+			storageClassParameters.ldapIdmap.GroupSearchBase = &groupSearchBase
+		}
+	}
+	return nil
+}
+
+// resolveAvailabilityDomain returns the full name of an availability domain
+// given in a storage class.
+func resolveAvailabilityDomain(ctx context.Context, identityClient client.IdentityInterface, compartmentId string, availabilityDomain string) (string, error) {
+	if client.IsIpv6SingleStackCluster() {
+		if !strings.Contains(availabilityDomain, ":") {
+			return "", status.Errorf(codes.InvalidArgument, "Full AvailabilityDomain with prefix not provided in storage class for IPv6 single stack cluster.")
+		}
+		return availabilityDomain, nil
+	}
+	ad, err := identityClient.GetAvailabilityDomainByName(ctx, compartmentId, availabilityDomain)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid available domain: %s or compartment ID: %s, error: %s", availabilityDomain, compartmentId, err.Error())
+	}
+	return *ad.Name, nil
+}
+
+// availabilityDomainShortName returns the name of an availability domain
+// without its tenancy specific prefix, as found in the zone label of nodes.
+func availabilityDomainShortName(availabilityDomain string) string {
+	return availabilityDomain[strings.LastIndex(availabilityDomain, ":")+1:]
+}
+
+func provisionFileSystem(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volumeName string, srcSnapshotId string, storageClassParameters StorageClassParameters, fssClient client.FileStorageInterface) (*fss.FileSystem, error) {
 	log.Info("Creating new File System")
 	createFileSystemDetails := fss.CreateFileSystemDetails{
 		AvailabilityDomain: &storageClassParameters.availabilityDomain,
@@ -781,6 +1324,12 @@ func provisionFileSystem(ctx context.Context, log *zap.SugaredLogger, c client.I
 	if storageClassParameters.kmsKey != "" {
 		createFileSystemDetails.KmsKeyId = &storageClassParameters.kmsKey
 	}
+	if srcSnapshotId != "" {
+		log.With("sourceSnapshotId", srcSnapshotId).Info("Cloning File System from snapshot")
+		createFileSystemDetails.SourceSnapshotId = &srcSnapshotId
+		// Detach the clone once it is hydrated so the source snapshot can be deleted independently.
+		createFileSystemDetails.CloneAttachStatus = fss.CreateFileSystemDetailsCloneAttachStatusDetach
+	}
 	return fssClient.CreateFileSystem(ctx, createFileSystemDetails)
 }
 
@@ -794,6 +1343,11 @@ func provisionMountTarget(ctx context.Context, log *zap.SugaredLogger, c client.
 		FreeformTags:       storageClassParameters.scTags.FreeformTags,
 		DefinedTags:        storageClassParameters.scTags.DefinedTags,
 		NsgIds:             storageClassParameters.nsgOcids,
+		Kerberos:           storageClassParameters.kerberos,
+		LdapIdmap:          storageClassParameters.ldapIdmap,
+	}
+	if storageClassParameters.ldapIdmap != nil {
+		createMountTargetDetails.IdmapType = fss.MountTargetIdmapTypeLdap
 	}
 	return fssClient.CreateMountTarget(ctx, createMountTargetDetails)
 }
@@ -874,6 +1428,7 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 	mountTargetOCID := ""
 	exportSetId := ""
 	isDeleteMountTarget := false
+	var additionalMountTargetOCIDs, additionalExportSetIds []string
 
 	if freeformTags != nil {
 		for k, v := range freeformTags {
@@ -886,6 +1441,14 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 				}
 			case "exportSetId":
 				exportSetId = freeformTags["exportSetId"]
+			case "additionalMountTargetOCIDs":
+				if v != "" {
+					additionalMountTargetOCIDs = strings.Split(v, ",")
+				}
+			case "additionalExportSetIds":
+				if v != "" {
+					additionalExportSetIds = strings.Split(v, ",")
+				}
 			}
 		}
 	}
@@ -953,6 +1516,11 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 	} else {
 		log.Info("filesystem not tagged with exportSetId, skip deleting export")
 	}
+	if err = d.deleteAdditionalMountTargets(ctx, log, fssClient, filesystemOcid, exportPath, additionalExportSetIds, additionalMountTargetOCIDs, dimensionsMap); err != nil {
+		metrics.SendMetricData(d.metricPusher, metrics.FssAllDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
 	startTimeFileSystem := time.Now()
 	log.Info("deleting file system")
 	// last delete File System
@@ -977,6 +1545,49 @@ func (d *FSSControllerDriver) DeleteVolume(ctx context.Context, req *csi.DeleteV
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
+// deleteAdditionalMountTargets deletes the exports of a file system from the
+// export sets of its additional mount targets, then the additional mount
+// targets created for the file system.
+func (d *FSSControllerDriver) deleteAdditionalMountTargets(ctx context.Context, log *zap.SugaredLogger, fssClient client.FileStorageInterface, filesystemOcid, exportPath string, exportSetIds, mountTargetOCIDs []string, dimensionsMap map[string]string) error {
+	for _, exportSetId := range exportSetIds {
+		startTimeExport := time.Now()
+		exportSummary, err := fssClient.FindExport(ctx, filesystemOcid, exportPath, exportSetId)
+		if client.IsNotFound(err) {
+			log.With("additionalExportSetId", exportSetId).Info("Export does not exist")
+			continue
+		}
+		if err == nil {
+			err = fssClient.DeleteExport(ctx, *exportSummary.Id)
+		}
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "fss", "verb", "delete", "resource", "export", "statusCode", util.GetHttpStatusCode(err)).
+				With("additionalExportSetId", exportSetId).With(zap.Error(err)).Error("Failed to delete export.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.ExportDelete, time.Since(startTimeExport).Seconds(), dimensionsMap)
+			return status.Errorf(codes.Internal, "failed to delete export from export set %s, error: %s", exportSetId, err.Error())
+		}
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.ExportDelete, time.Since(startTimeExport).Seconds(), dimensionsMap)
+		log.With("additionalExportSetId", exportSetId).Info("Export is deleted.")
+	}
+
+	for _, mountTargetOCID := range mountTargetOCIDs {
+		startTimeMountTarget := time.Now()
+		err := fssClient.DeleteMountTarget(ctx, mountTargetOCID)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "fss", "verb", "delete", "resource", "mountTarget", "statusCode", util.GetHttpStatusCode(err)).
+				With("additionalMountTargetOCID", mountTargetOCID).With(zap.Error(err)).Error("Failed to delete mount target.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.MTDelete, time.Since(startTimeMountTarget).Seconds(), dimensionsMap)
+			return status.Errorf(codes.Internal, "failed to delete mount target, mountTargetOcid: %s, error: %s", mountTargetOCID, err.Error())
+		}
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.MTDelete, time.Since(startTimeMountTarget).Seconds(), dimensionsMap)
+		log.With("additionalMountTargetOCID", mountTargetOCID).Info("Mount Target is deleted.")
+	}
+	return nil
+}
+
 func (d *FSSControllerDriver) ControllerGetCapabilities(ctx context.Context, request *csi.ControllerGetCapabilitiesRequest) (*csi.ControllerGetCapabilitiesResponse, error) {
 	newCap := func(cap csi.ControllerServiceCapability_RPC_Type) *csi.ControllerServiceCapability {
 		return &csi.ControllerServiceCapability{
@@ -990,6 +1601,9 @@ func (d *FSSControllerDriver) ControllerGetCapabilities(ctx context.Context, req
 	var caps []*csi.ControllerServiceCapability
 	for _, capability := range []csi.ControllerServiceCapability_RPC_Type{
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
+		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 	} {
 		caps = append(caps, newCap(capability))
 	}
@@ -1027,6 +1641,13 @@ func (d *FSSControllerDriver) ValidateVolumeCapabilities(ctx context.Context, re
 		return nil, status.Error(codes.InvalidArgument, "Volume Capabilities must be provided")
 	}
 
+	// Mount options are validated first since a volume can not be mounted
+	// with them whatever the state of its resources.
+	if err := validateNFSMountOptions(req.VolumeCapabilities); err != nil {
+		log.With(zap.Error(err)).Info("Mount options of volume capability are not supported.")
+		return &csi.ValidateVolumeCapabilitiesResponse{Message: err.Error()}, nil
+	}
+
 	volumeHandler := csi_util.ValidateFssId(volumeId)
 	filesystemOcid, mountTargetIP, exportPath := volumeHandler.FilesystemOcid, volumeHandler.MountTargetIPAddress, volumeHandler.FsExportPath
 
@@ -1181,20 +1802,508 @@ func (d *FSSControllerDriver) GetCapacity(ctx context.Context, request *csi.GetC
 	return nil, status.Error(codes.Unimplemented, "")
 }
 
-func (d *FSSControllerDriver) CreateSnapshot(ctx context.Context, request *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// getFSSClient returns a file storage client using the workload identity passed in the secrets, if any.
+func (d *FSSControllerDriver) getFSSClient(ctx context.Context, log *zap.SugaredLogger, secrets map[string]string) (client.FileStorageInterface, error) {
+	var serviceAccountToken *authv1.TokenRequest
+
+	secretParameters := extractSecretParameters(log, secrets)
+	if secretParameters.serviceAccount != "" || secretParameters.serviceAccountNamespace != "" {
+		serviceAccountTokenCreated, err := d.getServiceAccountToken(ctx, secretParameters.serviceAccount, secretParameters.serviceAccountNamespace)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountToken = serviceAccountTokenCreated
+	}
+
+	ociClientConfig := &client.OCIClientConfig{SaToken: serviceAccountToken, ParentRptURL: secretParameters.parentRptURL, TenancyId: d.config.Auth.TenancyID}
+
+	fssClient := d.client.FSS(ociClientConfig)
+	if fssClient == nil {
+		return nil, status.Error(codes.Internal, "Unable to create fss client")
+	}
+	return fssClient, nil
 }
 
-func (d *FSSControllerDriver) DeleteSnapshot(ctx context.Context, request *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// CreateSnapshot creates a snapshot of the file system backing the source volume.
+// The function is idempotent as snapshot names are unique per file system.
+func (d *FSSControllerDriver) CreateSnapshot(ctx context.Context, req *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
+	startTime := time.Now()
+	log := d.logger.With("snapshotName", req.Name, "sourceVolumeId", req.SourceVolumeId, "csiOperation", "createSnapshot")
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.Name
+
+	if req.Name == "" {
+		log.Error("Volume Snapshot name must be provided.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "Volume snapshot name must be provided")
+	}
+
+	filesystemOcid := csi_util.ValidateFssId(req.SourceVolumeId).FilesystemOcid
+	if filesystemOcid == "" {
+		log.Error("Invalid volume snapshot source ID provided")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid volume snapshot source ID provided %s", req.SourceVolumeId)
+	}
+	log = log.With("fssID", filesystemOcid)
+
+	snapshotParams, err := extractFSSSnapshotParameters(req.GetParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
+	}
+
+	fssClient, err := d.getFSSClient(ctx, log, req.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := fssClient.ListSnapshots(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "source volume %s not found", req.SourceVolumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check existence of snapshot %v", err)
+	}
+
+	var snapshotId string
+	var timeCreated *common.SDKTime
+	for _, snapshot := range snapshots {
+		if snapshot.Name != nil && *snapshot.Name == req.Name {
+			snapshotId, timeCreated = *snapshot.Id, snapshot.TimeCreated
+			break
+		}
+	}
+
+	if snapshotId != "" {
+		log.Info("Snapshot already created, checking if lifecycleState is Active")
+	} else {
+		snapshot, err := fssClient.CreateSnapshot(ctx, fss.CreateSnapshotDetails{
+			FileSystemId: &filesystemOcid,
+			Name:         &req.Name,
+			FreeformTags: snapshotParams.freeformTags,
+			DefinedTags:  snapshotParams.definedTags,
+		})
+		if err != nil {
+			log.With("service", "fss", "verb", "create", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Could not create snapshot.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "Could not create snapshot %q: %v", req.Name, err)
+		}
+		snapshotId, timeCreated = *snapshot.Id, snapshot.TimeCreated
+	}
+	log = log.With("snapshotId", snapshotId)
+	dimensionsMap[metrics.ResourceOCIDDimension] = snapshotId
+
+	snapshotActiveTimeoutCtx, cancel := csi_util.ShortenContextBeforeDeadline(ctx, 10*time.Second)
+	defer cancel()
+
+	snapshot, err := fssClient.AwaitSnapshotActive(snapshotActiveTimeoutCtx, log, snapshotId)
+	if err != nil {
+		if !strings.Contains(err.Error(), "timed out") {
+			log.With("service", "fss", "verb", "get", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Snapshot did not become active.")
+			dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "Snapshot did not become active %q: %v", req.Name, err)
+		}
+		log.Info("Snapshot has not become active yet, controller will retry")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.BackupCreating, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return &csi.CreateSnapshotResponse{
+			Snapshot: fssSnapshotToCSISnapshot(snapshotId, req.SourceVolumeId, timeCreated, false),
+		}, nil
+	}
+
+	log.Info("Snapshot is created and active.")
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.CreateSnapshotResponse{
+		Snapshot: fssSnapshotToCSISnapshot(*snapshot.Id, req.SourceVolumeId, snapshot.TimeCreated, snapshot.LifecycleState == fss.SnapshotLifecycleStateActive),
+	}, nil
 }
 
-func (d *FSSControllerDriver) ListSnapshots(ctx context.Context, request *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// extractFSSSnapshotParameters parses the volumesnapshotclass parameters of a
+// file system snapshot. File system snapshots are neither full nor incremental
+// backups and can not be copied to another region, so only tags are supported.
+func extractFSSSnapshotParameters(parameters map[string]string) (SnapshotParameters, error) {
+	for k := range parameters {
+		if k != backupFreeformTags && k != backupDefinedTags {
+			return SnapshotParameters{}, status.Errorf(codes.InvalidArgument, "parameter %s is not supported for file system snapshots, "+
+				"supported parameters are %s and %s", k, backupFreeformTags, backupDefinedTags)
+		}
+	}
+	return extractSnapshotParameters(parameters)
 }
 
-func (d *FSSControllerDriver) ControllerExpandVolume(ctx context.Context, request *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// DeleteSnapshot deletes the file system snapshot with the given snapshot ID.
+func (d *FSSControllerDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
+	startTime := time.Now()
+	log := d.logger.With("snapshotId", req.SnapshotId, "csiOperation", "deleteSnapshot")
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.SnapshotId
+
+	if req.SnapshotId == "" {
+		log.Error("SnapshotId is empty")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Error(codes.InvalidArgument, "SnapshotId must be provided")
+	}
+
+	fssClient, err := d.getFSSClient(ctx, log, req.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	err = fssClient.DeleteSnapshot(ctx, req.SnapshotId)
+	if err != nil && !client.IsNotFound(err) {
+		log.With("service", "fss", "verb", "delete", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to delete snapshot.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsConflict(err) {
+			// Snapshots cannot be deleted while a clone restored from them is still attached.
+			return nil, status.Errorf(codes.FailedPrecondition, "snapshot %s is in use, error: %v", req.SnapshotId, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete snapshot, snapshotId: %s, error: %v", req.SnapshotId, err)
+	}
+
+	log.Info("Snapshot is deleted.")
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.FSSSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.DeleteSnapshotResponse{}, nil
+}
+
+// ListSnapshots returns the snapshot with the given snapshot ID or the snapshots
+// of the given source volume. Listing every snapshot is not supported as the
+// File Storage service only lists snapshots per file system.
+func (d *FSSControllerDriver) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
+	log := d.logger.With("snapshotId", req.SnapshotId, "sourceVolumeId", req.SourceVolumeId, "csiOperation", "listSnapshots")
+
+	if req.SnapshotId == "" && req.SourceVolumeId == "" {
+		return nil, status.Error(codes.Unimplemented, "ListSnapshots requires either snapshot_id or source_volume_id")
+	}
+
+	fssClient, err := d.getFSSClient(ctx, log, req.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	filesystemOcid := ""
+	if req.SourceVolumeId != "" {
+		filesystemOcid = csi_util.ValidateFssId(req.SourceVolumeId).FilesystemOcid
+		if filesystemOcid == "" {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+	}
+
+	if req.SnapshotId != "" {
+		snapshot, err := fssClient.GetSnapshot(ctx, req.SnapshotId)
+		if err != nil {
+			if client.IsNotFound(err) {
+				return &csi.ListSnapshotsResponse{}, nil
+			}
+			log.With("service", "fss", "verb", "get", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get snapshot.")
+			return nil, status.Errorf(codes.Internal, "failed to get snapshot %s: %v", req.SnapshotId, err)
+		}
+		if filesystemOcid != "" && *snapshot.FileSystemId != filesystemOcid {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		if snapshot.LifecycleState == fss.SnapshotLifecycleStateDeleting || snapshot.LifecycleState == fss.SnapshotLifecycleStateDeleted {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		sourceVolumeId := req.SourceVolumeId
+		if sourceVolumeId == "" {
+			sourceVolumeId, err = d.getFSSVolumeId(*snapshot.FileSystemId)
+			if err != nil {
+				log.With(zap.Error(err)).Error("Failed to find the source volume of the snapshot.")
+				return nil, status.Errorf(codes.Internal, "failed to find the source volume of snapshot %s: %v", req.SnapshotId, err)
+			}
+		}
+		return &csi.ListSnapshotsResponse{
+			Entries: []*csi.ListSnapshotsResponse_Entry{{
+				Snapshot: fssSnapshotToCSISnapshot(*snapshot.Id, sourceVolumeId, snapshot.TimeCreated, snapshot.LifecycleState == fss.SnapshotLifecycleStateActive),
+			}},
+		}, nil
+	}
+
+	snapshots, err := fssClient.ListSnapshots(ctx, filesystemOcid)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		log.With("service", "fss", "verb", "list", "resource", "snapshot", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list snapshots.")
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots of %s: %v", req.SourceVolumeId, err)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		ti, tj := snapshots[i].TimeCreated, snapshots[j].TimeCreated
+		if ti != nil && tj != nil && !ti.Equal(tj.Time) {
+			return ti.Before(tj.Time)
+		}
+		return *snapshots[i].Id < *snapshots[j].Id
+	})
+
+	start, end, nextToken, err := getPage(req.StartingToken, req.MaxEntries, len(snapshots))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*csi.ListSnapshotsResponse_Entry, 0, end-start)
+	for _, snapshot := range snapshots[start:end] {
+		entries = append(entries, &csi.ListSnapshotsResponse_Entry{
+			Snapshot: fssSnapshotToCSISnapshot(*snapshot.Id, req.SourceVolumeId, snapshot.TimeCreated, snapshot.LifecycleState == fss.SnapshotSummaryLifecycleStateActive),
+		})
+	}
+
+	log.With("snapshotCount", len(entries), "nextToken", nextToken).Info("Listed snapshots.")
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+// fssFileSystemIndex indexes the persistent volumes of the driver by the OCID
+// of their file system.
+const fssFileSystemIndex = "fssFileSystem"
+
+func fssFileSystemIndexFunc(obj interface{}) ([]string, error) {
+	pv, ok := obj.(*v1.PersistentVolume)
+	if !ok || pv.Spec.CSI == nil || pv.Spec.CSI.Driver != FSSDriverName {
+		return nil, nil
+	}
+	filesystemOcid := csi_util.ValidateFssId(pv.Spec.CSI.VolumeHandle).FilesystemOcid
+	if filesystemOcid == "" {
+		return nil, nil
+	}
+	return []string{filesystemOcid}, nil
+}
+
+// getFSSVolumeId returns the ID of the volume of a file system. The volume ID
+// holds the mount target IP and export path next to the file system OCID, so
+// it is read from the persistent volume of the file system. An empty ID is
+// returned when the file system has no persistent volume.
+func (d *FSSControllerDriver) getFSSVolumeId(filesystemOcid string) (string, error) {
+	if d.persistentVolumeIndexer == nil {
+		return "", nil
+	}
+	pvs, err := d.persistentVolumeIndexer.ByIndex(fssFileSystemIndex, filesystemOcid)
+	if err != nil || len(pvs) == 0 {
+		return "", err
+	}
+	return pvs[0].(*v1.PersistentVolume).Spec.CSI.VolumeHandle, nil
+}
+
+// fssSnapshotToCSISnapshot converts a file system snapshot to its CSI representation.
+// Snapshots share the blocks of their file system so no size is reported.
+func fssSnapshotToCSISnapshot(snapshotId string, sourceVolumeId string, timeCreated *common.SDKTime, readyToUse bool) *csi.Snapshot {
+	snapshot := &csi.Snapshot{
+		SnapshotId:     snapshotId,
+		SourceVolumeId: sourceVolumeId,
+		ReadyToUse:     readyToUse,
+	}
+	if timeCreated != nil {
+		snapshot.CreationTime = timestamppb.New(timeCreated.Time)
+	}
+	return snapshot
+}
+
+// ControllerExpandVolume raises the quota of the file system of a volume to the
+// requested capacity. File systems grow as needed, so expanding a volume
+// without a quota is a no-op.
+func (d *FSSControllerDriver) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
+	startTime := time.Now()
+	volumeId := req.GetVolumeId()
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "expandVolume")
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = volumeId
+
+	filesystemOcid := csi_util.ValidateFssId(volumeId).FilesystemOcid
+	if filesystemOcid == "" {
+		log.Error("Unable to parse Volume Id")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Volume ID provided %s", volumeId)
+	}
+	log = log.With("fssID", filesystemOcid)
+
+	quotaLimitInGBs, err := extractQuotaLimitInGBs(req.GetCapacityRange())
+	if err != nil {
+		log.With(zap.Error(err)).Error("invalid capacity range")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+	log = log.With("quotaLimitInGBs", quotaLimitInGBs)
+
+	fssClient, err := d.getFSSClient(ctx, log, req.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	// The driver names the file system and its quota rule after the volume, so
+	// only the quota rule created for the volume is raised. Quota rules of file
+	// systems provisioned without enforceQuota are managed by users.
+	fileSystem, err := fssClient.GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get file system.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "file system %s not found", filesystemOcid)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get file system %s, error: %v", filesystemOcid, err)
+	}
+	volumeName := ""
+	if fileSystem.DisplayName != nil {
+		volumeName = *fileSystem.DisplayName
+	}
+	quotaRule, _, err := getFileSystemQuotaRule(ctx, fssClient, filesystemOcid, volumeName)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list quota rules of file system.")
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "file system %s not found", filesystemOcid)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list quota rules of file system %s, error: %v", filesystemOcid, err)
+	}
+	if quotaRule == nil {
+		log.Info("Volume was provisioned without enforceQuota, no action needed.")
+		return &csi.ControllerExpandVolumeResponse{
+			CapacityBytes:         int64(quotaLimitInGBs) * client.GiB,
+			NodeExpansionRequired: false,
+		}, nil
+	}
+
+	quotaLimitInGBs, err = setFileSystemQuota(ctx, log, fssClient, filesystemOcid, volumeName, quotaLimitInGBs)
+	if err != nil {
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.Internal, "failed to raise quota of file system %s, error: %v", filesystemOcid, err)
+	}
+
+	log.Info("Volume is expanded.")
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.FSSExpand, time.Since(startTime).Seconds(), dimensionsMap)
+	return &csi.ControllerExpandVolumeResponse{
+		CapacityBytes:         int64(quotaLimitInGBs) * client.GiB,
+		NodeExpansionRequired: false,
+	}, nil
+}
+
+// extractQuotaLimitInGBs returns the file system quota for the capacity range
+// of a volume, rounded up to a whole GiB. Unlike block volumes, file systems
+// have no minimum size, so the capacity is not raised to one.
+func extractQuotaLimitInGBs(capRange *csi.CapacityRange) (int, error) {
+	requiredBytes := capRange.GetRequiredBytes()
+	limitBytes := capRange.GetLimitBytes()
+	if requiredBytes <= 0 && limitBytes <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "capacity range must be provided to enforce a file system quota")
+	}
+	if limitBytes > 0 && requiredBytes > limitBytes {
+		return 0, status.Errorf(codes.OutOfRange, "limit (%v) can not be less than required (%v) size", csi_util.FormatBytes(limitBytes), csi_util.FormatBytes(requiredBytes))
+	}
+	sizeBytes := requiredBytes
+	if sizeBytes <= 0 {
+		sizeBytes = limitBytes
+	}
+	return int(csi_util.RoundUpSize(sizeBytes, client.GiB)), nil
+}
+
+// getFileSystemQuotaRule returns the file system level quota rule the driver
+// created for a volume, which is named after the volume, or nil when it has
+// none. The file system level quota rule of another name, if any, is returned
+// separately.
+func getFileSystemQuotaRule(ctx context.Context, fssClient client.FileStorageInterface, filesystemOcid string, volumeName string) (*fss.QuotaRuleSummary, *fss.QuotaRuleSummary, error) {
+	quotaRules, err := fssClient.ListQuotaRules(ctx, filesystemOcid, fss.ListQuotaRulesPrincipalTypeFileSystemLevel)
+	if err != nil {
+		return nil, nil, err
+	}
+	var otherQuotaRule *fss.QuotaRuleSummary
+	for i := range quotaRules {
+		if volumeName != "" && quotaRules[i].DisplayName != nil && *quotaRules[i].DisplayName == volumeName {
+			return &quotaRules[i], nil, nil
+		}
+		otherQuotaRule = &quotaRules[i]
+	}
+	return nil, otherQuotaRule, nil
+}
+
+// setFileSystemQuota enforces a hard quota of at least quotaLimitInGBs on the
+// file system with a quota rule named after the volume, and returns the
+// resulting quota. The quota is never lowered, so retried requests and
+// expansions to a smaller size leave it unchanged. Quota rules the driver did
+// not create are never changed.
+func setFileSystemQuota(ctx context.Context, log *zap.SugaredLogger, fssClient client.FileStorageInterface, filesystemOcid string, volumeName string, quotaLimitInGBs int) (int, error) {
+	quotaRule, otherQuotaRule, err := getFileSystemQuotaRule(ctx, fssClient, filesystemOcid, volumeName)
+	if err != nil {
+		log.With("service", "fss", "verb", "list", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list quota rules of file system.")
+		return 0, err
+	}
+
+	switch {
+	case quotaRule == nil && otherQuotaRule != nil:
+		log.With("quotaRuleID", *otherQuotaRule.Id).Error("File system has a quota rule not created by the driver.")
+		return 0, fmt.Errorf("file system has quota rule %s, which was not created for volume %s", *otherQuotaRule.Id, volumeName)
+	case quotaRule == nil:
+		_, err = fssClient.CreateQuotaRule(ctx, filesystemOcid, fss.CreateQuotaRuleDetails{
+			PrincipalType:         fss.CreateQuotaRuleDetailsPrincipalTypeFileSystemLevel,
+			IsHardQuota:           common.Bool(true),
+			QuotaLimitInGigabytes: &quotaLimitInGBs,
+			DisplayName:           &volumeName,
+		})
+		if err != nil {
+			log.With("service", "fss", "verb", "create", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to create quota rule of file system.")
+			return 0, err
+		}
+		log.Info("File system quota rule is created.")
+	case quotaRule.QuotaLimitInGigabytes == nil || *quotaRule.QuotaLimitInGigabytes < quotaLimitInGBs:
+		_, err = fssClient.UpdateQuotaRule(ctx, filesystemOcid, *quotaRule.Id, fss.UpdateQuotaRuleDetails{
+			QuotaLimitInGigabytes: &quotaLimitInGBs,
+		})
+		if err != nil {
+			log.With("service", "fss", "verb", "update", "resource", "quotaRule", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to update quota rule of file system.")
+			return 0, err
+		}
+		log.Info("File system quota rule is raised.")
+	default:
+		quotaLimitInGBs = *quotaRule.QuotaLimitInGigabytes
+		log.With("existingQuotaLimitInGBs", quotaLimitInGBs).Info("File system quota is already large enough.")
+	}
+
+	fileSystem, err := fssClient.GetFileSystem(ctx, filesystemOcid)
+	if err != nil {
+		log.With("service", "fss", "verb", "get", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get file system.")
+		return 0, err
+	}
+	if fileSystem.AreQuotaRulesEnabled == nil || !*fileSystem.AreQuotaRulesEnabled {
+		if err = fssClient.ToggleQuotaRules(ctx, filesystemOcid, true); err != nil {
+			log.With("service", "fss", "verb", "update", "resource", "fileSystem", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to enable quota rules of file system.")
+			return 0, err
+		}
+		log.Info("File system quota rules are enabled.")
+	}
+	return quotaLimitInGBs, nil
 }
 
 func (d *FSSControllerDriver) ControllerGetVolume(ctx context.Context, request *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
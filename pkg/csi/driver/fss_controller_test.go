@@ -17,6 +17,7 @@ package driver
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
 	"strings"
@@ -37,12 +38,14 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	authv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 )
 
 type MockFileStorageClient struct {
@@ -64,8 +67,63 @@ var (
 			Id:                 common.String("private-ip-fetch-error"),
 			PrivateIpIds:       []string{"private-ip-fetch-error"},
 		},
+		"mount-target-phx-ad-2": {
+			DisplayName:        common.String("mount-target-phx-ad-2"),
+			LifecycleState:     fss.MountTargetLifecycleStateActive,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("mount-target-phx-ad-2"),
+			PrivateIpIds:       []string{"private-ip-phx-ad-2"},
+			ExportSetId:        common.String("export-set-phx-ad-2"),
+		},
+		"mount-target-ad1": {
+			DisplayName:        common.String("mount-target-ad1"),
+			LifecycleState:     fss.MountTargetLifecycleStateActive,
+			AvailabilityDomain: common.String("AD1"),
+			Id:                 common.String("mount-target-ad1"),
+			PrivateIpIds:       []string{"10.0.20.1"},
+			ExportSetId:        common.String("export-set-ad1"),
+		},
+	}
+
+	// exportSummaries are the exports found in an export set, by export set ID.
+	exportSummaries = map[string]*fss.ExportSummary{
+		"export-set-phx-ad-2": {
+			Id:             common.String("export-phx-ad-2"),
+			ExportSetId:    common.String("export-set-phx-ad-2"),
+			LifecycleState: fss.ExportSummaryLifecycleStateActive,
+		},
 	}
 
+	deletedExportIds      []string
+	deletedMountTargetIds []string
+
+	// quotaRules are the file system level quota rules, by file system ID.
+	quotaRules = map[string][]fss.QuotaRuleSummary{
+		"file-system-with-quota": {
+			{
+				Id:                    common.String("quota-rule-10g"),
+				FileSystemId:          common.String("file-system-with-quota"),
+				PrincipalType:         fss.QuotaRuleSummaryPrincipalTypeFileSystemLevel,
+				IsHardQuota:           common.Bool(true),
+				DisplayName:           common.String("file-system-with-quota"),
+				QuotaLimitInGigabytes: common.Int(10),
+			},
+		},
+		"file-system-with-user-quota": {
+			{
+				Id:                    common.String("quota-rule-user"),
+				FileSystemId:          common.String("file-system-with-user-quota"),
+				PrincipalType:         fss.QuotaRuleSummaryPrincipalTypeFileSystemLevel,
+				IsHardQuota:           common.Bool(true),
+				DisplayName:           common.String("user-quota"),
+				QuotaLimitInGigabytes: common.Int(10),
+			},
+		},
+	}
+
+	// quotaLimits records the quota set on a file system, by file system ID.
+	quotaLimits = map[string]int{}
+
 	fileSystems = map[string]*fss.FileSystem{
 		"file-system-stuck-creating": {
 			DisplayName:        common.String("file-system-stuck-creating"),
@@ -73,6 +131,50 @@ var (
 			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
 			Id:                 common.String("file-system-stuck-creating"),
 		},
+		"file-system-ad1": {
+			DisplayName:        common.String("file-system-ad1"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("AD1"),
+			Id:                 common.String("file-system-ad1"),
+		},
+		"clone-not-hydrated": {
+			DisplayName:        common.String("clone-not-hydrated"),
+			LifecycleState:     fss.FileSystemLifecycleStateActive,
+			AvailabilityDomain: common.String("AD1"),
+			Id:                 common.String("clone-not-hydrated"),
+			IsHydrated:         common.Bool(false),
+		},
+	}
+
+	fssSnapshots = map[string]*fss.Snapshot{
+		"oc1.snapshot.active": {
+			Id:             common.String("oc1.snapshot.active"),
+			FileSystemId:   common.String("oc1.filesystem.xxxx"),
+			Name:           common.String("snapshot-active"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000000, 0)},
+		},
+		"oc1.snapshot.creating": {
+			Id:             common.String("oc1.snapshot.creating"),
+			FileSystemId:   common.String("oc1.filesystem.xxxx"),
+			Name:           common.String("snapshot-creating"),
+			LifecycleState: fss.SnapshotLifecycleStateCreating,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000100, 0)},
+		},
+		"oc1.snapshot.restorable": {
+			Id:             common.String("oc1.snapshot.restorable"),
+			FileSystemId:   common.String("file-system-ad1"),
+			Name:           common.String("snapshot-restorable"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000200, 0)},
+		},
+		"oc1.snapshot.other-ad": {
+			Id:             common.String("oc1.snapshot.other-ad"),
+			FileSystemId:   common.String("file-system-stuck-creating"),
+			Name:           common.String("snapshot-other-ad"),
+			LifecycleState: fss.SnapshotLifecycleStateActive,
+			TimeCreated:    &common.SDKTime{Time: time.Unix(1700000200, 0)},
+		},
 	}
 
 	exports = map[string]*fss.Export{
@@ -80,6 +182,34 @@ var (
 			LifecycleState: fss.ExportLifecycleStateCreating,
 			Id:             common.String("export-stuck-creating"),
 		},
+		"/restored-volume": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/restored-volume"),
+		},
+		"/cloned-volume": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/cloned-volume"),
+		},
+		"/clone-source": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/clone-source"),
+		},
+		"/multi-ad-volume": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/multi-ad-volume"),
+		},
+		"export-phx-ad-2": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("export-phx-ad-2"),
+		},
+		"/quota-volume": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/quota-volume"),
+		},
+		"/kerberos-volume": {
+			LifecycleState: fss.ExportLifecycleStateActive,
+			Id:             common.String("/kerberos-volume"),
+		},
 	}
 )
 
@@ -155,6 +285,9 @@ func (c *MockFileStorageClient) GetFileSystem(ctx context.Context, id string) (*
 	if fileSystems[id] != nil {
 		return fileSystems[id], nil
 	}
+	if id == "file-system-not-found" {
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "file system not found"}
+	}
 	idFs := id
 	ad := "zkJl:US-ASHBURN-AD-1"
 	displayName := id
@@ -198,6 +331,22 @@ func (c *MockFileStorageClient) AwaitFileSystemActive(ctx context.Context, logge
 	}, nil
 }
 
+func (c *MockFileStorageClient) AwaitFileSystemHydrated(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.FileSystem, error) {
+	var fs *fss.FileSystem
+	err := wait.PollImmediateUntil(testPollInterval, func() (bool, error) {
+		var err error
+		fs, err = c.GetFileSystem(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return fs.IsHydrated == nil || *fs.IsHydrated, nil
+	}, ctx.Done())
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
 func (c *MockFileStorageClient) GetFileSystemSummaryByDisplayName(ctx context.Context, compartmentID, ad, displayName string) (bool, []filestorage.FileSystemSummary, error) {
 	if displayName == "file-system-idempotency-check-timeout-volume" {
 		var page *string
@@ -304,6 +453,9 @@ func (c *MockFileStorageClient) AwaitExportActive(ctx context.Context, logger *z
 }
 
 func (c *MockFileStorageClient) FindExport(ctx context.Context, fsID, path, exportSetID string) (*filestorage.ExportSummary, error) {
+	if exportSummaries[exportSetID] != nil {
+		return exportSummaries[exportSetID], nil
+	}
 	var page *string
 	var requestMetadata common.RequestMetadata
 	for {
@@ -341,6 +493,7 @@ func (c *MockFileStorageClient) FindExport(ctx context.Context, fsID, path, expo
 
 // DeleteExport mocks the FileStorage DeleteExport implementation
 func (c *MockFileStorageClient) DeleteExport(ctx context.Context, id string) error {
+	deletedExportIds = append(deletedExportIds, id)
 	return nil
 }
 
@@ -393,9 +546,125 @@ func (c *MockFileStorageClient) CreateMountTarget(ctx context.Context, details f
 
 // DeleteMountTarget mocks the FileStorage DeleteMountTarget implementation
 func (c *MockFileStorageClient) DeleteMountTarget(ctx context.Context, id string) error {
+	deletedMountTargetIds = append(deletedMountTargetIds, id)
+	return nil
+}
+
+// CreateSnapshot mocks the FileStorage CreateSnapshot implementation
+func (c *MockFileStorageClient) CreateSnapshot(ctx context.Context, details filestorage.CreateSnapshotDetails) (*filestorage.Snapshot, error) {
+	return &filestorage.Snapshot{
+		Id:             common.String("oc1.snapshot." + *details.Name),
+		FileSystemId:   details.FileSystemId,
+		Name:           details.Name,
+		LifecycleState: fss.SnapshotLifecycleStateCreating,
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000300, 0)},
+	}, nil
+}
+
+// GetSnapshot mocks the FileStorage GetSnapshot implementation
+func (c *MockFileStorageClient) GetSnapshot(ctx context.Context, id string) (*filestorage.Snapshot, error) {
+	if fssSnapshots[id] != nil {
+		return fssSnapshots[id], nil
+	}
+	if id == "oc1.snapshot.not-found" {
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "snapshot not found"}
+	}
+	return &filestorage.Snapshot{
+		Id:             common.String(id),
+		FileSystemId:   common.String("oc1.filesystem.xxxx"),
+		Name:           common.String(strings.TrimPrefix(id, "oc1.snapshot.")),
+		LifecycleState: fss.SnapshotLifecycleStateActive,
+		TimeCreated:    &common.SDKTime{Time: time.Unix(1700000300, 0)},
+	}, nil
+}
+
+// ListSnapshots mocks the FileStorage ListSnapshots implementation
+func (c *MockFileStorageClient) ListSnapshots(ctx context.Context, fileSystemID string) ([]filestorage.SnapshotSummary, error) {
+	if fileSystemID == "file-system-not-found" {
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "file system not found"}
+	}
+	var snapshots []filestorage.SnapshotSummary
+	for _, snapshot := range fssSnapshots {
+		if *snapshot.FileSystemId != fileSystemID {
+			continue
+		}
+		snapshots = append(snapshots, filestorage.SnapshotSummary{
+			Id:             snapshot.Id,
+			FileSystemId:   snapshot.FileSystemId,
+			Name:           snapshot.Name,
+			LifecycleState: filestorage.SnapshotSummaryLifecycleStateEnum(snapshot.LifecycleState),
+			TimeCreated:    snapshot.TimeCreated,
+		})
+	}
+	return snapshots, nil
+}
+
+func (c *MockFileStorageClient) AwaitSnapshotActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.Snapshot, error) {
+	var snapshot *fss.Snapshot
+	if err := wait.PollImmediateUntil(testPollInterval, func() (bool, error) {
+		var err error
+		snapshot, err = c.GetSnapshot(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		switch state := snapshot.LifecycleState; state {
+		case fss.SnapshotLifecycleStateActive:
+			return true, nil
+		case fss.SnapshotLifecycleStateDeleting, fss.SnapshotLifecycleStateDeleted:
+			return false, fmt.Errorf("snapshot %q is in lifecycle state %q", *snapshot.Id, state)
+		default:
+			return false, nil
+		}
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// DeleteSnapshot mocks the FileStorage DeleteSnapshot implementation
+func (c *MockFileStorageClient) DeleteSnapshot(ctx context.Context, id string) error {
+	switch id {
+	case "oc1.snapshot.not-found":
+		return mockNotFoundError{statusCode: http.StatusNotFound, message: "snapshot not found"}
+	case "oc1.snapshot.clone-source":
+		return mockNotFoundError{statusCode: http.StatusConflict, message: "snapshot is the source of an attached clone"}
+	}
+	return nil
+}
+
+// ToggleQuotaRules mocks the FileStorage ToggleQuotaRules implementation
+func (c *MockFileStorageClient) ToggleQuotaRules(ctx context.Context, fileSystemID string, enabled bool) error {
 	return nil
 }
 
+// ListQuotaRules mocks the FileStorage ListQuotaRules implementation
+func (c *MockFileStorageClient) ListQuotaRules(ctx context.Context, fileSystemID string, principalType filestorage.ListQuotaRulesPrincipalTypeEnum) ([]filestorage.QuotaRuleSummary, error) {
+	if fileSystemID == "file-system-not-found" {
+		return nil, mockNotFoundError{statusCode: http.StatusNotFound, message: "file system not found"}
+	}
+	return quotaRules[fileSystemID], nil
+}
+
+// CreateQuotaRule mocks the FileStorage CreateQuotaRule implementation
+func (c *MockFileStorageClient) CreateQuotaRule(ctx context.Context, fileSystemID string, details filestorage.CreateQuotaRuleDetails) (*filestorage.QuotaRule, error) {
+	quotaLimits[fileSystemID] = *details.QuotaLimitInGigabytes
+	return &filestorage.QuotaRule{
+		Id:                    common.String("quota-rule-" + fileSystemID),
+		FileSystemId:          &fileSystemID,
+		QuotaLimitInGigabytes: details.QuotaLimitInGigabytes,
+	}, nil
+}
+
+// UpdateQuotaRule mocks the FileStorage UpdateQuotaRule implementation
+func (c *MockFileStorageClient) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, details filestorage.UpdateQuotaRuleDetails) (*filestorage.QuotaRule, error) {
+	quotaLimits[fileSystemID] = *details.QuotaLimitInGigabytes
+	return &filestorage.QuotaRule{
+		Id:                    &quotaRuleID,
+		FileSystemId:          &fileSystemID,
+		QuotaLimitInGigabytes: details.QuotaLimitInGigabytes,
+	}, nil
+}
+
 // FSS mocks client FileStorage implementation
 func (p *MockProvisionerClient) FSS(ociClientConfig *client.OCIClientConfig) client.FileStorageInterface {
 	return &MockFileStorageClient{}
@@ -408,6 +677,9 @@ type MockFSSProvisionerClient struct {
 func (m MockFSSProvisionerClient) Lustre() client.LustreInterface {
 	return nil
 }
+func (m MockFSSProvisionerClient) Limits() client.LimitsInterface {
+	return nil
+}
 func (m MockFSSProvisionerClient) Compute() client.ComputeInterface {
 	return &MockComputeClient{}
 }
@@ -629,67 +901,519 @@ func TestFSSControllerDriver_CreateVolume(t *testing.T) {
 					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
 					VolumeCapabilities: []*csi.VolumeCapability{{
 						AccessMode: &csi.VolumeCapability_AccessMode{
-							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("await mount target to be available failed with time out"),
+		},
+		{
+			name:   "Time out due to file system stuck in creating state",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "file-system-stuck-creating",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("Await File System failed with time out"),
+		},
+		{
+			name:   "Timed out finding export",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "export-idempotency-check-timeout",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("failed to check existence of export"),
+		},
+		{
+			name:   "Time out due to export stuck in creating state",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "export-stuck-creating",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("await export failed with time out"),
+		},
+		{
+			name:   "Error for unsupported volume content source",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "restored-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("Unsupported volumeContentSource"),
+		},
+		{
+			name:   "Error when restoring from a snapshot that does not exist",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "restored-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "oc1.snapshot.not-found"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "Failed to get snapshot with ID oc1.snapshot.not-found"),
+		},
+		{
+			name:   "Error when restoring from a snapshot that is not active",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "restored-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "oc1.snapshot.creating"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.Unavailable, "snapshot oc1.snapshot.creating is in lifecycle state \"CREATING\""),
+		},
+		{
+			name:   "Error when restoring from a snapshot in another availability domain",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "restored-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "oc1.snapshot.other-ad"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("volume must be provisioned in the same availability domain"),
+		},
+		{
+			name:   "Restore volume from snapshot",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "restored-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "oc1.snapshot.restorable"},
+						},
+					},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId: "restored-volume:10.0.20.1:/restored-volume",
+					VolumeContext: map[string]string{
+						"encryptInTransit": "false",
+					},
+					ContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Snapshot{
+							Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: "oc1.snapshot.restorable"},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Create volume with mount targets in several availability domains",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name: "multi-ad-volume",
+					Parameters: map[string]string{
+						"availabilityDomain":         "US-ASHBURN-AD-1",
+						"mountTargetOcid":            "oc1.mounttarget.xxxx",
+						"additionalMountTargetOcids": `["mount-target-phx-ad-2"]`,
+					},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId: "multi-ad-volume:10.0.20.1:/multi-ad-volume",
+					VolumeContext: map[string]string{
+						"encryptInTransit": "false",
+						mountTargetIps:     `{"AD1":"10.0.20.1","PHX-AD-2":"10.0.30.1"}`,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Create volume with a file system quota",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name: "quota-volume",
+					Parameters: map[string]string{
+						"availabilityDomain": "US-ASHBURN-AD-1",
+						"mountTargetOcid":    "oc1.mounttarget.xxxx",
+						"enforceQuota":       "true",
+					},
+					CapacityRange: &csi.CapacityRange{RequiredBytes: 5*client.GiB + 1},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId:      "quota-volume:10.0.20.1:/quota-volume",
+					CapacityBytes: 6 * client.GiB,
+					VolumeContext: map[string]string{
+						"encryptInTransit": "false",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Create volume with Kerberos security",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name: "kerberos-volume",
+					Parameters: map[string]string{
+						"availabilityDomain": "US-ASHBURN-AD-1",
+						"mountTargetOcid":    "oc1.mounttarget.xxxx",
+						kerberosSecurity:     "krb5p",
+					},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId: "kerberos-volume:10.0.20.1:/kerberos-volume",
+					VolumeContext: map[string]string{
+						"encryptInTransit": "false",
+						kerberosSecurity:   "krb5p",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Error for unsupported mount options",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "volume-name",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessType: &csi.VolumeCapability_Mount{
+							Mount: &csi.VolumeCapability_MountVolume{MountFlags: []string{"nfsvers=4.1", "nolock"}},
+						},
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("mount option nolock requires nfsvers=3"),
+		},
+		{
+			name:   "Error for a file system quota without a capacity",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name: "quota-volume",
+					Parameters: map[string]string{
+						"availabilityDomain": "US-ASHBURN-AD-1",
+						"mountTargetOcid":    "oc1.mounttarget.xxxx",
+						"enforceQuota":       "true",
+					},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("capacity range must be provided to enforce a file system quota"),
+		},
+		{
+			name:   "Error for two mount targets in the availability domain of the file system",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name: "multi-ad-volume",
+					Parameters: map[string]string{
+						"availabilityDomain":         "US-ASHBURN-AD-1",
+						"mountTargetOcid":            "oc1.mounttarget.xxxx",
+						"additionalMountTargetOcids": `["mount-target-ad1"]`,
+					},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: errors.New("more than one mount target in availability domain AD1, mount target mount-target-ad1"),
+		},
+		{
+			name:   "Error when cloning a volume with an invalid volume ID",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "cloned-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "invalid-volume-id"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "Invalid source volume ID \"invalid-volume-id\" in the volumeContentSource"),
+		},
+		{
+			name:   "Error when cloning a volume that does not exist",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "cloned-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-not-found:10.0.20.1:/export-path"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "Failed to get source volume with ID file-system-not-found:10.0.20.1:/export-path"),
+		},
+		{
+			name:   "Error when cloning a volume that is not active",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "cloned-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+						},
+					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-stuck-creating:10.0.20.1:/export-path"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.Unavailable, "source file system file-system-stuck-creating is in lifecycle state \"CREATING\""),
+		},
+		{
+			name:   "Error when cloning a volume in another availability domain",
+			fields: fields{},
+			args: args{
+				ctx: context.Background(),
+				req: &csi.CreateVolumeRequest{
+					Name:       "cloned-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
+					VolumeCapabilities: []*csi.VolumeCapability{{
+						AccessMode: &csi.VolumeCapability_AccessMode{
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
 						},
 					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "oc1.filesystem.xxxx:10.0.20.1:/export-path"},
+						},
+					},
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("await mount target to be available failed with time out"),
+			wantErr: errors.New("volume must be provisioned in the same availability domain"),
 		},
 		{
-			name:   "Time out due to file system stuck in creating state",
+			name:   "Error when the cloned volume is not hydrated in time",
 			fields: fields{},
 			args: args{
 				ctx: context.Background(),
 				req: &csi.CreateVolumeRequest{
-					Name:       "file-system-stuck-creating",
-					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
+					Name:       "clone-not-hydrated",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
 					VolumeCapabilities: []*csi.VolumeCapability{{
 						AccessMode: &csi.VolumeCapability_AccessMode{
-							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
 						},
 					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.20.1:/file-system-ad1"},
+						},
+					},
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("Await File System failed with time out"),
+			wantErr: status.Error(codes.DeadlineExceeded, "Await File System hydration failed with time out"),
 		},
 		{
-			name:   "Timed out finding export",
+			name:   "Error when the clone source snapshot is still attached",
 			fields: fields{},
 			args: args{
 				ctx: context.Background(),
 				req: &csi.CreateVolumeRequest{
-					Name:       "export-idempotency-check-timeout",
-					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
+					Name:       "clone-source",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
 					VolumeCapabilities: []*csi.VolumeCapability{{
 						AccessMode: &csi.VolumeCapability_AccessMode{
-							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
 						},
 					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.20.1:/file-system-ad1"},
+						},
+					},
 				},
 			},
 			want:    nil,
-			wantErr: errors.New("failed to check existence of export"),
+			wantErr: status.Error(codes.Unavailable, "clone source snapshot oc1.snapshot.clone-source is still in use"),
 		},
 		{
-			name:   "Time out due to export stuck in creating state",
+			name:   "Clone volume from an existing volume",
 			fields: fields{},
 			args: args{
 				ctx: context.Background(),
 				req: &csi.CreateVolumeRequest{
-					Name:       "export-stuck-creating",
-					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetSubnetOcid": "oc1.subnet.xxxx"},
+					Name:       "cloned-volume",
+					Parameters: map[string]string{"availabilityDomain": "US-ASHBURN-AD-1", "mountTargetOcid": "oc1.mounttarget.xxxx"},
 					VolumeCapabilities: []*csi.VolumeCapability{{
 						AccessMode: &csi.VolumeCapability_AccessMode{
-							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
 						},
 					}},
+					VolumeContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.20.1:/file-system-ad1"},
+						},
+					},
 				},
 			},
-			want:    nil,
-			wantErr: errors.New("await export failed with time out"),
+			want: &csi.CreateVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId: "cloned-volume:10.0.20.1:/cloned-volume",
+					VolumeContext: map[string]string{
+						"encryptInTransit": "false",
+					},
+					ContentSource: &csi.VolumeContentSource{
+						Type: &csi.VolumeContentSource_Volume{
+							Volume: &csi.VolumeContentSource_VolumeSource{VolumeId: "file-system-ad1:10.0.20.1:/file-system-ad1"},
+						},
+					},
+				},
+			},
+			wantErr: nil,
 		},
 		{
 			name:   "Error for Creating incorrect Networking client",
@@ -886,6 +1610,26 @@ func TestFSSControllerDriver_DeleteVolume(t *testing.T) {
 	}
 }
 
+func TestFSSControllerDriver_deleteAdditionalMountTargets(t *testing.T) {
+	deletedExportIds = nil
+	deletedMountTargetIds = nil
+	d := &FSSControllerDriver{ControllerDriver: ControllerDriver{
+		logger: zap.S(),
+		config: &providercfg.Config{},
+	}}
+	err := d.deleteAdditionalMountTargets(context.Background(), zap.S(), &MockFileStorageClient{}, "oc1.filesystem.xxxx", "/export-path",
+		[]string{"export-set-phx-ad-2"}, []string{"mount-target-phx-ad-2"}, map[string]string{})
+	if err != nil {
+		t.Fatalf("deleteAdditionalMountTargets() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(deletedExportIds, []string{"export-phx-ad-2"}) {
+		t.Errorf("deleted exports = %v, want [export-phx-ad-2]", deletedExportIds)
+	}
+	if !reflect.DeepEqual(deletedMountTargetIds, []string{"mount-target-phx-ad-2"}) {
+		t.Errorf("deleted mount targets = %v, want [mount-target-phx-ad-2]", deletedMountTargetIds)
+	}
+}
+
 func TestExtractStorageClassParameters(t *testing.T) {
 	tests := map[string]struct {
 		parameters                     map[string]string
@@ -1069,6 +1813,103 @@ func TestExtractStorageClassParameters(t *testing.T) {
 			wantErr:         false,
 			wantErrMessage:  "",
 		},
+		"Extract storage class parameters with additional mount target availability domains": {
+			parameters: map[string]string{
+				"availabilityDomain":                       "NWuj:PHX-AD-1",
+				"mountTargetSubnetOcid":                    "oc1.subnet.xxxx",
+				"additionalMountTargetAvailabilityDomains": `["NWuj:PHX-AD-2", "NWuj:PHX-AD-3"]`,
+			},
+			expectedStorageClassParameters: &StorageClassParameters{
+				availabilityDomain:                       "NWuj:PHX-AD-1",
+				compartmentOcid:                          "oc1.compartment.xxxx",
+				exportPath:                               "/ut-volume",
+				mountTargetSubnetOcid:                    "oc1.subnet.xxxx",
+				encryptInTransit:                         "false",
+				scTags:                                   &config.TagConfig{},
+				additionalMountTargetAvailabilityDomains: []string{"NWuj:PHX-AD-2", "NWuj:PHX-AD-3"},
+			},
+			clusterIPFamily: "IPv6",
+			wantErr:         false,
+			wantErrMessage:  "",
+		},
+		"Error for an additional mount target availability domain of the file system": {
+			parameters: map[string]string{
+				"availabilityDomain":                       "AD1",
+				"mountTargetSubnetOcid":                    "oc1.subnet.xxxx",
+				"additionalMountTargetAvailabilityDomains": `["AD1"]`,
+			},
+			clusterIPFamily: "IPv4",
+			wantErr:         true,
+			wantErrMessage:  "availability domain AD1 is listed more than once for mount targets",
+		},
+		"Error for invalid additional mount target availability domains": {
+			parameters: map[string]string{
+				"availabilityDomain":                       "AD1",
+				"mountTargetSubnetOcid":                    "oc1.subnet.xxxx",
+				"additionalMountTargetAvailabilityDomains": "AD2,AD3",
+			},
+			clusterIPFamily: "IPv4",
+			wantErr:         true,
+			wantErrMessage:  "Failed to parse additionalMountTargetAvailabilityDomains provided in storage class",
+		},
+		"Error for additional mount target availability domains without mount target subnet": {
+			parameters: map[string]string{
+				"availabilityDomain":                       "AD1",
+				"mountTargetOcid":                          "oc1.mounttarget.xxxx",
+				"additionalMountTargetAvailabilityDomains": `["AD2"]`,
+			},
+			clusterIPFamily: "IPv4",
+			wantErr:         true,
+			wantErrMessage:  "additionalMountTargetAvailabilityDomains requires mountTargetSubnetOcid in storage class",
+		},
+		"Extract storage class parameters with additional mount targets": {
+			parameters: map[string]string{
+				"availabilityDomain":         "AD1",
+				"mountTargetOcid":            "oc1.mounttarget.xxxx",
+				"additionalMountTargetOcids": `["oc1.mounttarget.ad2", "oc1.mounttarget.ad3"]`,
+			},
+			expectedStorageClassParameters: &StorageClassParameters{
+				availabilityDomain:         "AD1",
+				compartmentOcid:            "oc1.compartment.xxxx",
+				exportPath:                 "/ut-volume",
+				mountTargetOcid:            "oc1.mounttarget.xxxx",
+				encryptInTransit:           "false",
+				scTags:                     &config.TagConfig{},
+				additionalMountTargetOcids: []string{"oc1.mounttarget.ad2", "oc1.mounttarget.ad3"},
+			},
+			clusterIPFamily: "IPv4",
+			wantErr:         false,
+			wantErrMessage:  "",
+		},
+		"Extract storage class parameters with quota enforced": {
+			parameters: map[string]string{
+				"availabilityDomain": "AD1",
+				"mountTargetOcid":    "oc1.mounttarget.xxxx",
+				"enforceQuota":       "true",
+			},
+			expectedStorageClassParameters: &StorageClassParameters{
+				availabilityDomain: "AD1",
+				compartmentOcid:    "oc1.compartment.xxxx",
+				exportPath:         "/ut-volume",
+				mountTargetOcid:    "oc1.mounttarget.xxxx",
+				encryptInTransit:   "false",
+				scTags:             &config.TagConfig{},
+				enforceQuota:       true,
+			},
+			clusterIPFamily: "IPv4",
+			wantErr:         false,
+			wantErrMessage:  "",
+		},
+		"Error for invalid enforceQuota": {
+			parameters: map[string]string{
+				"availabilityDomain": "AD1",
+				"mountTargetOcid":    "oc1.mounttarget.xxxx",
+				"enforceQuota":       "yes",
+			},
+			clusterIPFamily: "IPv4",
+			wantErr:         true,
+			wantErrMessage:  "invalid enforceQuota provided in storage class: yes",
+		},
 	}
 	ctx := context.Background()
 	for name, tt := range tests {
@@ -1101,7 +1942,10 @@ func isStorageClassParametersEqual(gotStorageClassParameters, expectedStorageCla
 		(gotStorageClassParameters.mountTargetOcid == expectedStorageClassParameters.mountTargetOcid) &&
 		(gotStorageClassParameters.compartmentOcid == expectedStorageClassParameters.compartmentOcid) &&
 		(gotStorageClassParameters.exportPath == expectedStorageClassParameters.exportPath) &&
-		(gotStorageClassParameters.kmsKey == expectedStorageClassParameters.kmsKey)
+		(gotStorageClassParameters.kmsKey == expectedStorageClassParameters.kmsKey) &&
+		reflect.DeepEqual(gotStorageClassParameters.additionalMountTargetAvailabilityDomains, expectedStorageClassParameters.additionalMountTargetAvailabilityDomains) &&
+		reflect.DeepEqual(gotStorageClassParameters.additionalMountTargetOcids, expectedStorageClassParameters.additionalMountTargetOcids) &&
+		(gotStorageClassParameters.enforceQuota == expectedStorageClassParameters.enforceQuota)
 }
 
 func Test_validateMountTargetWithClusterIpFamily(t *testing.T) {
@@ -1469,3 +2313,561 @@ func TestFSSControllerDriver_getServiceAccountToken(t *testing.T) {
 		})
 	}
 }
+
+type recordingFileStorageClient struct {
+	MockFileStorageClient
+	createFileSystemDetails *filestorage.CreateFileSystemDetails
+}
+
+func (c *recordingFileStorageClient) CreateFileSystem(ctx context.Context, details filestorage.CreateFileSystemDetails) (*filestorage.FileSystem, error) {
+	c.createFileSystemDetails = &details
+	return c.MockFileStorageClient.CreateFileSystem(ctx, details)
+}
+
+func TestProvisionFileSystem(t *testing.T) {
+	tests := map[string]struct {
+		srcSnapshotId         string
+		wantSourceSnapshotId  *string
+		wantCloneAttachStatus filestorage.CreateFileSystemDetailsCloneAttachStatusEnum
+	}{
+		"New file system": {},
+		"File system restored from snapshot": {
+			srcSnapshotId:         "oc1.snapshot.restorable",
+			wantSourceSnapshotId:  common.String("oc1.snapshot.restorable"),
+			wantCloneAttachStatus: filestorage.CreateFileSystemDetailsCloneAttachStatusDetach,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fssClient := &recordingFileStorageClient{}
+			storageClassParameters := StorageClassParameters{
+				availabilityDomain: "AD1",
+				compartmentOcid:    "oc1.comp.xxxx",
+				scTags:             &config.TagConfig{},
+			}
+			if _, err := provisionFileSystem(context.Background(), zap.S(), nil, "volume-name", tt.srcSnapshotId, storageClassParameters, fssClient); err != nil {
+				t.Fatalf("provisionFileSystem() unexpected error %v", err)
+			}
+			details := fssClient.createFileSystemDetails
+			if !reflect.DeepEqual(details.SourceSnapshotId, tt.wantSourceSnapshotId) {
+				t.Errorf("SourceSnapshotId = %v, want %v", details.SourceSnapshotId, tt.wantSourceSnapshotId)
+			}
+			if details.CloneAttachStatus != tt.wantCloneAttachStatus {
+				t.Errorf("CloneAttachStatus = %q, want %q", details.CloneAttachStatus, tt.wantCloneAttachStatus)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_CreateSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		req     *csi.CreateSnapshotRequest
+		want    *csi.Snapshot
+		wantErr codes.Code
+	}{
+		"Error for snapshot name not provided": {
+			req:     &csi.CreateSnapshotRequest{SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			wantErr: codes.InvalidArgument,
+		},
+		"Error for invalid source volume ID": {
+			req:     &csi.CreateSnapshotRequest{Name: "snapshot-new", SourceVolumeId: "oc1.filesystem.xxxx"},
+			wantErr: codes.InvalidArgument,
+		},
+		"Error for invalid volumesnapshotclass tags": {
+			req: &csi.CreateSnapshotRequest{
+				Name:           "snapshot-new",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				Parameters:     map[string]string{backupFreeformTags: "{"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		"Error for backup type": {
+			req: &csi.CreateSnapshotRequest{
+				Name:           "snapshot-new",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				Parameters:     map[string]string{backupType: backupTypeFull},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		"Error for backup copy region": {
+			req: &csi.CreateSnapshotRequest{
+				Name:           "snapshot-new",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				Parameters:     map[string]string{backupCopyRegion: "us-ashburn-1"},
+			},
+			wantErr: codes.InvalidArgument,
+		},
+		"Error for source file system not found": {
+			req:     &csi.CreateSnapshotRequest{Name: "snapshot-new", SourceVolumeId: "file-system-not-found:10.0.10.207:/export-path"},
+			wantErr: codes.NotFound,
+		},
+		"Existing snapshot is returned": {
+			req: &csi.CreateSnapshotRequest{Name: "snapshot-active", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			want: &csi.Snapshot{
+				SnapshotId:     "oc1.snapshot.active",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				CreationTime:   timestamppb.New(time.Unix(1700000000, 0)),
+				ReadyToUse:     true,
+			},
+		},
+		"Existing snapshot that is not active yet is not ready to use": {
+			req: &csi.CreateSnapshotRequest{Name: "snapshot-creating", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			want: &csi.Snapshot{
+				SnapshotId:     "oc1.snapshot.creating",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				CreationTime:   timestamppb.New(time.Unix(1700000100, 0)),
+				ReadyToUse:     false,
+			},
+		},
+		"New snapshot is created": {
+			req: &csi.CreateSnapshotRequest{Name: "snapshot-new", SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path"},
+			want: &csi.Snapshot{
+				SnapshotId:     "oc1.snapshot.snapshot-new",
+				SourceVolumeId: "oc1.filesystem.xxxx:10.0.10.207:/export-path",
+				CreationTime:   timestamppb.New(time.Unix(1700000300, 0)),
+				ReadyToUse:     true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+				defer cancel()
+				d := &FSSControllerDriver{ControllerDriver: ControllerDriver{
+					logger: zap.S(),
+					config: &providercfg.Config{},
+					client: NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				}}
+				got, err := d.CreateSnapshot(ctx, tt.req)
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("CreateSnapshot() error = %v, want code %v", err, tt.wantErr)
+				}
+				if tt.wantErr != codes.OK {
+					return
+				}
+				if !reflect.DeepEqual(got.Snapshot, tt.want) {
+					t.Errorf("CreateSnapshot() = %v, want %v", got.Snapshot, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestFSSControllerDriver_DeleteSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		snapshotId string
+		wantErr    codes.Code
+	}{
+		"Error for snapshot ID not provided": {
+			wantErr: codes.InvalidArgument,
+		},
+		"Snapshot is deleted": {
+			snapshotId: "oc1.snapshot.active",
+		},
+		"Snapshot already deleted": {
+			snapshotId: "oc1.snapshot.not-found",
+		},
+		"Error for snapshot in use by a clone": {
+			snapshotId: "oc1.snapshot.clone-source",
+			wantErr:    codes.FailedPrecondition,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver: ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{},
+				client: NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+			}}
+			_, err := d.DeleteSnapshot(context.Background(), &csi.DeleteSnapshotRequest{SnapshotId: tt.snapshotId})
+			if status.Code(err) != tt.wantErr {
+				t.Errorf("DeleteSnapshot() error = %v, want code %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_ListSnapshots(t *testing.T) {
+	const sourceVolumeId = "oc1.filesystem.xxxx:10.0.10.207:/export-path"
+	tests := map[string]struct {
+		req                *csi.ListSnapshotsRequest
+		wantIds            []string
+		wantSourceVolumeId string
+		wantNextToken      string
+		wantErr            codes.Code
+	}{
+		"Error when neither snapshot ID nor source volume ID is provided": {
+			req:     &csi.ListSnapshotsRequest{},
+			wantErr: codes.Unimplemented,
+		},
+		"Snapshot by ID": {
+			req:                &csi.ListSnapshotsRequest{SnapshotId: "oc1.snapshot.active"},
+			wantIds:            []string{"oc1.snapshot.active"},
+			wantSourceVolumeId: sourceVolumeId,
+		},
+		"Snapshot by ID of a file system without persistent volume": {
+			req:     &csi.ListSnapshotsRequest{SnapshotId: "oc1.snapshot.other-ad"},
+			wantIds: []string{"oc1.snapshot.other-ad"},
+		},
+		"Snapshot by ID and source volume": {
+			req:                &csi.ListSnapshotsRequest{SnapshotId: "oc1.snapshot.active", SourceVolumeId: sourceVolumeId},
+			wantIds:            []string{"oc1.snapshot.active"},
+			wantSourceVolumeId: sourceVolumeId,
+		},
+		"Snapshot by ID not found": {
+			req: &csi.ListSnapshotsRequest{SnapshotId: "oc1.snapshot.not-found"},
+		},
+		"Snapshot by ID of another source volume": {
+			req: &csi.ListSnapshotsRequest{SnapshotId: "oc1.snapshot.other-ad", SourceVolumeId: sourceVolumeId},
+		},
+		"Snapshots by source volume": {
+			req:                &csi.ListSnapshotsRequest{SourceVolumeId: sourceVolumeId},
+			wantIds:            []string{"oc1.snapshot.active", "oc1.snapshot.creating"},
+			wantSourceVolumeId: sourceVolumeId,
+		},
+		"First page of snapshots by source volume": {
+			req:                &csi.ListSnapshotsRequest{SourceVolumeId: sourceVolumeId, MaxEntries: 1},
+			wantIds:            []string{"oc1.snapshot.active"},
+			wantNextToken:      "1",
+			wantSourceVolumeId: sourceVolumeId,
+		},
+		"Last page of snapshots by source volume": {
+			req:                &csi.ListSnapshotsRequest{SourceVolumeId: sourceVolumeId, MaxEntries: 1, StartingToken: "1"},
+			wantIds:            []string{"oc1.snapshot.creating"},
+			wantSourceVolumeId: sourceVolumeId,
+		},
+		"Error for invalid starting token": {
+			req:     &csi.ListSnapshotsRequest{SourceVolumeId: sourceVolumeId, StartingToken: "x"},
+			wantErr: codes.Aborted,
+		},
+		"Snapshots of invalid source volume": {
+			req: &csi.ListSnapshotsRequest{SourceVolumeId: "oc1.filesystem.xxxx"},
+		},
+		"Snapshots of deleted source volume": {
+			req: &csi.ListSnapshotsRequest{SourceVolumeId: "file-system-not-found:10.0.10.207:/export-path"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			persistentVolumeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{fssFileSystemIndex: fssFileSystemIndexFunc})
+			for _, pv := range []*v1.PersistentVolume{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "bv-volume"},
+					Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{
+						CSI: &v1.CSIPersistentVolumeSource{Driver: BlockVolumeDriverName, VolumeHandle: "oc1.volume.xxxx"},
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fss-volume"},
+					Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{
+						CSI: &v1.CSIPersistentVolumeSource{Driver: FSSDriverName, VolumeHandle: sourceVolumeId},
+					}},
+				},
+			} {
+				if err := persistentVolumeIndexer.Add(pv); err != nil {
+					t.Fatal(err)
+				}
+			}
+			d := &FSSControllerDriver{
+				ControllerDriver: ControllerDriver{
+					logger: zap.S(),
+					config: &providercfg.Config{},
+					client: NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+				},
+				persistentVolumeIndexer: persistentVolumeIndexer,
+			}
+			got, err := d.ListSnapshots(context.Background(), tt.req)
+			if status.Code(err) != tt.wantErr {
+				t.Fatalf("ListSnapshots() error = %v, want code %v", err, tt.wantErr)
+			}
+			if tt.wantErr != codes.OK {
+				return
+			}
+			var gotIds []string
+			for _, entry := range got.Entries {
+				gotIds = append(gotIds, entry.Snapshot.SnapshotId)
+				if entry.Snapshot.SourceVolumeId != tt.wantSourceVolumeId {
+					t.Errorf("ListSnapshots() source volume ID = %q, want %q", entry.Snapshot.SourceVolumeId, tt.wantSourceVolumeId)
+				}
+			}
+			if !reflect.DeepEqual(gotIds, tt.wantIds) {
+				t.Errorf("ListSnapshots() snapshot IDs = %v, want %v", gotIds, tt.wantIds)
+			}
+			if got.NextToken != tt.wantNextToken {
+				t.Errorf("ListSnapshots() next token = %q, want %q", got.NextToken, tt.wantNextToken)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_ControllerExpandVolume(t *testing.T) {
+	tests := map[string]struct {
+		volumeId      string
+		capacityRange *csi.CapacityRange
+		want          *csi.ControllerExpandVolumeResponse
+		wantQuota     int
+		wantErr       codes.Code
+	}{
+		"Error for invalid volume ID": {
+			volumeId:      "invalid-volume-id",
+			capacityRange: &csi.CapacityRange{RequiredBytes: 20 * client.GiB},
+			wantErr:       codes.InvalidArgument,
+		},
+		"Error for capacity range not provided": {
+			volumeId: "file-system-with-quota:10.0.20.1:/export-path",
+			wantErr:  codes.InvalidArgument,
+		},
+		"Error for file system not found": {
+			volumeId:      "file-system-not-found:10.0.20.1:/export-path",
+			capacityRange: &csi.CapacityRange{RequiredBytes: 20 * client.GiB},
+			wantErr:       codes.NotFound,
+		},
+		"Quota is raised": {
+			volumeId:      "file-system-with-quota:10.0.20.1:/export-path",
+			capacityRange: &csi.CapacityRange{RequiredBytes: 20 * client.GiB},
+			want:          &csi.ControllerExpandVolumeResponse{CapacityBytes: 20 * client.GiB},
+			wantQuota:     20,
+		},
+		"Quota is not lowered": {
+			volumeId:      "file-system-with-quota:10.0.20.1:/export-path",
+			capacityRange: &csi.CapacityRange{RequiredBytes: 5 * client.GiB},
+			want:          &csi.ControllerExpandVolumeResponse{CapacityBytes: 10 * client.GiB},
+		},
+		"Quota rule not created by the driver is left unchanged": {
+			volumeId:      "file-system-with-user-quota:10.0.20.1:/export-path",
+			capacityRange: &csi.CapacityRange{RequiredBytes: 20 * client.GiB},
+			want:          &csi.ControllerExpandVolumeResponse{CapacityBytes: 20 * client.GiB},
+		},
+		"Volume without quota is expanded without changes": {
+			volumeId:      "oc1.filesystem.xxxx:10.0.20.1:/export-path",
+			capacityRange: &csi.CapacityRange{RequiredBytes: 20 * client.GiB},
+			want:          &csi.ControllerExpandVolumeResponse{CapacityBytes: 20 * client.GiB},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			quotaLimits = map[string]int{}
+			d := &FSSControllerDriver{ControllerDriver: ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{},
+				client: NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+			}}
+			got, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{
+				VolumeId:      tt.volumeId,
+				CapacityRange: tt.capacityRange,
+			})
+			if status.Code(err) != tt.wantErr {
+				t.Fatalf("ControllerExpandVolume() error = %v, want code %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerExpandVolume() = %v, want %v", got, tt.want)
+			}
+			filesystemOcid := csi_util.ValidateFssId(tt.volumeId).FilesystemOcid
+			if quotaLimits[filesystemOcid] != tt.wantQuota {
+				t.Errorf("ControllerExpandVolume() set quota %d, want %d", quotaLimits[filesystemOcid], tt.wantQuota)
+			}
+		})
+	}
+}
+
+func Test_setFileSystemQuota(t *testing.T) {
+	tests := map[string]struct {
+		filesystemOcid  string
+		volumeName      string
+		quotaLimitInGBs int
+		want            int
+		wantQuota       int
+		wantErr         bool
+	}{
+		"Quota rule is created": {
+			filesystemOcid:  "oc1.filesystem.xxxx",
+			volumeName:      "volume-name",
+			quotaLimitInGBs: 20,
+			want:            20,
+			wantQuota:       20,
+		},
+		"Quota rule of the volume is raised": {
+			filesystemOcid:  "file-system-with-quota",
+			volumeName:      "file-system-with-quota",
+			quotaLimitInGBs: 20,
+			want:            20,
+			wantQuota:       20,
+		},
+		"Error for a quota rule not created for the volume": {
+			filesystemOcid:  "file-system-with-user-quota",
+			volumeName:      "volume-name",
+			quotaLimitInGBs: 20,
+			wantErr:         true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			quotaLimits = map[string]int{}
+			got, err := setFileSystemQuota(context.Background(), zap.S(), &MockFileStorageClient{}, tt.filesystemOcid, tt.volumeName, tt.quotaLimitInGBs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setFileSystemQuota() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("setFileSystemQuota() = %d, want %d", got, tt.want)
+			}
+			if quotaLimits[tt.filesystemOcid] != tt.wantQuota {
+				t.Errorf("setFileSystemQuota() set quota %d, want %d", quotaLimits[tt.filesystemOcid], tt.wantQuota)
+			}
+		})
+	}
+}
+
+func Test_extractKerberosParameters(t *testing.T) {
+	tests := map[string]struct {
+		parameters             map[string]string
+		storageClassParameters StorageClassParameters
+		want                   StorageClassParameters
+		wantErrMessage         string
+	}{
+		"No Kerberos parameters": {
+			parameters:             map[string]string{},
+			storageClassParameters: StorageClassParameters{mountTargetOcid: "oc1.mounttarget.xxxx"},
+			want:                   StorageClassParameters{mountTargetOcid: "oc1.mounttarget.xxxx"},
+		},
+		"Kerberos security with an existing mount target": {
+			parameters:             map[string]string{kerberosSecurity: "krb5p"},
+			storageClassParameters: StorageClassParameters{mountTargetOcid: "oc1.mounttarget.xxxx"},
+			want: StorageClassParameters{
+				mountTargetOcid:  "oc1.mounttarget.xxxx",
+				kerberosSecurity: "krb5p",
+				exportOptions: []fss.ClientOptions{{
+					Source:         common.String("0.0.0.0/0"),
+					Access:         fss.ClientOptionsAccessWrite,
+					IdentitySquash: fss.ClientOptionsIdentitySquashNone,
+					AllowedAuth:    []fss.ClientOptionsAllowedAuthEnum{fss.ClientOptionsAllowedAuthKrb5p},
+				}},
+			},
+		},
+		"Kerberos security keeps allowed authentication of export options": {
+			parameters: map[string]string{kerberosSecurity: "krb5"},
+			storageClassParameters: StorageClassParameters{
+				mountTargetOcid: "oc1.mounttarget.xxxx",
+				exportOptions: []fss.ClientOptions{
+					{Source: common.String("10.0.0.0/16")},
+					{Source: common.String("10.1.0.0/16"), AllowedAuth: []fss.ClientOptionsAllowedAuthEnum{fss.ClientOptionsAllowedAuthKrb5i}},
+				},
+			},
+			want: StorageClassParameters{
+				mountTargetOcid:  "oc1.mounttarget.xxxx",
+				kerberosSecurity: "krb5",
+				exportOptions: []fss.ClientOptions{
+					{Source: common.String("10.0.0.0/16"), AllowedAuth: []fss.ClientOptionsAllowedAuthEnum{fss.ClientOptionsAllowedAuthKrb5}},
+					{Source: common.String("10.1.0.0/16"), AllowedAuth: []fss.ClientOptionsAllowedAuthEnum{fss.ClientOptionsAllowedAuthKrb5i}},
+				},
+			},
+		},
+		"Kerberos and LDAP ID mapping for a new mount target": {
+			parameters: map[string]string{
+				kerberosSecurity:            "krb5i",
+				"kerberosRealm":             "EXAMPLE.COM",
+				"kerberosKeyTabSecretOcid":  "oc1.vaultsecret.xxxx",
+				"ldapOutboundConnectorOcid": "oc1.outboundconnector.xxxx",
+				"ldapSchemaType":            "RFC2307BIS",
+				"ldapUserSearchBase":        "ou=people,dc=example,dc=com",
+			},
+			storageClassParameters: StorageClassParameters{
+				mountTargetSubnetOcid: "oc1.subnet.xxxx",
+				exportOptions:         []fss.ClientOptions{{Source: common.String("10.0.0.0/16")}},
+			},
+			want: StorageClassParameters{
+				mountTargetSubnetOcid: "oc1.subnet.xxxx",
+				kerberosSecurity:      "krb5i",
+				exportOptions: []fss.ClientOptions{
+					{Source: common.String("10.0.0.0/16"), AllowedAuth: []fss.ClientOptionsAllowedAuthEnum{fss.ClientOptionsAllowedAuthKrb5i}},
+				},
+				kerberos: &fss.CreateKerberosDetails{
+					KerberosRealm:     common.String("EXAMPLE.COM"),
+					KeyTabSecretId:    common.String("oc1.vaultsecret.xxxx"),
+					IsKerberosEnabled: common.Bool(true),
+				},
+				ldapIdmap: &fss.CreateLdapIdmapDetails{
+					SchemaType:           fss.CreateLdapIdmapDetailsSchemaTypeRfc2307bis,
+					OutboundConnector1Id: common.String("oc1.outboundconnector.xxxx"),
+					UserSearchBase:       common.String("ou=people,dc=example,dc=com"),
+				},
+			},
+		},
+		"Error for invalid Kerberos security": {
+			parameters:             map[string]string{kerberosSecurity: "sys"},
+			storageClassParameters: StorageClassParameters{mountTargetOcid: "oc1.mounttarget.xxxx"},
+			wantErrMessage:         "invalid kerberosSecurity sys provided in storage class",
+		},
+		"Error for Kerberos security with in-transit encryption": {
+			parameters:             map[string]string{kerberosSecurity: "krb5p"},
+			storageClassParameters: StorageClassParameters{mountTargetOcid: "oc1.mounttarget.xxxx", encryptInTransit: "true"},
+			wantErrMessage:         "kerberosSecurity can not be used with encryptInTransit",
+		},
+		"Error for Kerberos security without realm for a new mount target": {
+			parameters:             map[string]string{kerberosSecurity: "krb5p"},
+			storageClassParameters: StorageClassParameters{mountTargetSubnetOcid: "oc1.subnet.xxxx"},
+			wantErrMessage:         "kerberosSecurity requires kerberosRealm in storage class when a mount target is created",
+		},
+		"Error for Kerberos realm with an existing mount target": {
+			parameters:             map[string]string{"kerberosRealm": "EXAMPLE.COM", "kerberosKeyTabSecretOcid": "oc1.vaultsecret.xxxx"},
+			storageClassParameters: StorageClassParameters{mountTargetOcid: "oc1.mounttarget.xxxx"},
+			wantErrMessage:         "kerberosRealm and ldapOutboundConnectorOcid require mountTargetSubnetOcid in storage class",
+		},
+		"Error for Kerberos realm without keytab secret": {
+			parameters:             map[string]string{"kerberosRealm": "EXAMPLE.COM"},
+			storageClassParameters: StorageClassParameters{mountTargetSubnetOcid: "oc1.subnet.xxxx"},
+			wantErrMessage:         "kerberosRealm requires kerberosKeyTabSecretOcid in storage class",
+		},
+		"Error for invalid LDAP schema type": {
+			parameters:             map[string]string{"ldapOutboundConnectorOcid": "oc1.outboundconnector.xxxx", "ldapSchemaType": "AD"},
+			storageClassParameters: StorageClassParameters{mountTargetSubnetOcid: "oc1.subnet.xxxx"},
+			wantErrMessage:         "invalid ldapSchemaType AD provided in storage class",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.storageClassParameters
+			err := extractKerberosParameters(tt.parameters, &got)
+			if tt.wantErrMessage != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrMessage) {
+					t.Fatalf("extractKerberosParameters() error = %v, want %q", err, tt.wantErrMessage)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("extractKerberosParameters() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extractKerberosParameters() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_ValidateVolumeCapabilities_MountOptions(t *testing.T) {
+	d := &FSSControllerDriver{ControllerDriver: ControllerDriver{
+		logger: zap.S(),
+		config: &providercfg.Config{},
+		client: NewClientProvisioner(nil, nil, &MockFileStorageClient{}),
+	}}
+	got, err := d.ValidateVolumeCapabilities(context.Background(), &csi.ValidateVolumeCapabilitiesRequest{
+		VolumeId: "oc1.filesystem.xxxx:10.0.20.1:/export-path",
+		VolumeCapabilities: []*csi.VolumeCapability{{
+			AccessType: &csi.VolumeCapability_Mount{
+				Mount: &csi.VolumeCapability_MountVolume{MountFlags: []string{"nfsvers=4.1", "hard", "soft"}},
+			},
+			AccessMode: &csi.VolumeCapability_AccessMode{
+				Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ValidateVolumeCapabilities() unexpected error: %v", err)
+	}
+	if got.Confirmed != nil {
+		t.Errorf("ValidateVolumeCapabilities() confirmed capabilities with unsupported mount options")
+	}
+	if got.Message != "mount options hard and soft can not be used together" {
+		t.Errorf("ValidateVolumeCapabilities() message = %q, want %q", got.Message, "mount options hard and soft can not be used together")
+	}
+}
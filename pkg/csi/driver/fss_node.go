@@ -15,12 +15,18 @@
 package driver
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -39,8 +45,18 @@ const (
 	FipsEnabled                = "1"
 	fssMountSemaphoreTimeout   = time.Second * 30
 	fssUnmountSemaphoreTimeout = time.Second * 30
+
+	// kerberosKeytabSecretKey is the node stage secret key of the keytab
+	// the node authenticates to Kerberos mount targets with.
+	kerberosKeytabSecretKey = "krb5.keytab"
 )
 
+// kerberosKeytabPath is the keytab the node plugin merges the keytabs of the
+// node stage secrets into. It is owned by the driver so the keytab of the host
+// is never replaced, rpc.gssd must be configured to read machine credentials
+// from it.
+var kerberosKeytabPath = "/host/etc/krb5.keytab.d/oci-fss-csi.keytab"
+
 var fssMountSemaphore = semaphore.NewWeighted(int64(2))
 var fssUnmountSemaphore = semaphore.NewWeighted(int64(4))
 
@@ -69,6 +85,8 @@ func (d FSSNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVo
 		d.util.LoadNodeMetadataFromApiServer(ctx, d.KubeClient, d.nodeID, d.nodeMetadata)
 	}
 
+	mountTargetIP = localMountTargetIP(logger, req.VolumeContext, d.nodeMetadata.AvailabilityDomain, mountTargetIP)
+
 	if csi_util.IsIpv4(mountTargetIP) && !d.nodeMetadata.Ipv4Enabled {
 		return nil, status.Error(codes.InvalidArgument, "Ipv4 mount target identified in volume id, but worker node does not support ipv4 ip family.")
 	} else if csi_util.IsIpv6(mountTargetIP) && !d.nodeMetadata.Ipv6Enabled {
@@ -94,6 +112,41 @@ func (d FSSNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVo
 		return nil, status.Errorf(codes.InvalidArgument, "EncryptInTransit must be a boolean value")
 	}
 
+	securityFlavor, err := kerberosSecurityFlavor(req.VolumeContext)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if securityFlavor != "" {
+		if encryptInTransit {
+			return nil, status.Error(codes.InvalidArgument, "Kerberos security can not be used with in-transit encryption")
+		}
+		options, err = withNFSSecurityOption(options, securityFlavor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err = writeKerberosKeytab(logger, req.GetSecrets()); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to write Kerberos keytab.")
+			return nil, status.Errorf(codes.Internal, "Failed to write Kerberos keytab: %v", err)
+		}
+		logger.With(kerberosSecurity, securityFlavor).Debug("Kerberos security enabled")
+	}
+
+	// Mount options are rejected by CreateVolume and ValidateVolumeCapabilities.
+	// Volumes provisioned before, or with options the kernel of the node may not
+	// support, are still mounted, with a warning.
+	mountOptions := options
+	if nfsMountOptions, err := disk.ParseNFSMountOptions(options); err != nil {
+		logger.With(zap.Error(err)).Warn("Mount options of the volume are not supported, mounting with them as is.")
+	} else {
+		if kernelRelease, err := disk.KernelRelease(); err != nil {
+			logger.With(zap.Error(err)).Warn("Could not get kernel release to validate the mount options of the volume.")
+		} else if err = nfsMountOptions.ValidateKernel(kernelRelease); err != nil {
+			logger.With(zap.Error(err)).Warn("Mount options of the volume may not be supported by the kernel of the node.")
+		}
+		// The version is passed as a single nfsvers option.
+		mountOptions = nfsMountOptions.Options()
+	}
+
 	mounter := mount.New("")
 
 	if encryptInTransit {
@@ -115,7 +168,7 @@ func (d FSSNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVo
 		}
 
 		if len(content) > 0 && strings.Contains(content, FipsEnabled) {
-			options = append(options, "fips")
+			mountOptions = append(mountOptions, "fips")
 			logger.Debug("Fips mode enabled")
 		}
 	}
@@ -176,9 +229,11 @@ func (d FSSNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVo
 	source := fmt.Sprintf("%s:%s", csi_util.FormatValidIp(mountTargetIP), exportPath)
 
 	if encryptInTransit {
-		err = disk.MountWithEncrypt(logger, source, targetPath, fsType, options)
+		err = disk.MountWithEncrypt(logger, source, targetPath, fsType, mountOptions)
 	} else {
-		err = mounter.Mount(source, targetPath, fsType, options)
+		_, mountArgsLogStr := disk.MakeMountArgs(source, targetPath, fsType, mountOptions)
+		logger.Debugf("Mounting with arguments (%s)", mountArgsLogStr)
+		err = mounter.Mount(source, targetPath, fsType, mountOptions)
 	}
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to mount volume to staging target path.")
@@ -190,6 +245,28 @@ func (d FSSNodeDriver) NodeStageVolume(ctx context.Context, req *csi.NodeStageVo
 	return &csi.NodeStageVolumeResponse{}, nil
 }
 
+// localMountTargetIP returns the IP of the mount target in the availability
+// domain of the node when the file system is exported through mount targets in
+// several availability domains, and the IP of the mount target in the volume ID
+// otherwise.
+func localMountTargetIP(logger *zap.SugaredLogger, volumeContext map[string]string, availabilityDomain string, mountTargetIP string) string {
+	mountTargetIpsJson, ok := volumeContext[mountTargetIps]
+	if !ok || availabilityDomain == "" {
+		return mountTargetIP
+	}
+	var mountTargetIpsByAvailabilityDomain map[string]string
+	if err := json.Unmarshal([]byte(mountTargetIpsJson), &mountTargetIpsByAvailabilityDomain); err != nil {
+		logger.With(zap.Error(err)).Warnf("Failed to parse %s of volume context, using mount target %s", mountTargetIps, mountTargetIP)
+		return mountTargetIP
+	}
+	if ip, ok := mountTargetIpsByAvailabilityDomain[availabilityDomainShortName(availabilityDomain)]; ok && ip != "" {
+		logger.With("availabilityDomain", availabilityDomain, "mountTarget", ip).Info("Using mount target in availability domain of node.")
+		return ip
+	}
+	logger.With("availabilityDomain", availabilityDomain).Infof("No mount target in availability domain of node, using mount target %s", mountTargetIP)
+	return mountTargetIP
+}
+
 func isInTransitEncryptionEnabled(volumeContext map[string]string) (bool, error) {
 	if volumeContext != nil {
 		if encryptInTransit, ok := volumeContext["encryptInTransit"]; ok {
@@ -199,6 +276,146 @@ func isInTransitEncryptionEnabled(volumeContext map[string]string) (bool, error)
 	return false, nil
 }
 
+// kerberosSecurityFlavor returns the Kerberos security flavor of the volume
+// context, or an empty string for volumes mounted with AUTH_SYS.
+func kerberosSecurityFlavor(volumeContext map[string]string) (string, error) {
+	securityFlavor, ok := volumeContext[kerberosSecurity]
+	if !ok {
+		return "", nil
+	}
+	if _, ok := kerberosAllowedAuth[securityFlavor]; !ok {
+		return "", fmt.Errorf("invalid %s %q, must be one of krb5, krb5i or krb5p", kerberosSecurity, securityFlavor)
+	}
+	return securityFlavor, nil
+}
+
+// withNFSSecurityOption adds the sec mount option for the security flavor,
+// unless the mount flags of the volume already set the same one.
+func withNFSSecurityOption(options []string, securityFlavor string) ([]string, error) {
+	for _, option := range options {
+		if value, ok := strings.CutPrefix(option, "sec="); ok {
+			if value != securityFlavor {
+				return nil, fmt.Errorf("mount option %s conflicts with %s %s of the volume", option, kerberosSecurity, securityFlavor)
+			}
+			return options, nil
+		}
+	}
+	return append(options, "sec="+securityFlavor), nil
+}
+
+// kerberosKeytabLock serialises the updates of the keytab of the driver by the
+// volumes staged concurrently.
+var kerberosKeytabLock sync.Mutex
+
+// writeKerberosKeytab merges the entries of the keytab of the node stage
+// secrets, if any, into the keytab of the driver. The volumes of several
+// secrets, or realms, share the keytab rpc.gssd reads, so the entries of the
+// other secrets are kept. Nodes without the secret must already have a keytab.
+func writeKerberosKeytab(logger *zap.SugaredLogger, secrets map[string]string) error {
+	keytab, ok := secrets[kerberosKeytabSecretKey]
+	if !ok {
+		return nil
+	}
+
+	kerberosKeytabLock.Lock()
+	defer kerberosKeytabLock.Unlock()
+
+	existing, err := os.ReadFile(kerberosKeytabPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	merged, changed, err := mergeKerberosKeytabs(existing, []byte(keytab))
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(kerberosKeytabPath), 0700); err != nil {
+		return err
+	}
+	// The keytab is replaced atomically since rpc.gssd may read it at any time.
+	tmpFile, err := os.CreateTemp(filepath.Dir(kerberosKeytabPath), ".krb5.keytab-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err = tmpFile.Write(merged); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpFile.Name(), kerberosKeytabPath); err != nil {
+		return err
+	}
+	logger.With("keytab", kerberosKeytabPath).Info("Kerberos keytab written.")
+	return nil
+}
+
+// keytabFileFormatVersion is the version of the keytab file format written by
+// MIT Kerberos and Active Directory tools.
+var keytabFileFormatVersion = []byte{0x05, 0x02}
+
+// mergeKerberosKeytabs adds the entries of the keytab missing from the
+// existing keytab, and returns whether any was added.
+func mergeKerberosKeytabs(existing, keytab []byte) ([]byte, bool, error) {
+	entries, err := keytabEntries(keytab)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid %s: %v", kerberosKeytabSecretKey, err)
+	}
+	if len(existing) == 0 {
+		existing = keytabFileFormatVersion
+	}
+	existingEntries, err := keytabEntries(existing)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid keytab %s: %v", kerberosKeytabPath, err)
+	}
+	known := make(map[string]bool, len(existingEntries))
+	for _, entry := range existingEntries {
+		known[string(entry)] = true
+	}
+
+	merged := append([]byte{}, existing...)
+	changed := false
+	for _, entry := range entries {
+		if !known[string(entry)] {
+			merged = append(merged, entry...)
+			known[string(entry)] = true
+			changed = true
+		}
+	}
+	return merged, changed, nil
+}
+
+// keytabEntries returns the entries of a keytab along with their length.
+// Deleted entries, which have a negative length, are skipped.
+func keytabEntries(keytab []byte) ([][]byte, error) {
+	if !bytes.HasPrefix(keytab, keytabFileFormatVersion) {
+		return nil, errors.New("unsupported keytab file format")
+	}
+	var entries [][]byte
+	for data := keytab[len(keytabFileFormatVersion):]; len(data) > 0; {
+		if len(data) < 4 {
+			return nil, errors.New("truncated keytab entry")
+		}
+		size := int64(int32(binary.BigEndian.Uint32(data)))
+		length := size
+		if length < 0 {
+			length = -length
+		}
+		if length > int64(len(data)-4) {
+			return nil, errors.New("truncated keytab entry")
+		}
+		if size > 0 {
+			entries = append(entries, data[:4+length])
+		}
+		data = data[4+length:]
+	}
+	return entries, nil
+}
+
 func isMountPoint(mounter mount.Interface, path string) (bool, error) {
 	ok, err := mounter.IsLikelyNotMountPoint(path)
 	if err != nil {
@@ -437,7 +654,7 @@ func (d FSSNodeDriver) unmountAndCleanup(logger *zap.SugaredLogger, targetPath s
 
 		logger.With("device", device).With("exportPath", exportPath).
 			With("mountTargetIP", mountTargetIP).Debugf("Identifying intransit encryption.")
-		if strings.HasSuffix(device, exportPath) && !strings.HasPrefix(device, mountTargetIP) {
+		if strings.HasSuffix(device, exportPath) && !strings.HasPrefix(device, mountTargetIP) && !isMountTargetSource(device, exportPath) {
 			logger.Debugf("Intransit encryption identified.")
 			inTransitEncryption = true
 			break
@@ -458,20 +675,33 @@ func (d FSSNodeDriver) unmountAndCleanup(logger *zap.SugaredLogger, targetPath s
 	return nil
 }
 
+// isMountTargetSource returns whether an NFS mount source is an export of a
+// mount target, which may be a mount target in the availability domain of the
+// node rather than the one in the volume ID. In-transit encryption mounts the
+// export through a local tunnel instead.
+func isMountTargetSource(source string, exportPath string) bool {
+	host := strings.Trim(strings.TrimSuffix(strings.TrimSuffix(source, exportPath), ":"), "[]")
+	ip := net.ParseIP(host)
+	return ip != nil && !ip.IsLoopback()
+}
+
 // NodeGetCapabilities returns the supported capabilities of the node server
 func (d FSSNodeDriver) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
-	nscap := &csi.NodeServiceCapability{
-		Type: &csi.NodeServiceCapability_Rpc{
-			Rpc: &csi.NodeServiceCapability_RPC{
-				Type: csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
+	var nscaps []*csi.NodeServiceCapability
+	nodeCaps := []csi.NodeServiceCapability_RPC_Type{csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME, csi.NodeServiceCapability_RPC_GET_VOLUME_STATS, csi.NodeServiceCapability_RPC_VOLUME_CONDITION}
+	for _, nodeCap := range nodeCaps {
+		c := &csi.NodeServiceCapability{
+			Type: &csi.NodeServiceCapability_Rpc{
+				Rpc: &csi.NodeServiceCapability_RPC{
+					Type: nodeCap,
+				},
 			},
-		},
+		}
+		nscaps = append(nscaps, c)
 	}
 
 	return &csi.NodeGetCapabilitiesResponse{
-		Capabilities: []*csi.NodeServiceCapability{
-			nscap,
-		},
+		Capabilities: nscaps,
 	}, nil
 }
 
@@ -503,7 +733,7 @@ func (d FSSNodeDriver) NodeGetInfo(ctx context.Context, req *csi.NodeGetInfoRequ
 
 // NodeGetVolumeStats return the stats of the volume
 func (d FSSNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "NodeGetVolumeStats is not supported yet")
+	return getNetworkFilesystemVolumeStats(ctx, d.logger, req, "nfs")
 }
 
 // NodeExpandVolume returns the expand of the volume
@@ -0,0 +1,220 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func Test_localMountTargetIP(t *testing.T) {
+	multiAvailabilityDomainContext := map[string]string{
+		"encryptInTransit": "false",
+		mountTargetIps:     `{"PHX-AD-1":"10.0.10.1","PHX-AD-2":"10.0.20.1"}`,
+	}
+	tests := []struct {
+		name               string
+		volumeContext      map[string]string
+		availabilityDomain string
+		want               string
+	}{
+		{
+			name:               "Single mount target",
+			volumeContext:      map[string]string{"encryptInTransit": "false"},
+			availabilityDomain: "PHX-AD-2",
+			want:               "10.0.10.1",
+		},
+		{
+			name:               "Mount target in availability domain of node",
+			volumeContext:      multiAvailabilityDomainContext,
+			availabilityDomain: "PHX-AD-2",
+			want:               "10.0.20.1",
+		},
+		{
+			name:               "Full name of availability domain of node",
+			volumeContext:      multiAvailabilityDomainContext,
+			availabilityDomain: "NWuj:PHX-AD-2",
+			want:               "10.0.20.1",
+		},
+		{
+			name:               "No mount target in availability domain of node",
+			volumeContext:      multiAvailabilityDomainContext,
+			availabilityDomain: "PHX-AD-3",
+			want:               "10.0.10.1",
+		},
+		{
+			name:               "Unknown availability domain of node",
+			volumeContext:      multiAvailabilityDomainContext,
+			availabilityDomain: "",
+			want:               "10.0.10.1",
+		},
+		{
+			name:               "Invalid mount target IPs",
+			volumeContext:      map[string]string{mountTargetIps: "PHX-AD-2=10.0.20.1"},
+			availabilityDomain: "PHX-AD-2",
+			want:               "10.0.10.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := localMountTargetIP(zap.S(), tt.volumeContext, tt.availabilityDomain, "10.0.10.1"); got != tt.want {
+				t.Errorf("localMountTargetIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isMountTargetSource(t *testing.T) {
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{source: "10.0.20.1:/export", want: true},
+		{source: "[fd00:c1::a9fe:202]:/export", want: true},
+		{source: "127.0.0.1:/export", want: false},
+		{source: "localhost:/export", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			if got := isMountTargetSource(tt.source, "/export"); got != tt.want {
+				t.Errorf("isMountTargetSource(%q) = %v, want %v", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_kerberosSecurityFlavor(t *testing.T) {
+	tests := []struct {
+		name          string
+		volumeContext map[string]string
+		want          string
+		wantErr       bool
+	}{
+		{name: "AUTH_SYS", volumeContext: map[string]string{"encryptInTransit": "false"}, want: ""},
+		{name: "krb5p", volumeContext: map[string]string{kerberosSecurity: "krb5p"}, want: "krb5p"},
+		{name: "Invalid flavor", volumeContext: map[string]string{kerberosSecurity: "sys"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kerberosSecurityFlavor(tt.volumeContext)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("kerberosSecurityFlavor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("kerberosSecurityFlavor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_withNFSSecurityOption(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "No mount options", options: nil, want: []string{"sec=krb5i"}},
+		{name: "Other mount options", options: []string{"nconnect=4"}, want: []string{"nconnect=4", "sec=krb5i"}},
+		{name: "Same security flavor", options: []string{"sec=krb5i"}, want: []string{"sec=krb5i"}},
+		{name: "Conflicting security flavor", options: []string{"sec=sys"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withNFSSecurityOption(tt.options, "krb5i")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("withNFSSecurityOption() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("withNFSSecurityOption() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testKeytab returns a keytab with an entry of each data.
+func testKeytab(entries ...string) string {
+	keytab := []byte{0x05, 0x02}
+	for _, entry := range entries {
+		keytab = binary.BigEndian.AppendUint32(keytab, uint32(len(entry)))
+		keytab = append(keytab, entry...)
+	}
+	return string(keytab)
+}
+
+func Test_writeKerberosKeytab(t *testing.T) {
+	defaultKerberosKeytabPath := kerberosKeytabPath
+	defer func() { kerberosKeytabPath = defaultKerberosKeytabPath }()
+	kerberosKeytabPath = filepath.Join(t.TempDir(), "krb5.keytab.d", "oci-fss-csi.keytab")
+
+	if err := writeKerberosKeytab(zap.S(), nil); err != nil {
+		t.Fatalf("writeKerberosKeytab() without keytab error = %v", err)
+	}
+	if _, err := os.Stat(kerberosKeytabPath); !os.IsNotExist(err) {
+		t.Fatalf("writeKerberosKeytab() without keytab wrote %s", kerberosKeytabPath)
+	}
+
+	tests := []struct {
+		name    string
+		keytab  string
+		want    string
+		wantErr bool
+	}{
+		{name: "first keytab", keytab: testKeytab("realm-a"), want: testKeytab("realm-a")},
+		{name: "same keytab", keytab: testKeytab("realm-a"), want: testKeytab("realm-a")},
+		{name: "keytab of another realm", keytab: testKeytab("realm-b", "realm-a"), want: testKeytab("realm-a", "realm-b")},
+		{name: "invalid keytab", keytab: "keytab", want: testKeytab("realm-a", "realm-b"), wantErr: true},
+		{name: "truncated keytab", keytab: testKeytab("realm-c")[:8], want: testKeytab("realm-a", "realm-b"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := writeKerberosKeytab(zap.S(), map[string]string{kerberosKeytabSecretKey: tt.keytab})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("writeKerberosKeytab() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got, err := os.ReadFile(kerberosKeytabPath)
+			if err != nil {
+				t.Fatalf("failed to read keytab: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("keytab = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	info, err := os.Stat(kerberosKeytabPath)
+	if err != nil {
+		t.Fatalf("failed to stat keytab: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("keytab mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func Test_keytabEntries(t *testing.T) {
+	deleted := []byte{0x05, 0x02, 0xff, 0xff, 0xff, 0xfd, 0, 0, 0}
+	got, err := keytabEntries(append(deleted, testKeytab("entry")[2:]...))
+	if err != nil {
+		t.Fatalf("keytabEntries() error = %v", err)
+	}
+	want := [][]byte{[]byte(testKeytab("entry")[2:])}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("keytabEntries() = %q, want %q", got, want)
+	}
+}
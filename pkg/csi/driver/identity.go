@@ -44,6 +44,12 @@ func (d *Driver) GetPluginCapabilities(ctx context.Context, req *csi.GetPluginCa
 		},
 	}
 
+	groupControllerService := csi.PluginCapability_Service_{
+		Service: &csi.PluginCapability_Service{
+			Type: csi.PluginCapability_Service_GROUP_CONTROLLER_SERVICE,
+		},
+	}
+
 	var capabilities []*csi.PluginCapability
 	if d.name == BlockVolumeDriverName {
 		capabilities = []*csi.PluginCapability{
@@ -53,6 +59,9 @@ func (d *Driver) GetPluginCapabilities(ctx context.Context, req *csi.GetPluginCa
 			{
 				Type: &accessibilityConstraints,
 			},
+			{
+				Type: &groupControllerService,
+			},
 		}
 	} else {
 		capabilities = []*csi.PluginCapability{
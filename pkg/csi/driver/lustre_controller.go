@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
 )
 
@@ -35,6 +36,13 @@ const (
 	MB = 1000 * KB
 	// GB is 1000 MB
 	GB = 1000 * MB
+
+	// lustreMinCapacityInGBs is the smallest Lustre file system that can be provisioned.
+	lustreMinCapacityInGBs = 31200
+	// lustreCapacityIncrementInGBs is the step in which Lustre file system capacity grows.
+	lustreCapacityIncrementInGBs = 10400
+	// lustreUpdatePollInterval is the interval at which the work request of a capacity update is polled.
+	lustreUpdatePollInterval = 30 * time.Second
 )
 
 // ControllerGetCapabilities advertises the controller RPCs supported by Lustre.
@@ -50,6 +58,7 @@ func (d *LustreControllerDriver) ControllerGetCapabilities(ctx context.Context,
 	}
 	var caps []*csi.ControllerServiceCapability
 	caps = append(caps, newCap(csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME))
+	caps = append(caps, newCap(csi.ControllerServiceCapability_RPC_EXPAND_VOLUME))
 	return &csi.ControllerGetCapabilitiesResponse{Capabilities: caps}, nil
 }
 
@@ -340,6 +349,223 @@ func (d *LustreControllerDriver) DeleteVolume(ctx context.Context, req *csi.Dele
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
+// ControllerExpandVolume implements CSI ControllerExpandVolume for Lustre. The file system
+// grows online, so no node expansion is required.
+func (d *LustreControllerDriver) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (resp *csi.ControllerExpandVolumeResponse, err error) {
+	defer MakeCSIPanicRecoveryWithError(d.logger, d.metricPusher, "LustreControllerDriver.ControllerExpandVolume", map[string]string{metrics.ResourceOCIDDimension: req.GetVolumeId()}, &err, codes.Internal)()
+	startTime := time.Now()
+	log := d.logger.With("csiOperation", "expandVolume", "volumeID", req.GetVolumeId())
+	log.Debug("Request being passed in ControllerExpandVolume gRPC ", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Volume ID must be provided")
+	}
+
+	dim := map[string]string{metrics.ResourceOCIDDimension: req.GetVolumeId()}
+
+	lustreFilesystemId := extractLustreFilesystemId(req.GetVolumeId())
+	if lustreFilesystemId == "" {
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Volume ID provided %s", req.GetVolumeId())
+	}
+	dim[metrics.ResourceOCIDDimension] = lustreFilesystemId
+	log = log.With("lustreFilesystemId", lustreFilesystemId)
+
+	capacityInGbs, err := roundUpLustreCapacity(req.GetCapacityRange())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Invalid capacity range")
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return nil, err
+	}
+	log = log.With("Capacity", capacityInGbs)
+
+	lustreClient := d.client.Lustre()
+	if lustreClient == nil {
+		return nil, status.Error(codes.Internal, "Unable to create lustre client")
+	}
+
+	fs, err := lustreClient.GetLustreFileSystem(ctx, lustreFilesystemId)
+	if err != nil {
+		log.With("service", "lustre", "verb", "get", "resource", "lustreFilesystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get Lustre filesystem for expansion.")
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Lustre filesystem %s not found", lustreFilesystemId)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get Lustre filesystem for expansion, error: %v", err)
+	}
+
+	currentCapacityInGbs := pointer.IntDeref(fs.CapacityInGBs, 0)
+	if currentCapacityInGbs >= capacityInGbs {
+		log.With("currentCapacity", currentCapacityInGbs).Info("Lustre filesystem already has the requested capacity.")
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return &csi.ControllerExpandVolumeResponse{
+			CapacityBytes:         int64(currentCapacityInGbs) * GB,
+			NodeExpansionRequired: false,
+		}, nil
+	}
+
+	workRequestId := ""
+	switch fs.LifecycleState {
+	case lustre.LustreFileSystemLifecycleStateActive:
+		workRequestId, err = lustreClient.UpdateLustreFileSystemCapacity(ctx, lustreFilesystemId, capacityInGbs)
+		if err != nil {
+			log.With("service", "lustre", "verb", "update", "resource", "lustreFilesystem", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to update Lustre filesystem capacity.")
+			dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+			return nil, status.Errorf(codes.Internal, "Failed to update Lustre filesystem capacity, error: %v", err)
+		}
+		log = log.With("workRequestId", workRequestId)
+		log.Info("Lustre filesystem capacity update requested.")
+	case lustre.LustreFileSystemLifecycleStateUpdating:
+		// A previous attempt may have requested the update already, wait for its work request.
+		log.Info("Lustre filesystem is already being updated, waiting for the update to complete.")
+	default:
+		log.With("lifecycleState", fs.LifecycleState).Error("Lustre filesystem cannot be expanded in its current state.")
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return nil, status.Errorf(codes.FailedPrecondition, "Lustre filesystem %s is in lifecycle state %v and cannot be expanded", lustreFilesystemId, fs.LifecycleState)
+	}
+
+	if err := d.awaitLustreUpdateWorkRequest(ctx, log, lustreClient, pointer.StringDeref(fs.CompartmentId, d.config.CompartmentID), lustreFilesystemId, workRequestId); err != nil {
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return nil, err
+	}
+
+	// The awaited work request may belong to another update of the file system, so verify the capacity.
+	fs, err = lustreClient.GetLustreFileSystem(ctx, lustreFilesystemId)
+	if err != nil {
+		log.With("service", "lustre", "verb", "get", "resource", "lustreFilesystem", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get Lustre filesystem after expansion.")
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return nil, status.Errorf(codes.Internal, "Failed to get Lustre filesystem after expansion, error: %v", err)
+	}
+	if currentCapacityInGbs = pointer.IntDeref(fs.CapacityInGBs, 0); currentCapacityInGbs < capacityInGbs {
+		log.With("currentCapacity", currentCapacityInGbs).Info("Lustre filesystem capacity is not updated yet, controller will retry.")
+		dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+		return nil, status.Errorf(codes.Unavailable, "Lustre filesystem %s has capacity %d GB, waiting for %d GB", lustreFilesystemId, currentCapacityInGbs, capacityInGbs)
+	}
+
+	log.Info("Lustre filesystem is expanded.")
+	dim[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.LustreExpand, time.Since(startTime).Seconds(), dim)
+	return &csi.ControllerExpandVolumeResponse{
+		CapacityBytes:         int64(capacityInGbs) * GB,
+		NodeExpansionRequired: false,
+	}, nil
+}
+
+// roundUpLustreCapacity returns the smallest capacity in GB allowed by the service that
+// satisfies the capacity range.
+func roundUpLustreCapacity(capacityRange *csi.CapacityRange) (int, error) {
+	if capacityRange == nil || capacityRange.GetRequiredBytes() <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "Required bytes must be provided in the capacity range")
+	}
+	capacityInGbs := int(csi_util.RoundUpSize(capacityRange.GetRequiredBytes(), 1*GB))
+	if capacityInGbs <= lustreMinCapacityInGBs {
+		capacityInGbs = lustreMinCapacityInGBs
+	} else {
+		increments := (capacityInGbs - lustreMinCapacityInGBs + lustreCapacityIncrementInGBs - 1) / lustreCapacityIncrementInGBs
+		capacityInGbs = lustreMinCapacityInGBs + increments*lustreCapacityIncrementInGBs
+	}
+	if limitBytes := capacityRange.GetLimitBytes(); limitBytes > 0 && int64(capacityInGbs)*GB > limitBytes {
+		return 0, status.Errorf(codes.OutOfRange, "Lustre capacity of %d GB needed for %d bytes exceeds the limit of %d bytes",
+			capacityInGbs, capacityRange.GetRequiredBytes(), limitBytes)
+	}
+	return capacityInGbs, nil
+}
+
+// awaitLustreUpdateWorkRequest waits for the work request updating the Lustre file system to finish.
+// If workRequestId is empty the most recent update work request of the file system is awaited.
+func (d *LustreControllerDriver) awaitLustreUpdateWorkRequest(ctx context.Context, log *zap.SugaredLogger, lustreClient client.LustreInterface, compartmentId, lustreFilesystemId, workRequestId string) error {
+	var workRequest *lustre.WorkRequestSummary
+	err := wait.PollUntilContextCancel(ctx, lustreUpdatePollInterval, true, func(ctx context.Context) (bool, error) {
+		workRequests, err := lustreClient.ListWorkRequests(ctx, compartmentId, lustreFilesystemId)
+		if err != nil {
+			return false, err
+		}
+		workRequest = nil
+		for i, wr := range workRequests {
+			if wr.Id == nil || wr.OperationType != lustre.OperationTypeUpdateLustreFileSystem {
+				continue
+			}
+			// Work requests are sorted by time accepted, most recent first.
+			if workRequestId == "" || *wr.Id == workRequestId {
+				workRequest = &workRequests[i]
+				break
+			}
+		}
+		if workRequest == nil {
+			log.Debug("Update work request not found yet")
+			return false, nil
+		}
+		switch workRequest.Status {
+		case lustre.OperationStatusSucceeded, lustre.OperationStatusFailed, lustre.OperationStatusCanceled:
+			return true, nil
+		default:
+			log.With("workRequestId", *workRequest.Id).Debugf("Update work request is in status %v", workRequest.Status)
+			return false, nil
+		}
+	})
+	if err != nil {
+		log.With("service", "lustre", "verb", "list", "resource", "lustreWorkRequests", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Error occurred while waiting for the Lustre filesystem update to complete.")
+		if ctx.Err() != nil {
+			return status.Errorf(codes.DeadlineExceeded, "deadline reached while waiting for Lustre filesystem update to complete, error : %v", err)
+		}
+		return status.Errorf(codes.Internal, "Failed to get Lustre filesystem update work request, error : %v", err)
+	}
+
+	if workRequest.Status == lustre.OperationStatusSucceeded {
+		return nil
+	}
+
+	errMsg := fmt.Sprintf("Lustre filesystem update work request %s is in status %v.", *workRequest.Id, workRequest.Status)
+	wrErrors, err := lustreClient.ListWorkRequestErrors(ctx, *workRequest.Id, lustreFilesystemId)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to list Lustre work request errors")
+		return status.Error(codes.Internal, errMsg)
+	}
+	if len(wrErrors) == 0 {
+		log.Error(errMsg)
+		return status.Error(codes.Internal, errMsg)
+	}
+	// Errors are sorted by timestamp, most recent first.
+	wrError := wrErrors[0]
+	log.With("workRequestErrorCode", pointer.StringDeref(wrError.Code, ""), "workRequestError", pointer.StringDeref(wrError.Message, "")).
+		Error("Lustre filesystem update failed with work request error")
+	return status.Errorf(lustreWorkRequestErrorCode(pointer.StringDeref(wrError.Code, "")), "%s Error from workrequest : %s",
+		errMsg, pointer.StringDeref(wrError.Message, ""))
+}
+
+// lustreWorkRequestErrorCode maps the error code of a Lustre work request error to a gRPC code.
+func lustreWorkRequestErrorCode(code string) codes.Code {
+	switch code {
+	case "InvalidParameter", "MissingParameter", "CannotParseRequest":
+		return codes.InvalidArgument
+	case "LimitExceeded", "QuotaExceeded", "InsufficientServiceCapacity", "OutOfCapacity":
+		return codes.ResourceExhausted
+	case "NotAuthorized", "NotAuthorizedOrNotFound", "NotAuthenticated":
+		return codes.PermissionDenied
+	case "NotFound":
+		return codes.NotFound
+	case "Conflict", "IncorrectState":
+		return codes.FailedPrecondition
+	case "TooManyRequests":
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
 // ValidateVolumeCapabilities implements CSI ValidateVolumeCapabilities for Lustre.
 func (d *LustreControllerDriver) ValidateVolumeCapabilities(ctx context.Context, req *csi.ValidateVolumeCapabilitiesRequest) (resp *csi.ValidateVolumeCapabilitiesResponse, err error) {
 	defer MakeCSIPanicRecoveryWithError(d.logger, d.metricPusher, "LustreControllerDriver.ValidateVolumeCapabilities", map[string]string{metrics.ResourceOCIDDimension: req.GetVolumeId()}, &err, codes.Internal)()
@@ -433,9 +659,6 @@ func (d *LustreControllerDriver) DeleteSnapshot(ctx context.Context, request *cs
 func (d *LustreControllerDriver) ListSnapshots(ctx context.Context, request *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "")
 }
-func (d *LustreControllerDriver) ControllerExpandVolume(ctx context.Context, request *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
-}
 func (d *LustreControllerDriver) ControllerGetVolume(ctx context.Context, request *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "")
 }
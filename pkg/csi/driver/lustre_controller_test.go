@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"testing/synctest"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
@@ -57,6 +58,12 @@ type MockOCILustreFileStorageClient struct {
 	// delete
 	DeleteErr error
 
+	// update capacity
+	UpdateCalled        bool
+	UpdateCapacityInGBs int
+	UpdateResp          string
+	UpdateErr           error
+
 	// await deleted
 	AwaitDeletedErr error
 
@@ -82,6 +89,17 @@ func (f *MockOCILustreFileStorageClient) ListLustreFileSystems(ctx context.Conte
 func (f *MockOCILustreFileStorageClient) DeleteLustreFileSystem(ctx context.Context, id string) error {
 	return f.DeleteErr
 }
+func (f *MockOCILustreFileStorageClient) UpdateLustreFileSystemCapacity(ctx context.Context, id string, capacityInGBs int) (string, error) {
+	f.UpdateCalled = true
+	f.UpdateCapacityInGBs = capacityInGBs
+	if f.UpdateErr == nil && f.GetResp != nil {
+		// reflect the new capacity in subsequent gets
+		updated := *f.GetResp
+		updated.CapacityInGBs = &capacityInGBs
+		f.GetResp = &updated
+	}
+	return f.UpdateResp, f.UpdateErr
+}
 func (f *MockOCILustreFileStorageClient) AwaitLustreFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*lustre.LustreFileSystem, error) {
 	return f.AwaitActiveResp, f.AwaitActiveErr
 }
@@ -830,7 +848,7 @@ func TestValidateVolumeCapabilities_GetError(t *testing.T) {
 }
 
 // ########################## ControllerGetCapabilities Tests ##########################
-func TestLustreController_ControllerGetCapabilities_SingleCreateDeleteOnly(t *testing.T) {
+func TestLustreController_ControllerGetCapabilities_CreateDeleteAndExpand(t *testing.T) {
 	d := &LustreControllerDriver{}
 
 	resp, err := d.ControllerGetCapabilities(context.Background(), &csi.ControllerGetCapabilitiesRequest{})
@@ -840,15 +858,20 @@ func TestLustreController_ControllerGetCapabilities_SingleCreateDeleteOnly(t *te
 	if resp == nil {
 		t.Fatalf("expected non-nil response")
 	}
-	if len(resp.Capabilities) != 1 {
-		t.Fatalf("expected 1 capability, got %d", len(resp.Capabilities))
+	if len(resp.Capabilities) != 2 {
+		t.Fatalf("expected 2 capabilities, got %d", len(resp.Capabilities))
 	}
-	cap := resp.Capabilities[0]
-	if cap.GetRpc() == nil {
-		t.Fatalf("expected RPC capability, got nil")
+	expected := []csi.ControllerServiceCapability_RPC_Type{
+		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
+		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 	}
-	if got := cap.GetRpc().GetType(); got != csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME {
-		t.Fatalf("expected capability CREATE_DELETE_VOLUME, got %v", got)
+	for i, cap := range resp.Capabilities {
+		if cap.GetRpc() == nil {
+			t.Fatalf("expected RPC capability, got nil")
+		}
+		if got := cap.GetRpc().GetType(); got != expected[i] {
+			t.Fatalf("expected capability %v, got %v", expected[i], got)
+		}
 	}
 }
 
@@ -872,14 +895,183 @@ func TestLustreController_ControllerGetCapabilities_NilReceiver(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error with nil receiver: %v", err)
 	}
-	if resp == nil || len(resp.Capabilities) != 1 {
-		t.Fatalf("expected exactly one capability with nil receiver")
+	if resp == nil || len(resp.Capabilities) != 2 {
+		t.Fatalf("expected two capabilities with nil receiver")
 	}
 	if resp.Capabilities[0].GetRpc().GetType() != csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME {
 		t.Fatalf("expected CREATE_DELETE_VOLUME with nil receiver")
 	}
 }
 
+// ########################## ControllerExpandVolume Tests ##########################
+
+const lustreTestVolumeId = "ocid1.lustrefilesystem.oc1.phx.id:10.0.0.10@tcp:/fs1"
+
+func newActiveLustreFileSystem(capacityInGBs int) *lustre.LustreFileSystem {
+	return &lustre.LustreFileSystem{
+		Id:             ptrString("ocid1.lustrefilesystem.oc1.phx.id"),
+		CompartmentId:  ptrString("ocid1.compartment.oc1..fs"),
+		CapacityInGBs:  &capacityInGBs,
+		LifecycleState: lustre.LustreFileSystemLifecycleStateActive,
+	}
+}
+
+func TestControllerExpandVolume_InvalidVolumeId(t *testing.T) {
+	d := newControllerWith(&MockOCILustreFileStorageClient{}, &MockOCIIdentityClient{})
+	_, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: "bad-id", CapacityRange: &csi.CapacityRange{RequiredBytes: 41600 * GB}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for malformed volume id, got %v", err)
+	}
+}
+
+func TestControllerExpandVolume_MissingCapacityRange(t *testing.T) {
+	d := newControllerWith(&MockOCILustreFileStorageClient{}, &MockOCIIdentityClient{})
+	_, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for missing capacity range, got %v", err)
+	}
+}
+
+func TestControllerExpandVolume_RoundedCapacityExceedsLimit(t *testing.T) {
+	fl := &MockOCILustreFileStorageClient{GetResp: newActiveLustreFileSystem(31200)}
+	d := newControllerWith(fl, &MockOCIIdentityClient{})
+	_, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 35000 * GB, LimitBytes: 40000 * GB}})
+	if status.Code(err) != codes.OutOfRange {
+		t.Fatalf("expected OutOfRange when rounded capacity exceeds limit, got %v", err)
+	}
+	if fl.UpdateCalled {
+		t.Fatalf("expected no update call")
+	}
+}
+
+func TestControllerExpandVolume_NotFound(t *testing.T) {
+	fl := &MockOCILustreFileStorageClient{GetErr: mockNotFoundError{statusCode: 404, message: "not found"}}
+	d := newControllerWith(fl, &MockOCIIdentityClient{})
+	_, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 41600 * GB}})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
+
+func TestControllerExpandVolume_AlreadyExpanded_Idempotent(t *testing.T) {
+	fl := &MockOCILustreFileStorageClient{GetResp: newActiveLustreFileSystem(41600)}
+	d := newControllerWith(fl, &MockOCIIdentityClient{})
+	resp, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 35000 * GB}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fl.UpdateCalled {
+		t.Fatalf("expected no update call for a file system that is large enough")
+	}
+	if resp.CapacityBytes != 41600*GB || resp.NodeExpansionRequired {
+		t.Fatalf("unexpected response %v", resp)
+	}
+}
+
+func TestControllerExpandVolume_NotActive_FailedPrecondition(t *testing.T) {
+	fs := newActiveLustreFileSystem(31200)
+	fs.LifecycleState = lustre.LustreFileSystemLifecycleStateFailed
+	fl := &MockOCILustreFileStorageClient{GetResp: fs}
+	d := newControllerWith(fl, &MockOCIIdentityClient{})
+	_, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 41600 * GB}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestControllerExpandVolume_Success(t *testing.T) {
+	fl := &MockOCILustreFileStorageClient{
+		GetResp:    newActiveLustreFileSystem(31200),
+		UpdateResp: "wr-update",
+		WRListResp: []lustre.WorkRequestSummary{
+			{Id: ptrString("wr-update"), OperationType: lustre.OperationTypeUpdateLustreFileSystem, Status: lustre.OperationStatusSucceeded},
+			{Id: ptrString("wr-create"), OperationType: lustre.OperationTypeCreateLustreFileSystem, Status: lustre.OperationStatusSucceeded},
+		},
+	}
+	d := newControllerWith(fl, &MockOCIIdentityClient{})
+	resp, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 35000 * GB}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fl.UpdateCalled || fl.UpdateCapacityInGBs != 41600 {
+		t.Fatalf("expected update to 41600 GB, got called=%v capacity=%d", fl.UpdateCalled, fl.UpdateCapacityInGBs)
+	}
+	if resp.CapacityBytes != 41600*GB || resp.NodeExpansionRequired {
+		t.Fatalf("unexpected response %v", resp)
+	}
+}
+
+func TestControllerExpandVolume_WorkRequestFailed_MapsErrorCode(t *testing.T) {
+	fl := &MockOCILustreFileStorageClient{
+		GetResp:    newActiveLustreFileSystem(31200),
+		UpdateResp: "wr-update",
+		WRListResp: []lustre.WorkRequestSummary{
+			{Id: ptrString("wr-update"), OperationType: lustre.OperationTypeUpdateLustreFileSystem, Status: lustre.OperationStatusFailed},
+		},
+		WRErrsResp: []lustre.WorkRequestError{{Code: ptrString("LimitExceeded"), Message: ptrString("capacity limit exceeded")}},
+	}
+	d := newControllerWith(fl, &MockOCIIdentityClient{})
+	_, err := d.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 41600 * GB}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if !containsErr(err, "capacity limit exceeded") {
+		t.Fatalf("expected error to include the work request error, got %v", err)
+	}
+}
+
+func TestControllerExpandVolume_UpdateInProgress_Timeout(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		fs := newActiveLustreFileSystem(31200)
+		fs.LifecycleState = lustre.LustreFileSystemLifecycleStateUpdating
+		fl := &MockOCILustreFileStorageClient{
+			GetResp: fs,
+			WRListResp: []lustre.WorkRequestSummary{
+				{Id: ptrString("wr-update"), OperationType: lustre.OperationTypeUpdateLustreFileSystem, Status: lustre.OperationStatusInProgress},
+			},
+		}
+		d := newControllerWith(fl, &MockOCIIdentityClient{})
+		ctx, cancel := context.WithTimeout(context.Background(), 2*lustreUpdatePollInterval)
+		defer cancel()
+		_, err := d.ControllerExpandVolume(ctx, &csi.ControllerExpandVolumeRequest{VolumeId: lustreTestVolumeId, CapacityRange: &csi.CapacityRange{RequiredBytes: 41600 * GB}})
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Fatalf("expected DeadlineExceeded, got %v", err)
+		}
+		if fl.UpdateCalled {
+			t.Fatalf("expected no new update while the file system is updating")
+		}
+	})
+}
+
+func TestHelper_roundUpLustreCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		required int64
+		limit    int64
+		want     int
+		wantCode codes.Code
+	}{
+		{name: "below minimum", required: 1 * GB, want: 31200},
+		{name: "minimum", required: 31200 * GB, want: 31200},
+		{name: "just above minimum", required: 31200*GB + 1, want: 41600},
+		{name: "exact increment", required: 52000 * GB, want: 52000},
+		{name: "within limit", required: 35000 * GB, limit: 41600 * GB, want: 41600},
+		{name: "exceeds limit", required: 35000 * GB, limit: 40000 * GB, wantCode: codes.OutOfRange},
+		{name: "missing required bytes", wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := roundUpLustreCapacity(&csi.CapacityRange{RequiredBytes: tt.required, LimitBytes: tt.limit})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %d GB, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
 // ########################## Helper Tests ##########################
 
 func TestHelper_extractLustreFilesystemId(t *testing.T) {
@@ -941,6 +1133,7 @@ func (t *testOCIClient) BlockStorage() client.BlockStorageInterface
 func (t *testOCIClient) FSS(*client.OCIClientConfig) client.FileStorageInterface       { return nil }
 func (t *testOCIClient) Lustre() client.LustreInterface                                { return t.lustre }
 func (t *testOCIClient) Identity(*client.OCIClientConfig) client.IdentityInterface     { return t.id }
+func (t *testOCIClient) Limits() client.LimitsInterface                                { return nil }
 
 // Keep for compatibility if other tests add additional helpers here.
 
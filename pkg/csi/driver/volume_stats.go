@@ -0,0 +1,162 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// networkFilesystemStatsTimeout is how long statfs may block before a
+	// network file system mount is reported as not responding.
+	networkFilesystemStatsTimeout = 10 * time.Second
+)
+
+var (
+	// statfs is swapped out in tests.
+	statfs = syscall.Statfs
+
+	// inflightStatfs holds the volume paths with a statfs call that has not
+	// returned yet. A call on a hung hard NFS mount or an evicted Lustre
+	// client can block indefinitely, so new calls for such a path are not
+	// started until the previous one returns.
+	inflightStatfs sync.Map
+
+	errStatfsTimeout = errors.New("statfs timed out")
+)
+
+// getNetworkFilesystemVolumeStats returns the byte and inode usage of the
+// network file system (NFS or Lustre) mounted at the volume path of the request.
+// A mount that is stale or does not respond is reported through an abnormal
+// volume condition rather than an error, so the CO can surface it.
+func getNetworkFilesystemVolumeStats(ctx context.Context, logger *zap.SugaredLogger, req *csi.NodeGetVolumeStatsRequest, fsType string) (*csi.NodeGetVolumeStatsResponse, error) {
+	logger = logger.With("volumeID", req.GetVolumeId(), "volumePath", req.GetVolumePath())
+
+	if req.GetVolumeId() == "" {
+		logger.Error("Volume ID not provided")
+		return nil, status.Error(codes.InvalidArgument, "Volume ID not provided")
+	}
+	volumePath := req.GetVolumePath()
+	if volumePath == "" {
+		logger.Error("Volume path not provided")
+		return nil, status.Error(codes.InvalidArgument, "volume path must be provided")
+	}
+
+	stats, err := statfsWithTimeout(ctx, volumePath, networkFilesystemStatsTimeout)
+	if err != nil {
+		if errors.Is(err, syscall.ENOENT) {
+			logger.Infof("Path does not exist %s", volumePath)
+			return nil, status.Errorf(codes.NotFound, "path %s does not exist", volumePath)
+		}
+		if message, abnormal := networkFilesystemCondition(err, fsType, volumePath); abnormal {
+			logger.With(zap.Error(err)).Warn(message)
+			return &csi.NodeGetVolumeStatsResponse{
+				VolumeCondition: &csi.VolumeCondition{
+					Abnormal: true,
+					Message:  message,
+				},
+			}, nil
+		}
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "failed to get stats of volume path %s: %v", volumePath, ctx.Err())
+		}
+		logger.With(zap.Error(err)).Errorf("failed to get %s volume stats on path %s", fsType, volumePath)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blockSize := int64(stats.Bsize)
+	return &csi.NodeGetVolumeStatsResponse{
+		Usage: []*csi.VolumeUsage{
+			{
+				Unit:      csi.VolumeUsage_BYTES,
+				Available: int64(stats.Bavail) * blockSize,
+				Total:     int64(stats.Blocks) * blockSize,
+				Used:      int64(stats.Blocks-stats.Bfree) * blockSize,
+			},
+			{
+				Unit:      csi.VolumeUsage_INODES,
+				Available: int64(stats.Ffree),
+				Total:     int64(stats.Files),
+				Used:      int64(stats.Files - stats.Ffree),
+			},
+		},
+		VolumeCondition: healthyVolumeCondition(),
+	}, nil
+}
+
+// statfsWithTimeout calls statfs on the path and gives up waiting for it once
+// the timeout expires or the context is done.
+func statfsWithTimeout(ctx context.Context, path string, timeout time.Duration) (*syscall.Statfs_t, error) {
+	if _, loaded := inflightStatfs.LoadOrStore(path, struct{}{}); loaded {
+		return nil, errStatfsTimeout
+	}
+
+	type result struct {
+		stats syscall.Statfs_t
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer inflightStatfs.Delete(path)
+		var r result
+		r.err = statfs(path, &r.stats)
+		done <- r
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		if r.err != nil {
+			return nil, r.err
+		}
+		return &r.stats, nil
+	case <-timer.C:
+		return nil, errStatfsTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// networkFilesystemCondition returns the volume condition message for errors
+// which indicate that the mount itself is unhealthy.
+func networkFilesystemCondition(err error, fsType string, volumePath string) (string, bool) {
+	switch {
+	case errors.Is(err, errStatfsTimeout):
+		if fsType == "lustre" {
+			return fmt.Sprintf("lustre mount at %s is not responding, the Lustre servers may be unreachable", volumePath), true
+		}
+		return fmt.Sprintf("%s mount at %s is not responding, the NFS server may be unreachable", fsType, volumePath), true
+	case errors.Is(err, syscall.ESTALE):
+		return fmt.Sprintf("%s mount at %s is stale: %v", fsType, volumePath, err), true
+	case errors.Is(err, syscall.EIO), errors.Is(err, syscall.ENOTCONN), errors.Is(err, syscall.ESHUTDOWN):
+		if fsType == "lustre" {
+			return fmt.Sprintf("lustre mount at %s is not accessible, the client may have been evicted: %v", volumePath, err), true
+		}
+		return fmt.Sprintf("%s mount at %s is not accessible: %v", fsType, volumePath, err), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"testing/synctest"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetNetworkFilesystemVolumeStats(t *testing.T) {
+	defer func() { statfs = syscall.Statfs }()
+
+	tests := []struct {
+		name          string
+		req           *csi.NodeGetVolumeStatsRequest
+		fsType        string
+		statfs        func(path string, buf *syscall.Statfs_t) error
+		wantCode      codes.Code
+		wantUsage     []*csi.VolumeUsage
+		wantAbnormal  bool
+		wantCondition string
+	}{
+		{
+			name:     "missing volume id",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumePath: "/mnt/volume"},
+			fsType:   "nfs",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing volume path",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumeId: "volume"},
+			fsType:   "nfs",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:   "healthy mount",
+			req:    &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: "/mnt/volume"},
+			fsType: "nfs",
+			statfs: func(path string, buf *syscall.Statfs_t) error {
+				buf.Bsize = 4096
+				buf.Blocks = 100
+				buf.Bfree = 40
+				buf.Bavail = 30
+				buf.Files = 1000
+				buf.Ffree = 900
+				return nil
+			},
+			wantUsage: []*csi.VolumeUsage{
+				{Unit: csi.VolumeUsage_BYTES, Available: 30 * 4096, Total: 100 * 4096, Used: 60 * 4096},
+				{Unit: csi.VolumeUsage_INODES, Available: 900, Total: 1000, Used: 100},
+			},
+			wantCondition: "volume is healthy",
+		},
+		{
+			name:     "path does not exist",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: "/mnt/volume"},
+			fsType:   "nfs",
+			statfs:   func(path string, buf *syscall.Statfs_t) error { return syscall.ENOENT },
+			wantCode: codes.NotFound,
+		},
+		{
+			name:          "stale nfs file handle",
+			req:           &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: "/mnt/volume"},
+			fsType:        "nfs",
+			statfs:        func(path string, buf *syscall.Statfs_t) error { return syscall.ESTALE },
+			wantAbnormal:  true,
+			wantCondition: "nfs mount at /mnt/volume is stale",
+		},
+		{
+			name:          "evicted lustre client",
+			req:           &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: "/mnt/volume"},
+			fsType:        "lustre",
+			statfs:        func(path string, buf *syscall.Statfs_t) error { return syscall.ESHUTDOWN },
+			wantAbnormal:  true,
+			wantCondition: "the client may have been evicted",
+		},
+		{
+			name:     "unexpected statfs error",
+			req:      &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: "/mnt/volume"},
+			fsType:   "nfs",
+			statfs:   func(path string, buf *syscall.Statfs_t) error { return syscall.EACCES },
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statfs = tt.statfs
+			resp, err := getNetworkFilesystemVolumeStats(context.Background(), zap.S(), tt.req, tt.fsType)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if len(resp.Usage) != len(tt.wantUsage) {
+				t.Fatalf("expected usage %v, got %v", tt.wantUsage, resp.Usage)
+			}
+			for i := range tt.wantUsage {
+				got, want := resp.Usage[i], tt.wantUsage[i]
+				if got.Unit != want.Unit || got.Available != want.Available || got.Total != want.Total || got.Used != want.Used {
+					t.Fatalf("expected usage %v, got %v", want, got)
+				}
+			}
+			if resp.VolumeCondition.GetAbnormal() != tt.wantAbnormal {
+				t.Fatalf("expected abnormal %v, got %v", tt.wantAbnormal, resp.VolumeCondition)
+			}
+			if !strings.Contains(resp.VolumeCondition.GetMessage(), tt.wantCondition) {
+				t.Fatalf("expected condition message to contain %q, got %q", tt.wantCondition, resp.VolumeCondition.GetMessage())
+			}
+		})
+	}
+}
+
+func TestGetNetworkFilesystemVolumeStats_HungMount(t *testing.T) {
+	defer func() { statfs = syscall.Statfs }()
+
+	synctest.Test(t, func(t *testing.T) {
+		release := make(chan struct{})
+		var calls atomic.Int32
+		statfs = func(path string, buf *syscall.Statfs_t) error {
+			calls.Add(1)
+			<-release
+			return nil
+		}
+		req := &csi.NodeGetVolumeStatsRequest{VolumeId: "volume", VolumePath: "/mnt/hung"}
+
+		for i := 0; i < 2; i++ {
+			resp, err := getNetworkFilesystemVolumeStats(context.Background(), zap.S(), req, "nfs")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !resp.VolumeCondition.GetAbnormal() || !strings.Contains(resp.VolumeCondition.GetMessage(), "not responding") {
+				t.Fatalf("expected not responding condition, got %v", resp.VolumeCondition)
+			}
+		}
+		// The second request must not pile up another blocked statfs call.
+		if got := calls.Load(); got != 1 {
+			t.Fatalf("expected 1 statfs call, got %d", got)
+		}
+
+		close(release)
+		synctest.Wait()
+		if _, inflight := inflightStatfs.Load("/mnt/hung"); inflight {
+			t.Fatalf("expected the in-flight statfs call to be cleared")
+		}
+	})
+}
+
+func TestNetworkFilesystemNodeGetCapabilities(t *testing.T) {
+	want := map[csi.NodeServiceCapability_RPC_Type]bool{
+		csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME: true,
+		csi.NodeServiceCapability_RPC_GET_VOLUME_STATS:     true,
+		csi.NodeServiceCapability_RPC_VOLUME_CONDITION:     true,
+	}
+	for name, getCapabilities := range map[string]func(context.Context, *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error){
+		"fss":    FSSNodeDriver{}.NodeGetCapabilities,
+		"lustre": LustreNodeDriver{}.NodeGetCapabilities,
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := getCapabilities(context.Background(), &csi.NodeGetCapabilitiesRequest{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(resp.Capabilities) != len(want) {
+				t.Fatalf("expected %d capabilities, got %v", len(want), resp.Capabilities)
+			}
+			for _, c := range resp.Capabilities {
+				if !want[c.GetRpc().GetType()] {
+					t.Fatalf("unexpected capability %v", c.GetRpc().GetType())
+				}
+			}
+		})
+	}
+}
@@ -76,6 +76,21 @@ const (
 	BlockSnapshotDelete = "BSNAP_DELETE"
 	// BlockSnapshotRestore is the OCI metric suffix for Block Volume Snapshot Restore
 	BlockSnapshotRestore = "BSNAP_RESTORE"
+	// BlockGroupSnapshotProvision is the OCI metric suffix for Block Volume Group Snapshot Provision
+	BlockGroupSnapshotProvision = "BGSNAP_PROVISION"
+	// BlockGroupSnapshotDelete is the OCI metric suffix for Block Volume Group Snapshot Delete
+	BlockGroupSnapshotDelete = "BGSNAP_DELETE"
+
+	// FSSSnapshotProvision is the OCI metric suffix for FSS Snapshot Provision
+	FSSSnapshotProvision = "FSS_SNAP_PROVISION"
+	// FSSSnapshotDelete is the OCI metric suffix for FSS Snapshot Delete
+	FSSSnapshotDelete = "FSS_SNAP_DELETE"
+	// FSSSnapshotRestore is the OCI metric suffix for FSS Snapshot Restore
+	FSSSnapshotRestore = "FSS_SNAP_RESTORE"
+	// FSSClone is the OCI metric suffix for FSS Clone
+	FSSClone = "FSS_CLONE"
+	// FSSExpand is the OCI metric suffix for FSS quota expansion
+	FSSExpand = "FSS_EXPAND"
 
 	// FssAllProvision is the OCI metric suffix for FSS end to end provision
 	FssAllProvision = "FSS_ALL_PROVISION"
@@ -87,6 +102,8 @@ const (
 	LustreProvision = "LUSTRE_PROVISION"
 	// LustreDelete is the OCI metric suffix for Lustre end to end deletion
 	LustreDelete = "LUSTRE_DELETE"
+	// LustreExpand is the OCI metric suffix for Lustre expansion
+	LustreExpand = "LUSTRE_EXPAND"
 
 	ResourceOCIDDimension     = "resourceOCID"
 	ComponentDimension        = "component"
@@ -40,6 +40,7 @@ const (
 	volumePollInterval       = 5 * time.Second
 	volumeBackupPollInterval = 5 * time.Second
 	volumeClonePollInterval  = 10 * time.Second
+	volumeGroupPollInterval  = 5 * time.Second
 	// OCIVolumeID is the name of the oci volume id.
 	OCIVolumeID = "ociVolumeID"
 	// OCIVolumeBackupID is the name of the oci volume backup id annotation.
@@ -54,18 +55,43 @@ const (
 type BlockStorageInterface interface {
 	AwaitVolumeAvailableORTimeout(ctx context.Context, id string) (*core.Volume, error)
 	AwaitVolumeHydratedOrTimeout(ctx context.Context, id string) (*core.Volume, error)
+	AwaitVolumeReplicasRemovedOrTimeout(ctx context.Context, id string) (*core.Volume, error)
 	CreateVolume(ctx context.Context, details core.CreateVolumeDetails) (*core.Volume, error)
 	DeleteVolume(ctx context.Context, id string) error
 	GetVolume(ctx context.Context, id string) (*core.Volume, error)
 	GetVolumesByName(ctx context.Context, volumeName, compartmentID string) ([]core.Volume, error)
+	ListVolumes(ctx context.Context, compartmentID string) ([]core.Volume, error)
 	UpdateVolume(ctx context.Context, volumeId string, details core.UpdateVolumeDetails) (*core.Volume, error)
 	GetBootVolume(ctx context.Context, id string) (*core.BootVolume, error)
+	GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error)
 
 	AwaitVolumeBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeBackup, error)
 	CreateVolumeBackup(ctx context.Context, details core.CreateVolumeBackupDetails) (*core.VolumeBackup, error)
 	DeleteVolumeBackup(ctx context.Context, id string) error
 	GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error)
 	GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error)
+	ListVolumeBackups(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeBackup, error)
+	UpdateVolumeBackup(ctx context.Context, id string, details core.UpdateVolumeBackupDetails) (*core.VolumeBackup, error)
+	CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error)
+
+	// InRegion returns the block storage client of another region.
+	InRegion(region string) (BlockStorageInterface, error)
+
+	ListVolumeBackupPolicies(ctx context.Context, compartmentID string) ([]core.VolumeBackupPolicy, error)
+	GetVolumeBackupPolicyAssetAssignment(ctx context.Context, assetID string) ([]core.VolumeBackupPolicyAssignment, error)
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, assetID, policyID string) (*core.VolumeBackupPolicyAssignment, error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error
+
+	AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error)
+	CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error)
+	DeleteVolumeGroup(ctx context.Context, id string) error
+	GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error)
+
+	AwaitVolumeGroupBackupCommittedOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error)
+	CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error)
+	DeleteVolumeGroupBackup(ctx context.Context, id string) error
+	GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error)
+	GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error)
 }
 
 func (c *client) GetVolume(ctx context.Context, id string) (*core.Volume, error) {
@@ -116,6 +142,29 @@ func (c *client) GetBootVolume(ctx context.Context, id string) (*core.BootVolume
 
 }
 
+func (c *client) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetBlockVolumeReplica")
+	}
+
+	resp, err := c.bs.GetBlockVolumeReplica(ctx, core.GetBlockVolumeReplicaRequest{
+		BlockVolumeReplicaId: &id,
+		RequestMetadata:      c.requestMetadata})
+	incRequestCounter(err, getVerb, blockVolumeReplicaResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", blockVolumeReplicaResource).
+			With("blockVolumeReplicaID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).Info("OPC Request ID recorded for GetBlockVolumeReplica call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.BlockVolumeReplica, nil
+}
+
 func (c *client) GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetVolumeBackup")
@@ -228,6 +277,36 @@ func (c *client) AwaitVolumeHydratedOrTimeout(ctx context.Context, id string) (*
 	return volume, nil
 }
 
+// AwaitVolumeReplicasRemovedOrTimeout waits until the replicas of the volume are
+// removed, it takes context as timeout
+func (c *client) AwaitVolumeReplicasRemovedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
+	var volume *core.Volume
+	if err := wait.PollImmediateUntil(volumePollInterval, func() (bool, error) {
+		var err error
+		volume, err = c.GetVolume(ctx, id)
+		if err != nil {
+			if !IsRetryable(err) {
+				return false, err
+			}
+			return false, nil
+		}
+
+		switch state := volume.LifecycleState; state {
+		case core.VolumeLifecycleStateAvailable:
+			return len(volume.BlockVolumeReplicas) == 0, nil
+		case core.VolumeLifecycleStateFaulty,
+			core.VolumeLifecycleStateTerminated,
+			core.VolumeLifecycleStateTerminating:
+			return false, errors.Errorf("volume did not become available (lifecycleState=%q)", state)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+
+	return volume, nil
+}
+
 func (c *client) CreateVolume(ctx context.Context, details core.CreateVolumeDetails) (*core.Volume, error) {
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(true, "CreateVolume")
@@ -343,6 +422,72 @@ func (c *client) DeleteVolumeBackup(ctx context.Context, id string) error {
 	return nil
 }
 
+func (c *client) UpdateVolumeBackup(ctx context.Context, id string, details core.UpdateVolumeBackupDetails) (*core.VolumeBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "UpdateVolumeBackup")
+	}
+
+	resp, err := c.bs.UpdateVolumeBackup(ctx, core.UpdateVolumeBackupRequest{
+		VolumeBackupId:            &id,
+		UpdateVolumeBackupDetails: details,
+		RequestMetadata:           c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, volumeBackupResource)
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeBackup, nil
+}
+
+// CopyVolumeBackup starts copying the volume backup to the destination region
+// and returns the copy, which is created in the compartment of the backup.
+func (c *client) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CopyVolumeBackup")
+	}
+
+	resp, err := c.bs.CopyVolumeBackup(ctx, core.CopyVolumeBackupRequest{
+		VolumeBackupId: &id,
+		CopyVolumeBackupDetails: core.CopyVolumeBackupDetails{
+			DestinationRegion: &destinationRegion,
+			DisplayName:       &displayName,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, volumeBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeBackupResource).
+			With("volumeBackupId", id, "destinationRegion", destinationRegion, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CopyVolumeBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeBackup, nil
+}
+
+func (c *client) InRegion(region string) (BlockStorageInterface, error) {
+	bs, ok := c.bs.(*core.BlockstorageClient)
+	if !ok {
+		return nil, errors.Errorf("block storage client can not be used in region %s", region)
+	}
+
+	regional := *bs
+	regional.SetRegion(region)
+	return &client{
+		bs:              &regional,
+		requestMetadata: c.requestMetadata,
+		rateLimiter:     c.rateLimiter,
+		logger:          c.logger.With("region", region),
+	}, nil
+}
+
 /*
  * TODO: Expand the API to be generic 'GetVolumes' with the following features as necessary
  * 1. Option to sort by display name or creation timestamp.
@@ -392,6 +537,51 @@ func (c *client) GetVolumesByName(ctx context.Context, volumeName, compartmentID
 	return volumeList, nil
 }
 
+// ListVolumes returns all the volumes in the given compartment that have not
+// been terminated.
+func (c *client) ListVolumes(ctx context.Context, compartmentID string) ([]core.Volume, error) {
+	var page *string
+	volumeList := make([]core.Volume, 0)
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumes")
+		}
+
+		listVolumeResponse, err := c.bs.ListVolumes(ctx,
+			core.ListVolumesRequest{
+				CompartmentId:   &compartmentID,
+				Page:            page,
+				RequestMetadata: c.requestMetadata,
+			})
+		incRequestCounter(err, listVerb, volumeResource)
+
+		if listVolumeResponse.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeResource).
+				With("CompartmentID", compartmentID, "OpcRequestId", *(listVolumeResponse.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while listing volumes.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volume := range listVolumeResponse.Items {
+			volumeState := volume.LifecycleState
+			if volumeState != core.VolumeLifecycleStateTerminating &&
+				volumeState != core.VolumeLifecycleStateTerminated {
+				volumeList = append(volumeList, volume)
+			}
+		}
+
+		if page = listVolumeResponse.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeList, nil
+}
+
 func (c *client) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
 	var page *string
 	volumeBackupList := make([]core.VolumeBackup, 0)
@@ -436,3 +626,434 @@ func (c *client) GetVolumeBackupsByName(ctx context.Context, snapshotName, compa
 
 	return volumeBackupList, nil
 }
+
+// ListVolumeBackups lists the volume backups in the compartment which are not
+// terminated or being terminated. An empty volume ID lists the backups of all
+// volumes.
+func (c *client) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeBackup, error) {
+	var page *string
+	volumeBackupList := make([]core.VolumeBackup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeBackups")
+		}
+
+		req := core.ListVolumeBackupsRequest{
+			CompartmentId:   &compartmentID,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		}
+		if volumeID != "" {
+			req.VolumeId = &volumeID
+		}
+
+		listVolumeBackupsResponse, err := c.bs.ListVolumeBackups(ctx, req)
+		incRequestCounter(err, listVerb, volumeBackupResource)
+
+		if listVolumeBackupsResponse.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupResource).
+				With("volumeID", volumeID, "CompartmentID", compartmentID, "OpcRequestId", *(listVolumeBackupsResponse.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while listing volume backups.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeBackup := range listVolumeBackupsResponse.Items {
+			volumeState := volumeBackup.LifecycleState
+			if volumeState != core.VolumeBackupLifecycleStateTerminating &&
+				volumeState != core.VolumeBackupLifecycleStateTerminated {
+				volumeBackupList = append(volumeBackupList, volumeBackup)
+			}
+		}
+
+		if page = listVolumeBackupsResponse.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeBackupList, nil
+}
+
+// ListVolumeBackupPolicies lists the volume backup policies of the compartment.
+// The Oracle defined policies (gold, silver and bronze) are listed when the
+// compartment is empty.
+func (c *client) ListVolumeBackupPolicies(ctx context.Context, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	var page *string
+	policies := make([]core.VolumeBackupPolicy, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeBackupPolicies")
+		}
+
+		req := core.ListVolumeBackupPoliciesRequest{
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		}
+		if compartmentID != "" {
+			req.CompartmentId = &compartmentID
+		}
+
+		resp, err := c.bs.ListVolumeBackupPolicies(ctx, req)
+		incRequestCounter(err, listVerb, volumeBackupPolicyResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupPolicyResource).
+				With("CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while listing volume backup policies.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		policies = append(policies, resp.Items...)
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return policies, nil
+}
+
+// GetVolumeBackupPolicyAssetAssignment returns the backup policy assignments
+// of the volume. A volume has at most one assignment.
+func (c *client) GetVolumeBackupPolicyAssetAssignment(ctx context.Context, assetID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	var page *string
+	assignments := make([]core.VolumeBackupPolicyAssignment, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "GetVolumeBackupPolicyAssetAssignment")
+		}
+
+		resp, err := c.bs.GetVolumeBackupPolicyAssetAssignment(ctx, core.GetVolumeBackupPolicyAssetAssignmentRequest{
+			AssetId:         &assetID,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, getVerb, volumeBackupPolicyAssignmentResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeBackupPolicyAssignmentResource).
+				With("volumeID", assetID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded for GetVolumeBackupPolicyAssetAssignment call.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		assignments = append(assignments, resp.Items...)
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return assignments, nil
+}
+
+func (c *client) CreateVolumeBackupPolicyAssignment(ctx context.Context, assetID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeBackupPolicyAssignment")
+	}
+
+	resp, err := c.bs.CreateVolumeBackupPolicyAssignment(ctx, core.CreateVolumeBackupPolicyAssignmentRequest{
+		CreateVolumeBackupPolicyAssignmentDetails: core.CreateVolumeBackupPolicyAssignmentDetails{
+			AssetId:  &assetID,
+			PolicyId: &policyID,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, volumeBackupPolicyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeBackupPolicyAssignmentResource).
+			With("volumeID", assetID, "policyID", policyID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateVolumeBackupPolicyAssignment call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeBackupPolicyAssignment, nil
+}
+
+func (c *client) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeBackupPolicyAssignment")
+	}
+
+	resp, err := c.bs.DeleteVolumeBackupPolicyAssignment(ctx, core.DeleteVolumeBackupPolicyAssignmentRequest{
+		PolicyAssignmentId: &id,
+		RequestMetadata:    c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, volumeBackupPolicyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeBackupPolicyAssignmentResource).
+			With("policyAssignmentID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeBackupPolicyAssignment call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *client) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeGroup")
+	}
+
+	resp, err := c.bs.GetVolumeGroup(ctx, core.GetVolumeGroupRequest{
+		VolumeGroupId:   &id,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, getVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeGroupResource).
+			With("volumeGroupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeGroup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroup, nil
+}
+
+func (c *client) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeGroup")
+	}
+
+	resp, err := c.bs.CreateVolumeGroup(ctx, core.CreateVolumeGroupRequest{CreateVolumeGroupDetails: details,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, createVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeGroupResource).
+			With("volumeGroupName", *(details.DisplayName), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).Info("OPC Request ID recorded for CreateVolumeGroup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroup, nil
+}
+
+func (c *client) DeleteVolumeGroup(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeGroup")
+	}
+
+	resp, err := c.bs.DeleteVolumeGroup(ctx, core.DeleteVolumeGroupRequest{
+		VolumeGroupId:   &id,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, deleteVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeGroupResource).
+			With("volumeGroupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeGroup call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// AwaitVolumeGroupAvailableOrTimeout takes context as timeout
+func (c *client) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	var volumeGroup *core.VolumeGroup
+	if err := wait.PollImmediateUntil(volumeGroupPollInterval, func() (bool, error) {
+		var err error
+		volumeGroup, err = c.GetVolumeGroup(ctx, id)
+		if err != nil {
+			if !IsRetryable(err) {
+				return false, err
+			}
+			return false, nil
+		}
+
+		switch state := volumeGroup.LifecycleState; state {
+		case core.VolumeGroupLifecycleStateAvailable:
+			return true, nil
+		case core.VolumeGroupLifecycleStateFaulty,
+			core.VolumeGroupLifecycleStateTerminated,
+			core.VolumeGroupLifecycleStateTerminating:
+			return false, errors.Errorf("volume group did not become available (lifecycleState=%q)", state)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+
+	return volumeGroup, nil
+}
+
+func (c *client) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.GetVolumeGroupBackup(ctx, core.GetVolumeGroupBackupRequest{
+		VolumeGroupBackupId: &id,
+		RequestMetadata:     c.requestMetadata})
+	incRequestCounter(err, getVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroupBackup, nil
+}
+
+func (c *client) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.CreateVolumeGroupBackup(ctx, core.CreateVolumeGroupBackupRequest{CreateVolumeGroupBackupDetails: details,
+		RequestMetadata: c.requestMetadata})
+	incRequestCounter(err, createVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupName", *(details.DisplayName), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).Info("OPC Request ID recorded for CreateVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.VolumeGroupBackup, nil
+}
+
+func (c *client) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.DeleteVolumeGroupBackup(ctx, core.DeleteVolumeGroupBackupRequest{
+		VolumeGroupBackupId: &id,
+		RequestMetadata:     c.requestMetadata})
+	incRequestCounter(err, deleteVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupId", id, "OpcRequestId", *(resp.OpcRequestId)).With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// AwaitVolumeGroupBackupCommittedOrTimeout waits until the point in time of
+// the volume group backup has been captured, which is when the backups of its
+// volumes are known. It takes context as timeout.
+func (c *client) AwaitVolumeGroupBackupCommittedOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	var volumeGroupBackup *core.VolumeGroupBackup
+	if err := wait.PollImmediateUntil(volumeBackupPollInterval, func() (bool, error) {
+		var err error
+		volumeGroupBackup, err = c.GetVolumeGroupBackup(ctx, id)
+		if err != nil {
+			if !IsRetryable(err) {
+				return false, err
+			}
+			return false, nil
+		}
+
+		switch state := volumeGroupBackup.LifecycleState; state {
+		case core.VolumeGroupBackupLifecycleStateCommitted,
+			core.VolumeGroupBackupLifecycleStateAvailable:
+			return true, nil
+		case core.VolumeGroupBackupLifecycleStateFaulty,
+			core.VolumeGroupBackupLifecycleStateTerminated,
+			core.VolumeGroupBackupLifecycleStateTerminating:
+			return false, errors.Errorf("volume group backup did not become committed (lifecycleState=%q)", state)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+
+	return volumeGroupBackup, nil
+}
+
+func (c *client) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	var page *string
+	volumeGroupBackupList := make([]core.VolumeGroupBackup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeGroupBackups")
+		}
+
+		listVolumeGroupBackupsResponse, err := c.bs.ListVolumeGroupBackups(ctx,
+			core.ListVolumeGroupBackupsRequest{
+				CompartmentId:   &compartmentID,
+				Page:            page,
+				DisplayName:     &groupSnapshotName,
+				RequestMetadata: c.requestMetadata,
+			})
+		incRequestCounter(err, listVerb, volumeGroupBackupResource)
+
+		if listVolumeGroupBackupsResponse.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeGroupBackupResource).
+				With("groupSnapshotName", groupSnapshotName, "CompartmentID", compartmentID, "OpcRequestId", *(listVolumeGroupBackupsResponse.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume group backups by name.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeGroupBackup := range listVolumeGroupBackupsResponse.Items {
+			switch volumeGroupBackup.LifecycleState {
+			case core.VolumeGroupBackupLifecycleStateRequestReceived,
+				core.VolumeGroupBackupLifecycleStateCreating,
+				core.VolumeGroupBackupLifecycleStateCommitted,
+				core.VolumeGroupBackupLifecycleStateAvailable:
+				volumeGroupBackupList = append(volumeGroupBackupList, volumeGroupBackup)
+			}
+		}
+
+		if page = listVolumeGroupBackupsResponse.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeGroupBackupList, nil
+}
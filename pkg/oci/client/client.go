@@ -24,6 +24,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/oracle/oci-go-sdk/v65/filestorage"
 	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/limits"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/lustrefilestorage"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
@@ -51,6 +52,7 @@ type Interface interface {
 	FSS(*OCIClientConfig) FileStorageInterface
 	Lustre() LustreInterface
 	Identity(*OCIClientConfig) IdentityInterface
+	Limits() LimitsInterface
 }
 
 type OCIClientConfig struct {
@@ -84,6 +86,8 @@ type virtualNetworkClient interface {
 	GetVcn(ctx context.Context, request core.GetVcnRequest) (response core.GetVcnResponse, err error)
 	GetSecurityList(ctx context.Context, request core.GetSecurityListRequest) (response core.GetSecurityListResponse, err error)
 	UpdateSecurityList(ctx context.Context, request core.UpdateSecurityListRequest) (response core.UpdateSecurityListResponse, err error)
+	GetRouteTable(ctx context.Context, request core.GetRouteTableRequest) (response core.GetRouteTableResponse, err error)
+	UpdateRouteTable(ctx context.Context, request core.UpdateRouteTableRequest) (response core.UpdateRouteTableResponse, err error)
 
 	GetPrivateIp(ctx context.Context, request core.GetPrivateIpRequest) (response core.GetPrivateIpResponse, err error)
 	ListPrivateIps(ctx context.Context, request core.ListPrivateIpsRequest) (response core.ListPrivateIpsResponse, err error)
@@ -113,6 +117,7 @@ type loadBalancerClient interface {
 	DeleteLoadBalancer(ctx context.Context, request loadbalancer.DeleteLoadBalancerRequest) (response loadbalancer.DeleteLoadBalancerResponse, err error)
 	ListCertificates(ctx context.Context, request loadbalancer.ListCertificatesRequest) (response loadbalancer.ListCertificatesResponse, err error)
 	CreateCertificate(ctx context.Context, request loadbalancer.CreateCertificateRequest) (response loadbalancer.CreateCertificateResponse, err error)
+	DeleteCertificate(ctx context.Context, request loadbalancer.DeleteCertificateRequest) (response loadbalancer.DeleteCertificateResponse, err error)
 	GetWorkRequest(ctx context.Context, request loadbalancer.GetWorkRequestRequest) (response loadbalancer.GetWorkRequestResponse, err error)
 	ListWorkRequests(ctx context.Context, request loadbalancer.ListWorkRequestsRequest) (response loadbalancer.ListWorkRequestsResponse, err error)
 	CreateBackendSet(ctx context.Context, request loadbalancer.CreateBackendSetRequest) (response loadbalancer.CreateBackendSetResponse, err error)
@@ -124,6 +129,15 @@ type loadBalancerClient interface {
 	CreateRuleSet(ctx context.Context, request loadbalancer.CreateRuleSetRequest) (response loadbalancer.CreateRuleSetResponse, err error)
 	UpdateRuleSet(ctx context.Context, request loadbalancer.UpdateRuleSetRequest) (response loadbalancer.UpdateRuleSetResponse, err error)
 	DeleteRuleSet(ctx context.Context, request loadbalancer.DeleteRuleSetRequest) (response loadbalancer.DeleteRuleSetResponse, err error)
+	CreateHostname(ctx context.Context, request loadbalancer.CreateHostnameRequest) (response loadbalancer.CreateHostnameResponse, err error)
+	UpdateHostname(ctx context.Context, request loadbalancer.UpdateHostnameRequest) (response loadbalancer.UpdateHostnameResponse, err error)
+	DeleteHostname(ctx context.Context, request loadbalancer.DeleteHostnameRequest) (response loadbalancer.DeleteHostnameResponse, err error)
+	CreatePathRouteSet(ctx context.Context, request loadbalancer.CreatePathRouteSetRequest) (response loadbalancer.CreatePathRouteSetResponse, err error)
+	UpdatePathRouteSet(ctx context.Context, request loadbalancer.UpdatePathRouteSetRequest) (response loadbalancer.UpdatePathRouteSetResponse, err error)
+	DeletePathRouteSet(ctx context.Context, request loadbalancer.DeletePathRouteSetRequest) (response loadbalancer.DeletePathRouteSetResponse, err error)
+	CreateRoutingPolicy(ctx context.Context, request loadbalancer.CreateRoutingPolicyRequest) (response loadbalancer.CreateRoutingPolicyResponse, err error)
+	UpdateRoutingPolicy(ctx context.Context, request loadbalancer.UpdateRoutingPolicyRequest) (response loadbalancer.UpdateRoutingPolicyResponse, err error)
+	DeleteRoutingPolicy(ctx context.Context, request loadbalancer.DeleteRoutingPolicyRequest) (response loadbalancer.DeleteRoutingPolicyResponse, err error)
 	UpdateLoadBalancerShape(ctx context.Context, request loadbalancer.UpdateLoadBalancerShapeRequest) (response loadbalancer.UpdateLoadBalancerShapeResponse, err error)
 	UpdateNetworkSecurityGroups(ctx context.Context, request loadbalancer.UpdateNetworkSecurityGroupsRequest) (response loadbalancer.UpdateNetworkSecurityGroupsResponse, err error)
 	UpdateLoadBalancer(ctx context.Context, request loadbalancer.UpdateLoadBalancerRequest) (response loadbalancer.UpdateLoadBalancerResponse, err error)
@@ -161,6 +175,16 @@ type filestorageClient interface {
 	CreateMountTarget(ctx context.Context, request filestorage.CreateMountTargetRequest) (response filestorage.CreateMountTargetResponse, err error)
 	DeleteMountTarget(ctx context.Context, request filestorage.DeleteMountTargetRequest) (response filestorage.DeleteMountTargetResponse, err error)
 	ListMountTargets(ctx context.Context, request filestorage.ListMountTargetsRequest) (response filestorage.ListMountTargetsResponse, err error)
+
+	CreateSnapshot(ctx context.Context, request filestorage.CreateSnapshotRequest) (response filestorage.CreateSnapshotResponse, err error)
+	GetSnapshot(ctx context.Context, request filestorage.GetSnapshotRequest) (response filestorage.GetSnapshotResponse, err error)
+	ListSnapshots(ctx context.Context, request filestorage.ListSnapshotsRequest) (response filestorage.ListSnapshotsResponse, err error)
+	DeleteSnapshot(ctx context.Context, request filestorage.DeleteSnapshotRequest) (response filestorage.DeleteSnapshotResponse, err error)
+
+	ToggleQuotaRules(ctx context.Context, request filestorage.ToggleQuotaRulesRequest) (response filestorage.ToggleQuotaRulesResponse, err error)
+	ListQuotaRules(ctx context.Context, request filestorage.ListQuotaRulesRequest) (response filestorage.ListQuotaRulesResponse, err error)
+	CreateQuotaRule(ctx context.Context, request filestorage.CreateQuotaRuleRequest) (response filestorage.CreateQuotaRuleResponse, err error)
+	UpdateQuotaRule(ctx context.Context, request filestorage.UpdateQuotaRuleRequest) (response filestorage.UpdateQuotaRuleResponse, err error)
 }
 
 type blockstorageClient interface {
@@ -170,11 +194,27 @@ type blockstorageClient interface {
 	ListVolumes(ctx context.Context, request core.ListVolumesRequest) (response core.ListVolumesResponse, err error)
 	UpdateVolume(ctx context.Context, request core.UpdateVolumeRequest) (response core.UpdateVolumeResponse, err error)
 	GetBootVolume(ctx context.Context, request core.GetBootVolumeRequest) (response core.GetBootVolumeResponse, err error)
+	GetBlockVolumeReplica(ctx context.Context, request core.GetBlockVolumeReplicaRequest) (response core.GetBlockVolumeReplicaResponse, err error)
 
 	GetVolumeBackup(ctx context.Context, request core.GetVolumeBackupRequest) (response core.GetVolumeBackupResponse, err error)
 	CreateVolumeBackup(ctx context.Context, request core.CreateVolumeBackupRequest) (response core.CreateVolumeBackupResponse, err error)
 	DeleteVolumeBackup(ctx context.Context, request core.DeleteVolumeBackupRequest) (response core.DeleteVolumeBackupResponse, err error)
 	ListVolumeBackups(ctx context.Context, request core.ListVolumeBackupsRequest) (response core.ListVolumeBackupsResponse, err error)
+	UpdateVolumeBackup(ctx context.Context, request core.UpdateVolumeBackupRequest) (response core.UpdateVolumeBackupResponse, err error)
+	CopyVolumeBackup(ctx context.Context, request core.CopyVolumeBackupRequest) (response core.CopyVolumeBackupResponse, err error)
+
+	ListVolumeBackupPolicies(ctx context.Context, request core.ListVolumeBackupPoliciesRequest) (response core.ListVolumeBackupPoliciesResponse, err error)
+	GetVolumeBackupPolicyAssetAssignment(ctx context.Context, request core.GetVolumeBackupPolicyAssetAssignmentRequest) (response core.GetVolumeBackupPolicyAssetAssignmentResponse, err error)
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, request core.CreateVolumeBackupPolicyAssignmentRequest) (response core.CreateVolumeBackupPolicyAssignmentResponse, err error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, request core.DeleteVolumeBackupPolicyAssignmentRequest) (response core.DeleteVolumeBackupPolicyAssignmentResponse, err error)
+
+	GetVolumeGroup(ctx context.Context, request core.GetVolumeGroupRequest) (response core.GetVolumeGroupResponse, err error)
+	CreateVolumeGroup(ctx context.Context, request core.CreateVolumeGroupRequest) (response core.CreateVolumeGroupResponse, err error)
+	DeleteVolumeGroup(ctx context.Context, request core.DeleteVolumeGroupRequest) (response core.DeleteVolumeGroupResponse, err error)
+	GetVolumeGroupBackup(ctx context.Context, request core.GetVolumeGroupBackupRequest) (response core.GetVolumeGroupBackupResponse, err error)
+	CreateVolumeGroupBackup(ctx context.Context, request core.CreateVolumeGroupBackupRequest) (response core.CreateVolumeGroupBackupResponse, err error)
+	DeleteVolumeGroupBackup(ctx context.Context, request core.DeleteVolumeGroupBackupRequest) (response core.DeleteVolumeGroupBackupResponse, err error)
+	ListVolumeGroupBackups(ctx context.Context, request core.ListVolumeGroupBackupsRequest) (response core.ListVolumeGroupBackupsResponse, err error)
 }
 
 type identityClient interface {
@@ -196,12 +236,14 @@ type client struct {
 	identity            identityClient
 	//compartment 		compartmentClient
 	lustre lustrefilestorage.LustreFileStorageClient
+	limits limitsClient
 
 	requestMetadata common.RequestMetadata
 	rateLimiter     RateLimiter
 
-	subnetCache cache.Store
-	logger      *zap.SugaredLogger
+	subnetCache               cache.Store
+	resourceAvailabilityCache cache.Store
+	logger                    *zap.SugaredLogger
 }
 
 // New constructs an OCI API client.
@@ -297,6 +339,16 @@ func New(logger *zap.SugaredLogger, cp common.ConfigurationProvider, opRateLimit
 	if err != nil {
 		return nil, errors.Wrap(err, "configuring lustre file storage client custom transport")
 	}
+
+	limitsClient, err := limits.NewLimitsClientWithConfigurationProvider(cp)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewLimitsClientWithConfigurationProvider")
+	}
+
+	err = configureCustomTransport(logger, &limitsClient.BaseClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "configuring limits service client custom transport")
+	}
 	requestMetadata := common.RequestMetadata{
 		RetryPolicy: newRetryPolicy(),
 	}
@@ -314,11 +366,13 @@ func New(logger *zap.SugaredLogger, cp common.ConfigurationProvider, opRateLimit
 		filestorage:         &fss,
 		//compartment:     	 &compartment,
 		lustre:          lustreClient,
+		limits:          &limitsClient,
 		rateLimiter:     *opRateLimiter,
 		requestMetadata: requestMetadata,
 
-		subnetCache: cache.NewTTLStore(subnetCacheKeyFn, time.Duration(24)*time.Hour),
-		logger:      logger,
+		subnetCache:               cache.NewTTLStore(subnetCacheKeyFn, time.Duration(24)*time.Hour),
+		resourceAvailabilityCache: cache.NewTTLStore(resourceAvailabilityCacheKeyFn, resourceAvailabilityCacheTTL),
+		logger:                    logger,
 	}
 
 	return c, nil
@@ -428,6 +482,10 @@ func (c *client) Compute() ComputeInterface {
 	return c
 }
 
+func (c *client) Limits() LimitsInterface {
+	return c
+}
+
 func (c *client) Identity(ociClientConfig *OCIClientConfig) IdentityInterface {
 
 	if ociClientConfig == nil {
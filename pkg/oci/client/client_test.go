@@ -227,6 +227,14 @@ func (c *mockVirtualNetworkClient) UpdateSecurityList(ctx context.Context, reque
 	return core.UpdateSecurityListResponse{}, nil
 }
 
+func (c *mockVirtualNetworkClient) GetRouteTable(ctx context.Context, request core.GetRouteTableRequest) (response core.GetRouteTableResponse, err error) {
+	return core.GetRouteTableResponse{}, nil
+}
+
+func (c *mockVirtualNetworkClient) UpdateRouteTable(ctx context.Context, request core.UpdateRouteTableRequest) (response core.UpdateRouteTableResponse, err error) {
+	return core.UpdateRouteTableResponse{}, nil
+}
+
 func (c *mockVirtualNetworkClient) GetPrivateIp(ctx context.Context, request core.GetPrivateIpRequest) (response core.GetPrivateIpResponse, err error) {
 	return core.GetPrivateIpResponse{}, nil
 }
@@ -76,6 +76,28 @@ func IsNotFound(err error) bool {
 	return ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound
 }
 
+// IsPreconditionFailed returns true if the given error indicates that an
+// If-Match conditional request was rejected because the etag was stale.
+func IsPreconditionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	serviceErr, ok := common.IsServiceError(errors.Cause(err))
+	return ok && serviceErr.GetHTTPStatusCode() == http.StatusPreconditionFailed
+}
+
+// IsConflict returns true if the given error indicates that the request
+// conflicts with the current state of the resource.
+func IsConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	serviceErr, ok := common.IsServiceError(errors.Cause(err))
+	return ok && serviceErr.GetHTTPStatusCode() == http.StatusConflict
+}
+
 // IsRetryable returns true if the given error is retriable.
 func IsRetryable(err error) bool {
 	if err == nil {
@@ -39,6 +39,7 @@ type FileStorageInterface interface {
 	GetFileSystem(ctx context.Context, id string) (*fss.FileSystem, error)
 	GetFileSystemSummaryByDisplayName(ctx context.Context, compartmentID, ad, displayName string) (bool, []fss.FileSystemSummary, error)
 	AwaitFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.FileSystem, error)
+	AwaitFileSystemHydrated(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.FileSystem, error)
 	CreateFileSystem(ctx context.Context, details fss.CreateFileSystemDetails) (*fss.FileSystem, error)
 	DeleteFileSystem(ctx context.Context, id string) error
 
@@ -51,6 +52,17 @@ type FileStorageInterface interface {
 	CreateMountTarget(ctx context.Context, details fss.CreateMountTargetDetails) (*fss.MountTarget, error)
 	DeleteMountTarget(ctx context.Context, id string) error
 	GetMountTargetSummaryByDisplayName(ctx context.Context, compartmentID, ad, mountTargetName string) (bool, []fss.MountTargetSummary, error)
+
+	CreateSnapshot(ctx context.Context, details fss.CreateSnapshotDetails) (*fss.Snapshot, error)
+	GetSnapshot(ctx context.Context, id string) (*fss.Snapshot, error)
+	ListSnapshots(ctx context.Context, fileSystemID string) ([]fss.SnapshotSummary, error)
+	AwaitSnapshotActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.Snapshot, error)
+	DeleteSnapshot(ctx context.Context, id string) error
+
+	ToggleQuotaRules(ctx context.Context, fileSystemID string, enabled bool) error
+	ListQuotaRules(ctx context.Context, fileSystemID string, principalType fss.ListQuotaRulesPrincipalTypeEnum) ([]fss.QuotaRuleSummary, error)
+	CreateQuotaRule(ctx context.Context, fileSystemID string, details fss.CreateQuotaRuleDetails) (*fss.QuotaRule, error)
+	UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, details fss.UpdateQuotaRuleDetails) (*fss.QuotaRule, error)
 }
 
 func (c *client) CreateFileSystem(ctx context.Context, details fss.CreateFileSystemDetails) (*fss.FileSystem, error) {
@@ -133,6 +145,39 @@ func (c *client) AwaitFileSystemActive(ctx context.Context, logger *zap.SugaredL
 	return fs, nil
 }
 
+// AwaitFileSystemHydrated waits for a cloned file system to be hydrated, i.e.
+// for all of its data to be copied from the source snapshot.
+func (c *client) AwaitFileSystemHydrated(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.FileSystem, error) {
+	logger.Info("Waiting for FileSystem to be hydrated")
+
+	var fs *fss.FileSystem
+	err := wait.PollImmediateUntil(defaultInterval, func() (bool, error) {
+		logger.Debug("Polling FileSystem hydration")
+
+		var err error
+		fs, err = c.GetFileSystem(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		switch state := fs.LifecycleState; state {
+		case fss.FileSystemLifecycleStateDeleting, fss.FileSystemLifecycleStateDeleted:
+			return false, errors.Errorf("file system %q is in lifecycle state %q", *fs.Id, state)
+		}
+		if fs.IsHydrated != nil && *fs.IsHydrated {
+			logger.Info("FileSystem is hydrated")
+			return true, nil
+		}
+		logger.Debug("FileSystem is not hydrated yet")
+		return false, nil
+	}, ctx.Done())
+	if err != nil {
+		return nil, err
+	}
+
+	return fs, nil
+}
+
 func (c *client) GetFileSystemSummaryByDisplayName(ctx context.Context, compartmentID, ad, displayName string) (bool, []fss.FileSystemSummary, error) {
 
 	var page *string
@@ -517,3 +562,268 @@ func (c *client) GetMountTargetSummaryByDisplayName(ctx context.Context, compart
 
 	return foundConflicting, mountTargetSummaries, nil
 }
+
+func (c *client) CreateSnapshot(ctx context.Context, details fss.CreateSnapshotDetails) (*fss.Snapshot, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateSnapshot")
+	}
+
+	resp, err := c.filestorage.CreateSnapshot(ctx, fss.CreateSnapshotRequest{
+		CreateSnapshotDetails: details,
+		RequestMetadata:       c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, snapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", createVerb, "resource", snapshotResource).
+			With("fssID", *(details.FileSystemId), "snapshotName", *(details.Name), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateSnapshot call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.Snapshot, nil
+}
+
+func (c *client) GetSnapshot(ctx context.Context, id string) (*fss.Snapshot, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetSnapshot")
+	}
+
+	resp, err := c.filestorage.GetSnapshot(ctx, fss.GetSnapshotRequest{
+		SnapshotId:      &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, snapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", getVerb, "resource", snapshotResource).
+			With("snapshotID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetSnapshot call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.Snapshot, nil
+}
+
+// ListSnapshots returns the snapshots of the given file system that are not
+// being deleted.
+func (c *client) ListSnapshots(ctx context.Context, fileSystemID string) ([]fss.SnapshotSummary, error) {
+	var page *string
+	snapshots := make([]fss.SnapshotSummary, 0)
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListSnapshots")
+		}
+
+		resp, err := c.filestorage.ListSnapshots(ctx, fss.ListSnapshotsRequest{
+			FileSystemId:    &fileSystemID,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, snapshotResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "fss", "verb", listVerb, "resource", snapshotResource).
+				With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded for ListSnapshots call.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, snapshot := range resp.Items {
+			if snapshot.LifecycleState == fss.SnapshotSummaryLifecycleStateDeleting ||
+				snapshot.LifecycleState == fss.SnapshotSummaryLifecycleStateDeleted {
+				continue
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (c *client) AwaitSnapshotActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*fss.Snapshot, error) {
+	logger.Infof("Waiting for Snapshot to be in lifecycle state %q", fss.SnapshotLifecycleStateActive)
+
+	var snapshot *fss.Snapshot
+	if err := wait.PollImmediateUntil(defaultInterval, func() (bool, error) {
+		logger.Debug("Polling Snapshot lifecycle state")
+
+		var err error
+		snapshot, err = c.GetSnapshot(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		switch state := snapshot.LifecycleState; state {
+		case fss.SnapshotLifecycleStateActive:
+			logger.Infof("Snapshot is in lifecycle state %q", state)
+			return true, nil
+		case fss.SnapshotLifecycleStateDeleting, fss.SnapshotLifecycleStateDeleted:
+			logger.Errorf("Snapshot is in lifecycle state %q", state)
+			return false, fmt.Errorf("snapshot %q is in lifecycle state %q", *snapshot.Id, state)
+		default:
+			logger.Debugf("Snapshot is in lifecycle state %q", state)
+			return false, nil
+		}
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func (c *client) DeleteSnapshot(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteSnapshot")
+	}
+
+	resp, err := c.filestorage.DeleteSnapshot(ctx, fss.DeleteSnapshotRequest{
+		SnapshotId:      &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, snapshotResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", deleteVerb, "resource", snapshotResource).
+			With("snapshotID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteSnapshot call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *client) ToggleQuotaRules(ctx context.Context, fileSystemID string, enabled bool) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "ToggleQuotaRules")
+	}
+
+	resp, err := c.filestorage.ToggleQuotaRules(ctx, fss.ToggleQuotaRulesRequest{
+		FileSystemId:            &fileSystemID,
+		ToggleQuotaRulesDetails: fss.ToggleQuotaRulesDetails{AreQuotaRulesEnabled: &enabled},
+		RequestMetadata:         c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, fileSystemResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", updateVerb, "resource", fileSystemResource).
+			With("fssID", fileSystemID, "areQuotaRulesEnabled", enabled, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for ToggleQuotaRules call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *client) ListQuotaRules(ctx context.Context, fileSystemID string, principalType fss.ListQuotaRulesPrincipalTypeEnum) ([]fss.QuotaRuleSummary, error) {
+	var page *string
+	quotaRules := make([]fss.QuotaRuleSummary, 0)
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListQuotaRules")
+		}
+
+		resp, err := c.filestorage.ListQuotaRules(ctx, fss.ListQuotaRulesRequest{
+			FileSystemId:    &fileSystemID,
+			PrincipalType:   principalType,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, quotaRuleResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "fss", "verb", listVerb, "resource", quotaRuleResource).
+				With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded for ListQuotaRules call.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		quotaRules = append(quotaRules, resp.Items...)
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return quotaRules, nil
+}
+
+func (c *client) CreateQuotaRule(ctx context.Context, fileSystemID string, details fss.CreateQuotaRuleDetails) (*fss.QuotaRule, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateQuotaRule")
+	}
+
+	resp, err := c.filestorage.CreateQuotaRule(ctx, fss.CreateQuotaRuleRequest{
+		FileSystemId:           &fileSystemID,
+		CreateQuotaRuleDetails: details,
+		RequestMetadata:        c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, quotaRuleResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", createVerb, "resource", quotaRuleResource).
+			With("fssID", fileSystemID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateQuotaRule call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.QuotaRule, nil
+}
+
+func (c *client) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, details fss.UpdateQuotaRuleDetails) (*fss.QuotaRule, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "UpdateQuotaRule")
+	}
+
+	resp, err := c.filestorage.UpdateQuotaRule(ctx, fss.UpdateQuotaRuleRequest{
+		FileSystemId:           &fileSystemID,
+		QuotaRuleId:            &quotaRuleID,
+		UpdateQuotaRuleDetails: details,
+		RequestMetadata:        c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, quotaRuleResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "fss", "verb", updateVerb, "resource", quotaRuleResource).
+			With("fssID", fileSystemID, "quotaRuleID", quotaRuleID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for UpdateQuotaRule call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.QuotaRule, nil
+}
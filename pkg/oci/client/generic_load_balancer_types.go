@@ -29,7 +29,8 @@ type GenericBackendSetDetails struct {
 	Backends                        []GenericBackend
 	SessionPersistenceConfiguration *GenericSessionPersistenceConfiguration
 	// Only needed for LB
-	SslConfiguration *GenericSslConfigurationDetails
+	LbCookieSessionPersistenceConfiguration *GenericLbCookieSessionPersistenceConfiguration
+	SslConfiguration                        *GenericSslConfigurationDetails
 	// Only needed for NLB
 	IsPreserveSource *bool
 	IpVersion        *GenericIpVersion
@@ -42,6 +43,16 @@ type GenericSessionPersistenceConfiguration struct {
 	DisableFallback *bool
 }
 
+type GenericLbCookieSessionPersistenceConfiguration struct {
+	CookieName      *string
+	DisableFallback *bool
+	Domain          *string
+	Path            *string
+	MaxAgeInSeconds *int
+	IsSecure        *bool
+	IsHttpOnly      *bool
+}
+
 type GenericHealthChecker struct {
 	Protocol          string
 	IsForcePlainText  *bool
@@ -117,8 +128,10 @@ type GenericCreateLoadBalancerDetails struct {
 	IpVersion                   *GenericIpVersion
 
 	// Only needed for LB
-	Certificates map[string]GenericCertificate
-	RuleSets     map[string]loadbalancer.RuleSetDetails
+	Certificates  map[string]GenericCertificate
+	RuleSets      map[string]loadbalancer.RuleSetDetails
+	Hostnames     map[string]loadbalancer.HostnameDetails
+	PathRouteSets map[string]loadbalancer.PathRouteSetDetails
 	// Supported only in NLB
 	AssignedPrivateIpv4 *string
 	AssignedIpv6        *string
@@ -167,6 +180,9 @@ type GenericLoadBalancer struct {
 	Certificates            map[string]GenericCertificate
 	BackendSets             map[string]GenericBackendSetDetails
 	RuleSets                map[string]loadbalancer.RuleSetDetails
+	Hostnames               map[string]loadbalancer.HostnameDetails
+	PathRouteSets           map[string]loadbalancer.PathRouteSetDetails
+	RoutingPolicies         map[string]loadbalancer.RoutingPolicyDetails
 	IpVersion               *GenericIpVersion
 
 	FreeformTags map[string]string
@@ -0,0 +1,108 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/pkg/errors"
+)
+
+const (
+	// BlockStorageLimitsService is the name of the block volume service in
+	// OCI service limits.
+	BlockStorageLimitsService = "block-storage"
+	// BlockStorageTotalStorageLimit is the AD scoped limit on the total size of
+	// block volumes, in GB.
+	BlockStorageTotalStorageLimit = "total-storage-gb"
+
+	// resourceAvailabilityCacheTTL is how long the availability of a limit is
+	// served from the cache before OCI is asked again.
+	resourceAvailabilityCacheTTL = 5 * time.Minute
+)
+
+// LimitsInterface defines the interface to OCI service limits and quotas.
+type LimitsInterface interface {
+	// GetResourceAvailability returns the usage and availability of the limit of a
+	// service in the compartment, taking the quotas of the compartment into
+	// account. The availability domain is required for AD scoped limits and must
+	// be empty otherwise. Results are cached for a few minutes.
+	GetResourceAvailability(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (*limits.ResourceAvailability, error)
+}
+
+type limitsClient interface {
+	GetResourceAvailability(ctx context.Context, request limits.GetResourceAvailabilityRequest) (response limits.GetResourceAvailabilityResponse, err error)
+}
+
+// resourceAvailabilityEntry is a cached resource availability.
+type resourceAvailabilityEntry struct {
+	key          string
+	availability *limits.ResourceAvailability
+}
+
+func resourceAvailabilityCacheKeyFn(obj interface{}) (string, error) {
+	return obj.(*resourceAvailabilityEntry).key, nil
+}
+
+func resourceAvailabilityKey(serviceName, limitName, compartmentID, availabilityDomain string) string {
+	return strings.Join([]string{serviceName, limitName, compartmentID, availabilityDomain}, "/")
+}
+
+func (c *client) GetResourceAvailability(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (*limits.ResourceAvailability, error) {
+	key := resourceAvailabilityKey(serviceName, limitName, compartmentID, availabilityDomain)
+	item, exists, err := c.resourceAvailabilityCache.GetByKey(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if exists {
+		return item.(*resourceAvailabilityEntry).availability, nil
+	}
+
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetResourceAvailability")
+	}
+
+	req := limits.GetResourceAvailabilityRequest{
+		ServiceName:     &serviceName,
+		LimitName:       &limitName,
+		CompartmentId:   &compartmentID,
+		RequestMetadata: c.requestMetadata,
+	}
+	if availabilityDomain != "" {
+		req.AvailabilityDomain = &availabilityDomain
+	}
+
+	resp, err := c.limits.GetResourceAvailability(ctx, req)
+	incRequestCounter(err, getVerb, resourceAvailabilityResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "limits", "verb", getVerb, "resource", resourceAvailabilityResource).
+			With("limit", limitName, "availabilityDomain", availabilityDomain, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetResourceAvailability call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	availability := &resp.ResourceAvailability
+	_ = c.resourceAvailabilityCache.Add(&resourceAvailabilityEntry{key: key, availability: availability})
+	return availability, nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+type mockLimitsClient struct {
+	calls     int
+	available map[string]int64
+}
+
+func (m *mockLimitsClient) GetResourceAvailability(ctx context.Context, request limits.GetResourceAvailabilityRequest) (limits.GetResourceAvailabilityResponse, error) {
+	m.calls++
+	available, ok := m.available[*request.AvailabilityDomain]
+	if !ok {
+		return limits.GetResourceAvailabilityResponse{}, errors.New("limit not found")
+	}
+	return limits.GetResourceAvailabilityResponse{
+		ResourceAvailability: limits.ResourceAvailability{Available: &available},
+	}, nil
+}
+
+func TestClient_GetResourceAvailability(t *testing.T) {
+	limitsClient := &mockLimitsClient{available: map[string]int64{"AD1": 1024, "AD2": 2048}}
+	c := &client{
+		limits: limitsClient,
+		rateLimiter: RateLimiter{
+			Reader: flowcontrol.NewFakeAlwaysRateLimiter(),
+			Writer: flowcontrol.NewFakeAlwaysRateLimiter(),
+		},
+		resourceAvailabilityCache: cache.NewTTLStore(resourceAvailabilityCacheKeyFn, time.Hour),
+		logger:                    zap.S(),
+	}
+
+	for _, tc := range []struct {
+		ad        string
+		want      int64
+		wantCalls int
+	}{
+		{ad: "AD1", want: 1024, wantCalls: 1},
+		{ad: "AD1", want: 1024, wantCalls: 1},
+		{ad: "AD2", want: 2048, wantCalls: 2},
+	} {
+		got, err := c.GetResourceAvailability(context.Background(), BlockStorageLimitsService, BlockStorageTotalStorageLimit, "compartment", tc.ad)
+		if err != nil {
+			t.Fatalf("GetResourceAvailability(%s) unexpected error: %v", tc.ad, err)
+		}
+		if *got.Available != tc.want {
+			t.Errorf("GetResourceAvailability(%s) available = %d, want %d", tc.ad, *got.Available, tc.want)
+		}
+		if limitsClient.calls != tc.wantCalls {
+			t.Errorf("GetResourceAvailability(%s) made %d calls to OCI, want %d", tc.ad, limitsClient.calls, tc.wantCalls)
+		}
+	}
+
+	// Failures are not cached.
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetResourceAvailability(context.Background(), BlockStorageLimitsService, BlockStorageTotalStorageLimit, "compartment", "AD3"); err == nil {
+			t.Fatalf("GetResourceAvailability(AD3) expected an error")
+		}
+	}
+	if limitsClient.calls != 4 {
+		t.Errorf("GetResourceAvailability(AD3) made %d calls to OCI, want 4", limitsClient.calls)
+	}
+}
@@ -49,6 +49,7 @@ type GenericLoadBalancerInterface interface {
 
 	GetCertificateByName(ctx context.Context, lbID, name string) (*GenericCertificate, error)
 	CreateCertificate(ctx context.Context, lbID string, cert *GenericCertificate) (string, error)
+	DeleteCertificate(ctx context.Context, lbID, name string) (string, error)
 
 	CreateBackendSet(ctx context.Context, lbID, name string, details *GenericBackendSetDetails) (string, error)
 	UpdateBackendSet(ctx context.Context, lbID, name string, details *GenericBackendSetDetails) (string, error)
@@ -62,6 +63,18 @@ type GenericLoadBalancerInterface interface {
 	UpdateRuleSet(ctx context.Context, lbID string, name string, details *loadbalancer.RuleSetDetails) (string, error)
 	DeleteRuleSet(ctx context.Context, lbID string, name string) (string, error)
 
+	CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error)
+	UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error)
+	DeleteHostname(ctx context.Context, lbID string, name string) (string, error)
+
+	CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error)
+	UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error)
+	DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error)
+
+	CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error)
+	UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error)
+	DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error)
+
 	UpdateLoadBalancerShape(context.Context, string, *GenericUpdateLoadBalancerShapeDetails) (string, error)
 	UpdateNetworkSecurityGroups(context.Context, string, []string) (string, error)
 
@@ -178,6 +191,8 @@ func (c *loadbalancerClientStruct) CreateLoadBalancer(ctx context.Context, detai
 		FreeformTags:            details.FreeformTags,
 		DefinedTags:             details.DefinedTags,
 		RuleSets:                details.RuleSets,
+		Hostnames:               details.Hostnames,
+		PathRouteSets:           details.PathRouteSets,
 	}
 
 	// IpMode for OCI Load balancers can only be set at Create
@@ -276,6 +291,25 @@ func (c *loadbalancerClientStruct) CreateCertificate(ctx context.Context, lbID s
 	return *resp.OpcWorkRequestId, nil
 }
 
+func (c *loadbalancerClientStruct) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteCertificate")
+	}
+
+	resp, err := c.loadbalancer.DeleteCertificate(ctx, loadbalancer.DeleteCertificateRequest{
+		LoadBalancerId:  &lbID,
+		CertificateName: &name,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, certificateResource)
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
 func (c *loadbalancerClientStruct) GetWorkRequest(ctx context.Context, id string) (*loadbalancer.WorkRequest, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetWorkRequest")
@@ -343,8 +377,9 @@ func (c *loadbalancerClientStruct) CreateBackendSet(ctx context.Context, lbID st
 				TimeoutInMillis:  details.HealthChecker.TimeoutInMillis,
 				IntervalInMillis: details.HealthChecker.IntervalInMillis,
 			},
-			Policy:                          details.Policy,
-			SessionPersistenceConfiguration: getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			Policy:                                  details.Policy,
+			SessionPersistenceConfiguration:         getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			LbCookieSessionPersistenceConfiguration: getLbCookieSessionPersistenceConfiguration(details.LbCookieSessionPersistenceConfiguration),
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -382,8 +417,9 @@ func (c *loadbalancerClientStruct) UpdateBackendSet(ctx context.Context, lbID st
 				TimeoutInMillis:  details.HealthChecker.TimeoutInMillis,
 				IntervalInMillis: details.HealthChecker.IntervalInMillis,
 			},
-			Policy:                          details.Policy,
-			SessionPersistenceConfiguration: getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			Policy:                                  details.Policy,
+			SessionPersistenceConfiguration:         getSessionPersistenceConfiguration(details.SessionPersistenceConfiguration),
+			LbCookieSessionPersistenceConfiguration: getLbCookieSessionPersistenceConfiguration(details.LbCookieSessionPersistenceConfiguration),
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -433,6 +469,9 @@ func (c *loadbalancerClientStruct) CreateListener(ctx context.Context, lbID stri
 			DefaultBackendSetName:   details.DefaultBackendSetName,
 			Port:                    details.Port,
 			Protocol:                details.Protocol,
+			HostnameNames:           details.HostnameNames,
+			PathRouteSetName:        details.PathRouteSetName,
+			RoutingPolicyName:       details.RoutingPolicyName,
 			ConnectionConfiguration: getListenerConnectionConfiguration(details.ConnectionConfiguration),
 		},
 		RequestMetadata: c.requestMetadata,
@@ -465,6 +504,9 @@ func (c *loadbalancerClientStruct) UpdateListener(ctx context.Context, lbID stri
 			Port:                  details.Port,
 			Protocol:              details.Protocol,
 			RuleSetNames:          details.RuleSetNames,
+			HostnameNames:         details.HostnameNames,
+			PathRouteSetName:      details.PathRouteSetName,
+			RoutingPolicyName:     details.RoutingPolicyName,
 		},
 		RequestMetadata: c.requestMetadata,
 	}
@@ -554,6 +596,188 @@ func (c *loadbalancerClientStruct) DeleteRuleSet(ctx context.Context, lbID strin
 	return *resp.OpcWorkRequestId, nil
 }
 
+func (c *loadbalancerClientStruct) CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "CreateHostname")
+	}
+
+	resp, err := c.loadbalancer.CreateHostname(ctx, loadbalancer.CreateHostnameRequest{
+		LoadBalancerId: &lbID,
+		CreateHostnameDetails: loadbalancer.CreateHostnameDetails{
+			Name:     &name,
+			Hostname: details.Hostname,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, hostnameResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdateHostname")
+	}
+
+	resp, err := c.loadbalancer.UpdateHostname(ctx, loadbalancer.UpdateHostnameRequest{
+		LoadBalancerId: &lbID,
+		Name:           &name,
+		UpdateHostnameDetails: loadbalancer.UpdateHostnameDetails{
+			Hostname: details.Hostname,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, hostnameResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) DeleteHostname(ctx context.Context, lbID string, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteHostname")
+	}
+
+	resp, err := c.loadbalancer.DeleteHostname(ctx, loadbalancer.DeleteHostnameRequest{
+		LoadBalancerId:  &lbID,
+		Name:            &name,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, hostnameResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "CreatePathRouteSet")
+	}
+
+	resp, err := c.loadbalancer.CreatePathRouteSet(ctx, loadbalancer.CreatePathRouteSetRequest{
+		LoadBalancerId: &lbID,
+		CreatePathRouteSetDetails: loadbalancer.CreatePathRouteSetDetails{
+			Name:       &name,
+			PathRoutes: details.PathRoutes,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, pathRouteSetResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdatePathRouteSet")
+	}
+
+	resp, err := c.loadbalancer.UpdatePathRouteSet(ctx, loadbalancer.UpdatePathRouteSetRequest{
+		LoadBalancerId:   &lbID,
+		PathRouteSetName: &name,
+		UpdatePathRouteSetDetails: loadbalancer.UpdatePathRouteSetDetails{
+			PathRoutes: details.PathRoutes,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, pathRouteSetResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeletePathRouteSet")
+	}
+
+	resp, err := c.loadbalancer.DeletePathRouteSet(ctx, loadbalancer.DeletePathRouteSetRequest{
+		LoadBalancerId:   &lbID,
+		PathRouteSetName: &name,
+		RequestMetadata:  c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, pathRouteSetResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "CreateRoutingPolicy")
+	}
+
+	resp, err := c.loadbalancer.CreateRoutingPolicy(ctx, loadbalancer.CreateRoutingPolicyRequest{
+		LoadBalancerId: &lbID,
+		CreateRoutingPolicyDetails: loadbalancer.CreateRoutingPolicyDetails{
+			Name:                     &name,
+			ConditionLanguageVersion: loadbalancer.CreateRoutingPolicyDetailsConditionLanguageVersionV1,
+			Rules:                    details.Rules,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, routingPolicyResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdateRoutingPolicy")
+	}
+
+	resp, err := c.loadbalancer.UpdateRoutingPolicy(ctx, loadbalancer.UpdateRoutingPolicyRequest{
+		LoadBalancerId:    &lbID,
+		RoutingPolicyName: &name,
+		UpdateRoutingPolicyDetails: loadbalancer.UpdateRoutingPolicyDetails{
+			ConditionLanguageVersion: loadbalancer.UpdateRoutingPolicyDetailsConditionLanguageVersionV1,
+			Rules:                    details.Rules,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, routingPolicyResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
+func (c *loadbalancerClientStruct) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "DeleteRoutingPolicy")
+	}
+
+	resp, err := c.loadbalancer.DeleteRoutingPolicy(ctx, loadbalancer.DeleteRoutingPolicyRequest{
+		LoadBalancerId:    &lbID,
+		RoutingPolicyName: &name,
+		RequestMetadata:   c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, routingPolicyResource)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return *resp.OpcWorkRequestId, nil
+}
+
 func (c *loadbalancerClientStruct) AwaitWorkRequest(ctx context.Context, id string) (*GenericWorkRequest, error) {
 	var wr *loadbalancer.WorkRequest
 	contextWithTimeout, cancel := context.WithTimeout(ctx, defaultSynchronousAPIPollContextTimeout)
@@ -681,6 +905,21 @@ func (c *loadbalancerClientStruct) loadbalancerToGenericLoadbalancer(lb *loadbal
 		ruleSets[rsn] = loadbalancer.RuleSetDetails{Items: rs.Items}
 	}
 
+	hostnames := make(map[string]loadbalancer.HostnameDetails)
+	for hn, h := range lb.Hostnames {
+		hostnames[hn] = loadbalancer.HostnameDetails{Name: h.Name, Hostname: h.Hostname}
+	}
+
+	pathRouteSets := make(map[string]loadbalancer.PathRouteSetDetails)
+	for prsn, prs := range lb.PathRouteSets {
+		pathRouteSets[prsn] = loadbalancer.PathRouteSetDetails{PathRoutes: prs.PathRoutes}
+	}
+
+	routingPolicies := make(map[string]loadbalancer.RoutingPolicyDetails)
+	for rpn, rp := range lb.RoutingPolicies {
+		routingPolicies[rpn] = loadbalancer.RoutingPolicyDetails{Rules: rp.Rules}
+	}
+
 	return &GenericLoadBalancer{
 		Id:                      lb.Id,
 		CompartmentId:           lb.CompartmentId,
@@ -698,6 +937,9 @@ func (c *loadbalancerClientStruct) loadbalancerToGenericLoadbalancer(lb *loadbal
 		FreeformTags:            lb.FreeformTags,
 		DefinedTags:             lb.DefinedTags,
 		RuleSets:                ruleSets,
+		Hostnames:               hostnames,
+		PathRouteSets:           pathRouteSets,
+		RoutingPolicies:         routingPolicies,
 		SystemTags:              lb.SystemTags,
 	}
 }
@@ -837,6 +1079,10 @@ func (c *loadbalancerClientStruct) backendSetsToGenericBackendSetDetails(backend
 		if v.SessionPersistenceConfiguration != nil {
 			backendDetailsStruct.SessionPersistenceConfiguration = getGenericSessionPersistenceConfiguration(v.SessionPersistenceConfiguration)
 		}
+
+		if v.LbCookieSessionPersistenceConfiguration != nil {
+			backendDetailsStruct.LbCookieSessionPersistenceConfiguration = getGenericLbCookieSessionPersistenceConfiguration(v.LbCookieSessionPersistenceConfiguration)
+		}
 		genericBackendSetDetails[k] = backendDetailsStruct
 	}
 
@@ -869,6 +1115,10 @@ func (c *loadbalancerClientStruct) genericBackendSetDetailsToBackendSets(backend
 		if v.SessionPersistenceConfiguration != nil {
 			backendSetDetailsStruct.SessionPersistenceConfiguration = getSessionPersistenceConfiguration(v.SessionPersistenceConfiguration)
 		}
+
+		if v.LbCookieSessionPersistenceConfiguration != nil {
+			backendSetDetailsStruct.LbCookieSessionPersistenceConfiguration = getLbCookieSessionPersistenceConfiguration(v.LbCookieSessionPersistenceConfiguration)
+		}
 		backendSetDetails[k] = backendSetDetailsStruct
 	}
 	return backendSetDetails
@@ -1044,6 +1294,37 @@ func getGenericSessionPersistenceConfiguration(details *loadbalancer.SessionPers
 	}
 }
 
+func getLbCookieSessionPersistenceConfiguration(details *GenericLbCookieSessionPersistenceConfiguration) *loadbalancer.LbCookieSessionPersistenceConfigurationDetails {
+	if details == nil {
+		return nil
+	}
+	return &loadbalancer.LbCookieSessionPersistenceConfigurationDetails{
+		CookieName:      details.CookieName,
+		DisableFallback: details.DisableFallback,
+		Domain:          details.Domain,
+		Path:            details.Path,
+		MaxAgeInSeconds: details.MaxAgeInSeconds,
+		IsSecure:        details.IsSecure,
+		IsHttpOnly:      details.IsHttpOnly,
+	}
+}
+
+func getGenericLbCookieSessionPersistenceConfiguration(details *loadbalancer.LbCookieSessionPersistenceConfigurationDetails) *GenericLbCookieSessionPersistenceConfiguration {
+	if details == nil {
+		return nil
+	}
+
+	return &GenericLbCookieSessionPersistenceConfiguration{
+		CookieName:      details.CookieName,
+		DisableFallback: details.DisableFallback,
+		Domain:          details.Domain,
+		Path:            details.Path,
+		MaxAgeInSeconds: details.MaxAgeInSeconds,
+		IsSecure:        details.IsSecure,
+		IsHttpOnly:      details.IsHttpOnly,
+	}
+}
+
 func getListenerConnectionConfiguration(details *GenericConnectionConfiguration) *loadbalancer.ConnectionConfiguration {
 	var connectionConfiguration *loadbalancer.ConnectionConfiguration
 
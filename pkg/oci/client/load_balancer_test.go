@@ -323,6 +323,9 @@ func (c *MockLoadBalancerClient) ListCertificates(ctx context.Context, request l
 func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, request loadbalancer.CreateCertificateRequest) (response loadbalancer.CreateCertificateResponse, err error) {
 	return
 }
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, request loadbalancer.DeleteCertificateRequest) (response loadbalancer.DeleteCertificateResponse, err error) {
+	return
+}
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, request loadbalancer.CreateBackendSetRequest) (response loadbalancer.CreateBackendSetResponse, err error) {
 	return
 }
@@ -350,6 +353,33 @@ func (c *MockLoadBalancerClient) UpdateRuleSet(ctx context.Context, request load
 func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, request loadbalancer.DeleteRuleSetRequest) (response loadbalancer.DeleteRuleSetResponse, err error) {
 	return
 }
+func (c *MockLoadBalancerClient) CreateHostname(ctx context.Context, request loadbalancer.CreateHostnameRequest) (response loadbalancer.CreateHostnameResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) UpdateHostname(ctx context.Context, request loadbalancer.UpdateHostnameRequest) (response loadbalancer.UpdateHostnameResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) DeleteHostname(ctx context.Context, request loadbalancer.DeleteHostnameRequest) (response loadbalancer.DeleteHostnameResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) CreatePathRouteSet(ctx context.Context, request loadbalancer.CreatePathRouteSetRequest) (response loadbalancer.CreatePathRouteSetResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) UpdatePathRouteSet(ctx context.Context, request loadbalancer.UpdatePathRouteSetRequest) (response loadbalancer.UpdatePathRouteSetResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) DeletePathRouteSet(ctx context.Context, request loadbalancer.DeletePathRouteSetRequest) (response loadbalancer.DeletePathRouteSetResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, request loadbalancer.CreateRoutingPolicyRequest) (response loadbalancer.CreateRoutingPolicyResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, request loadbalancer.UpdateRoutingPolicyRequest) (response loadbalancer.UpdateRoutingPolicyResponse, err error) {
+	return
+}
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, request loadbalancer.DeleteRoutingPolicyRequest) (response loadbalancer.DeleteRoutingPolicyResponse, err error) {
+	return
+}
 func (c *MockLoadBalancerClient) UpdateLoadBalancerShape(ctx context.Context, request loadbalancer.UpdateLoadBalancerShapeRequest) (response loadbalancer.UpdateLoadBalancerShapeResponse, err error) {
 	return
 }
@@ -27,6 +27,7 @@ type LustreInterface interface {
 	GetLustreFileSystem(ctx context.Context, id string) (*lustre.LustreFileSystem, error)
 	ListLustreFileSystems(ctx context.Context, compartmentID, ad, displayName string) ([]lustre.LustreFileSystemSummary, error)
 	DeleteLustreFileSystem(ctx context.Context, id string) error
+	UpdateLustreFileSystemCapacity(ctx context.Context, id string, capacityInGBs int) (string, error)
 
 	// Waiters
 	AwaitLustreFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*lustre.LustreFileSystem, error)
@@ -164,6 +165,38 @@ func (c *client) DeleteLustreFileSystem(ctx context.Context, id string) error {
 	return nil
 }
 
+// UpdateLustreFileSystemCapacity requests the capacity of a Lustre file system to be changed
+// and returns the OCID of the work request tracking the update.
+func (c *client) UpdateLustreFileSystemCapacity(ctx context.Context, id string, capacityInGBs int) (string, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return "", RateLimitError(true, "UpdateLustreFileSystem")
+	}
+
+	resp, err := c.lustre.UpdateLustreFileSystem(ctx, lustre.UpdateLustreFileSystemRequest{
+		LustreFileSystemId: &id,
+		UpdateLustreFileSystemDetails: lustre.UpdateLustreFileSystemDetails{
+			CapacityInGBs: &capacityInGBs,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, "lustreFileSystem")
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "lustre", "verb", updateVerb, "resource", "lustreFileSystem").
+			With("volumeID", id, "capacityInGBs", capacityInGBs, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for UpdateLustreFileSystem call.")
+	}
+
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if resp.OpcWorkRequestId == nil {
+		return "", nil
+	}
+	return *resp.OpcWorkRequestId, nil
+}
+
 // AwaitLustreFileSystemActive waits for the Lustre file system to become ACTIVE or returns error on terminal states.
 func (c *client) AwaitLustreFileSystemActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*lustre.LustreFileSystem, error) {
 	logger.Info("Waiting for LustreFileSystem to go in lifecycle state ACTIVE")
@@ -44,16 +44,21 @@ const (
 	backendSetResource          resource = "load_balancer_backend_set"
 	listenerResource            resource = "load_balancer_listener"
 	ruleSetResource             resource = "load_balancer_rule_set"
+	hostnameResource            resource = "load_balancer_hostname"
+	pathRouteSetResource        resource = "load_balancer_path_route_set"
+	routingPolicyResource       resource = "load_balancer_routing_policy"
 	shapeResource               resource = "load_balancer_shape"
 	certificateResource         resource = "load_balancer_certificate"
 	workRequestResource         resource = "load_balancer_work_request"
 	nlbWorkRequestResource      resource = "network_load_balancer_work_request"
 	securityListResource        resource = "security_list"
+	routeTableResource          resource = "route_table"
 	volumeResource              resource = "volume"
 	volumeAttachmentResource    resource = "volume_attachment"
 	fileSystemResource          resource = "file_system"
 	mountTargetResource         resource = "mount_target"
 	exportResource              resource = "export"
+	snapshotResource            resource = "snapshot"
 	privateIPResource           resource = "private_ip"
 	ipv6IPResource              resource = "ipv6_ip"
 	availabilityDomainResource  resource = "availability_domain"
@@ -61,6 +66,17 @@ const (
 	nsgRuleResource             resource = "network_security_group_rules"
 	publicReservedIPResource    resource = "public_reserved_ip"
 	volumeBackupResource        resource = "volumeBackup"
+	blockVolumeReplicaResource  resource = "block_volume_replica"
+	volumeGroupResource         resource = "volume_group"
+	volumeGroupBackupResource   resource = "volume_group_backup"
+	quotaRuleResource           resource = "quota_rule"
+)
+
+const resourceAvailabilityResource resource = "resource_availability"
+
+const (
+	volumeBackupPolicyResource           resource = "volume_backup_policy"
+	volumeBackupPolicyAssignmentResource resource = "volume_backup_policy_assignment"
 )
 
 type verb string
@@ -200,6 +200,10 @@ func (c *networkLoadbalancer) CreateCertificate(ctx context.Context, lbID string
 	return "", nil
 }
 
+func (c *networkLoadbalancer) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
 func (c *networkLoadbalancer) GetWorkRequest(ctx context.Context, id string) (*networkloadbalancer.WorkRequest, error) {
 	if !c.rateLimiter.Reader.TryAccept() {
 		return nil, RateLimitError(false, "GetWorkRequest")
@@ -480,6 +484,42 @@ func (c *networkLoadbalancer) DeleteRuleSet(ctx context.Context, lbID string, na
 	return "", nil
 }
 
+func (c *networkLoadbalancer) CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) DeleteHostname(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *networkLoadbalancer) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *networkLoadbalancer) UpdateLoadBalancerShape(context.Context, string, *GenericUpdateLoadBalancerShapeDetails) (string, error) {
 	return "", nil
 }
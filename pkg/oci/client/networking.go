@@ -38,6 +38,9 @@ type NetworkingInterface interface {
 	GetSecurityList(ctx context.Context, id string) (core.GetSecurityListResponse, error)
 	UpdateSecurityList(ctx context.Context, id string, etag string, ingressRules []core.IngressSecurityRule, egressRules []core.EgressSecurityRule) (core.UpdateSecurityListResponse, error)
 
+	GetRouteTable(ctx context.Context, id string) (*core.RouteTable, *string, error)
+	UpdateRouteTable(ctx context.Context, id, etag string, routeRules []core.RouteRule) (*core.RouteTable, error)
+
 	ListPrivateIps(ctx context.Context, vnicId string) ([]core.PrivateIp, error)
 	GetPrivateIp(ctx context.Context, id string) (*core.PrivateIp, error)
 	CreatePrivateIp(ctx context.Context, vnicID string) (*core.PrivateIp, error)
@@ -231,6 +234,52 @@ func (c *client) UpdateSecurityList(ctx context.Context, id string, etag string,
 	return resp, errors.WithStack(err)
 }
 
+// GetRouteTable returns the route table along with its etag so that callers
+// can perform optimistic concurrency controlled updates.
+func (c *client) GetRouteTable(ctx context.Context, id string) (*core.RouteTable, *string, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, nil, RateLimitError(false, "GetRouteTable")
+	}
+
+	resp, err := c.network.GetRouteTable(ctx, core.GetRouteTableRequest{
+		RtId:            &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, routeTableResource)
+
+	if err != nil {
+		c.logger.With("routeTableId", id).Infof("GetRouteTable failed %s", pointer.StringDeref(resp.OpcRequestId, ""))
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return &resp.RouteTable, resp.Etag, nil
+}
+
+// UpdateRouteTable replaces the route rules of the route table. The update is
+// rejected by the service if the etag no longer matches the route table.
+func (c *client) UpdateRouteTable(ctx context.Context, id, etag string, routeRules []core.RouteRule) (*core.RouteTable, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "UpdateRouteTable")
+	}
+
+	resp, err := c.network.UpdateRouteTable(ctx, core.UpdateRouteTableRequest{
+		RtId:    &id,
+		IfMatch: &etag,
+		UpdateRouteTableDetails: core.UpdateRouteTableDetails{
+			RouteRules: routeRules,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, routeTableResource)
+
+	if err != nil {
+		c.logger.With("routeTableId", id).Infof("UpdateRouteTable failed %s", pointer.StringDeref(resp.OpcRequestId, ""))
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.RouteTable, nil
+}
+
 func subnetCacheKeyFn(obj interface{}) (string, error) {
 	return *obj.(*core.Subnet).Id, nil
 }
@@ -114,3 +114,7 @@ func IsIpv6SingleStackCluster() bool {
 func IsBootVolume(ocid string) bool {
 	return strings.Contains(ocid, ".bootvolume.")
 }
+
+func IsBlockVolumeReplica(ocid string) bool {
+	return strings.Contains(ocid, ".blockvolumereplica.")
+}
@@ -58,6 +58,7 @@ type VolumeAttachmentInterface interface {
 	WaitForUHPVolumeLoggedOut(ctx context.Context, attachmentID string) error
 
 	// ListVolumeAttachments returns all non-DETACHED volume attachments
+	// of a volume, or of all the volumes of the compartment if volumeID is empty.
 	// If no attachments are found, errNotFound is returned
 	ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error)
 }
@@ -327,12 +328,16 @@ func (c *client) ListVolumeAttachments(ctx context.Context, compartmentID, volum
 			return nil, RateLimitError(false, "ListVolumeAttachments")
 		}
 
-		resp, err := c.compute.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+		req := core.ListVolumeAttachmentsRequest{
 			CompartmentId:   &compartmentID,
-			VolumeId:        &volumeID,
 			Page:            page,
 			RequestMetadata: c.requestMetadata,
-		})
+		}
+		if volumeID != "" {
+			req.VolumeId = &volumeID
+		}
+
+		resp, err := c.compute.ListVolumeAttachments(ctx, req)
 
 		if resp.OpcRequestId != nil {
 			c.logger.With("service", "compute", "verb", listVerb, "resource", volumeAttachmentResource).
@@ -72,6 +72,16 @@ func LookupNodeCompartment(k kubernetes.Interface, nodeName string) (string, err
 	return "", errors.New("CompartmentID annotation is not present")
 }
 
+// LookupClusterUID returns the UID of the kube-system namespace, which tells
+// apart the clusters sharing a compartment.
+func LookupClusterUID(ctx context.Context, k kubernetes.Interface) (string, error) {
+	namespace, err := k.CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(namespace.UID), nil
+}
+
 func GetError(err error) string {
 	if err == nil {
 		return ""
@@ -621,7 +621,7 @@ func diskByPathsForMountPoint(mountPoint mount.MountPoint, logger *zap.SugaredLo
 			base := filepath.Base(path)
 			if strings.HasPrefix(base, "ip-") && strings.Contains(base, "-iscsi-") {
 				// include only if ISCSI session active
-				if !isISCSISessionActive(path, logger) {
+				if !IsISCSISessionActive(path, logger) {
 					logger.Infof("Ignoring path %s due to no active ISCSI session", path)
 					return nil
 				}
@@ -652,11 +652,11 @@ func diskByPathsForMountPoint(mountPoint mount.MountPoint, logger *zap.SugaredLo
 	return diskByPaths, nil
 }
 
-// isISCSISessionActive returns true if there is an active iSCSI session
+// IsISCSISessionActive returns true if there is an active iSCSI session
 // that matches the portal and IQN of the by-path filename.
 // Example by-path:
 // /dev/disk/by-path/ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:5638bae3-98d1-4e33-b912-9bb567d94f59-lun-2
-func isISCSISessionActive(path string, logger *zap.SugaredLogger) bool {
+func IsISCSISessionActive(path string, logger *zap.SugaredLogger) bool {
 	// For /dev/disk/by-path/ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:5638bae3-98d1-4e33-b912-9bb567d94f59-lun-2
 	// m[0] = /dev/disk/by-path/ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:5638bae3-98d1-4e33-b912-9bb567d94f59-lun-2
 	// m[1] = 169.254.2.2
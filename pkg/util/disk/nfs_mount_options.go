@@ -0,0 +1,188 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// NFSVersion3 is NFSv3, which locks files with the separate NLM protocol.
+	NFSVersion3 = "3"
+	// NFSVersion41 is NFSv4.1, which locks files as part of the protocol.
+	NFSVersion41 = "4.1"
+
+	maxNConnect = 16
+)
+
+// minNConnectKernelVersion is the first kernel version with the nconnect
+// mount option.
+var minNConnectKernelVersion = version.MustParseGeneric("5.3")
+
+// kernelReleasePath is the release of the running kernel, which is the kernel
+// of the host in containers.
+var kernelReleasePath = "/proc/sys/kernel/osrelease"
+
+// NFSMountOptions are the NFS mount options of a file storage volume. The
+// options the driver does not model are passed through as they are.
+type NFSMountOptions struct {
+	// Version is the NFS version, NFSVersion3 or NFSVersion41. It is empty to
+	// let the node negotiate the version.
+	Version string
+	// NConnect is the number of TCP connections to the mount target, or 0 for
+	// a single connection.
+	NConnect int
+	// LookupCache is the lookup cache mode: all, none, pos or positive.
+	LookupCache string
+	// Timeo is the time, in tenths of a second, the client waits for a
+	// response before it retries a request, or 0 for the default.
+	Timeo int
+	// Hard and Soft are set when the mount options set them. Hard mounts retry
+	// requests forever while soft mounts fail them after retrans retries.
+	Hard bool
+	Soft bool
+	// NoLock is set for NFSv3 mounts which lock files locally instead of on
+	// the mount target.
+	NoLock bool
+	// Other are the mount options which are not modelled.
+	Other []string
+}
+
+// ParseNFSMountOptions parses and validates the NFS mount options of a volume.
+// It rejects options and combinations of options which fail to mount or are
+// not supported by file storage.
+func ParseNFSMountOptions(options []string) (*NFSMountOptions, error) {
+	o := &NFSMountOptions{}
+	majorVersion, minorVersion := "", ""
+	for _, option := range options {
+		name, value, hasValue := strings.Cut(option, "=")
+		var err error
+		switch name {
+		case "nfsvers", "vers":
+			majorVersion, minorVersion, _ = strings.Cut(value, ".")
+		case "minorversion":
+			minorVersion = value
+		case "nconnect":
+			o.NConnect, err = strconv.Atoi(value)
+			if err == nil && (o.NConnect < 1 || o.NConnect > maxNConnect) {
+				err = fmt.Errorf("must be between 1 and %d", maxNConnect)
+			}
+		case "lookupcache":
+			o.LookupCache = value
+			if value != "all" && value != "none" && value != "pos" && value != "positive" {
+				err = fmt.Errorf("must be one of all, none, pos or positive")
+			}
+		case "timeo":
+			o.Timeo, err = strconv.Atoi(value)
+			if err == nil && o.Timeo < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+		case "hard":
+			o.Hard = true
+		case "soft":
+			o.Soft = true
+		case "nolock":
+			o.NoLock = true
+		default:
+			o.Other = append(o.Other, option)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid mount option %s: %v", option, err)
+		}
+		if (name == "hard" || name == "soft" || name == "nolock") && hasValue {
+			return nil, fmt.Errorf("invalid mount option %s: does not take a value", option)
+		}
+	}
+
+	switch {
+	case majorVersion == "" && minorVersion == "":
+	case majorVersion == "3" && minorVersion == "":
+		o.Version = NFSVersion3
+	case majorVersion == "4" && minorVersion == "",
+		(majorVersion == "4" || majorVersion == "") && minorVersion == "1":
+		// NFSv4 mounts without a minor version negotiate it, which is 4.1 on
+		// file storage, and a minor version implies NFSv4.
+		o.Version = NFSVersion41
+	default:
+		return nil, fmt.Errorf("unsupported NFS version %s, file storage supports NFS versions %s and %s", strings.Trim(majorVersion+"."+minorVersion, "."), NFSVersion3, NFSVersion41)
+	}
+
+	if o.Hard && o.Soft {
+		return nil, fmt.Errorf("mount options hard and soft can not be used together")
+	}
+	if o.NoLock && o.Version != NFSVersion3 {
+		return nil, fmt.Errorf("mount option nolock requires nfsvers=%s, NFSv4.1 locks files on the mount target", NFSVersion3)
+	}
+	return o, nil
+}
+
+// Options returns the mount options, with the version as a single nfsvers
+// option.
+func (o *NFSMountOptions) Options() []string {
+	var options []string
+	if o.Version != "" {
+		options = append(options, "nfsvers="+o.Version)
+	}
+	if o.NConnect > 0 {
+		options = append(options, "nconnect="+strconv.Itoa(o.NConnect))
+	}
+	if o.LookupCache != "" {
+		options = append(options, "lookupcache="+o.LookupCache)
+	}
+	if o.Timeo > 0 {
+		options = append(options, "timeo="+strconv.Itoa(o.Timeo))
+	}
+	if o.Hard {
+		options = append(options, "hard")
+	}
+	if o.Soft {
+		options = append(options, "soft")
+	}
+	if o.NoLock {
+		options = append(options, "nolock")
+	}
+	return append(options, o.Other...)
+}
+
+// ValidateKernel checks that the kernel with the given release supports the
+// mount options.
+func (o *NFSMountOptions) ValidateKernel(kernelRelease string) error {
+	if o.NConnect == 0 {
+		return nil
+	}
+	kernelVersion, err := version.ParseGeneric(kernelRelease)
+	if err != nil {
+		return fmt.Errorf("failed to parse kernel release %s: %v", kernelRelease, err)
+	}
+	if kernelVersion.LessThan(minNConnectKernelVersion) {
+		return fmt.Errorf("mount option nconnect requires kernel %s or later, node runs kernel %s", minNConnectKernelVersion, kernelRelease)
+	}
+	return nil
+}
+
+// KernelRelease returns the release of the running kernel.
+func KernelRelease() (string, error) {
+	release, err := os.ReadFile(kernelReleasePath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(release)), nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNFSMountOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		options     []string
+		wantOptions []string
+		wantErr     string
+	}{
+		{
+			name:        "no mount options",
+			options:     nil,
+			wantOptions: nil,
+		},
+		{
+			name:        "NFSv4.1 with nconnect",
+			options:     []string{"nfsvers=4.1", "nconnect=4", "lookupcache=pos", "timeo=600", "hard", "sec=krb5p"},
+			wantOptions: []string{"nfsvers=4.1", "nconnect=4", "lookupcache=pos", "timeo=600", "hard", "sec=krb5p"},
+		},
+		{
+			name:        "NFSv4.1 with minorversion",
+			options:     []string{"vers=4", "minorversion=1"},
+			wantOptions: []string{"nfsvers=4.1"},
+		},
+		{
+			name:        "NFSv3 without locking",
+			options:     []string{"vers=3", "nolock", "soft"},
+			wantOptions: []string{"nfsvers=3", "soft", "nolock"},
+		},
+		{
+			name:        "NFSv4 without minor version",
+			options:     []string{"nfsvers=4"},
+			wantOptions: []string{"nfsvers=4.1"},
+		},
+		{
+			name:        "NFSv4 without minor version with vers",
+			options:     []string{"vers=4", "hard"},
+			wantOptions: []string{"nfsvers=4.1", "hard"},
+		},
+		{
+			name:        "minorversion without nfsvers",
+			options:     []string{"minorversion=1"},
+			wantOptions: []string{"nfsvers=4.1"},
+		},
+		{
+			name:    "unsupported NFS version",
+			options: []string{"nfsvers=2"},
+			wantErr: "unsupported NFS version 2",
+		},
+		{
+			name:    "unsupported NFS minor version with minorversion",
+			options: []string{"vers=4", "minorversion=0"},
+			wantErr: "unsupported NFS version 4.0",
+		},
+		{
+			name:    "unsupported NFS minor version",
+			options: []string{"nfsvers=4.2"},
+			wantErr: "unsupported NFS version 4.2",
+		},
+		{
+			name:    "nconnect out of range",
+			options: []string{"nconnect=32"},
+			wantErr: "invalid mount option nconnect=32: must be between 1 and 16",
+		},
+		{
+			name:    "nconnect not a number",
+			options: []string{"nconnect=many"},
+			wantErr: "invalid mount option nconnect=many",
+		},
+		{
+			name:    "invalid lookupcache",
+			options: []string{"lookupcache=some"},
+			wantErr: "invalid mount option lookupcache=some",
+		},
+		{
+			name:    "invalid timeo",
+			options: []string{"timeo=0"},
+			wantErr: "invalid mount option timeo=0: must be positive",
+		},
+		{
+			name:    "hard and soft",
+			options: []string{"hard", "soft"},
+			wantErr: "mount options hard and soft can not be used together",
+		},
+		{
+			name:    "hard with a value",
+			options: []string{"hard=true"},
+			wantErr: "invalid mount option hard=true: does not take a value",
+		},
+		{
+			name:    "nolock with NFSv4.1",
+			options: []string{"nfsvers=4.1", "nolock"},
+			wantErr: "mount option nolock requires nfsvers=3",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNFSMountOptions(tt.options)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseNFSMountOptions() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseNFSMountOptions() unexpected error: %v", err)
+			}
+			if options := got.Options(); !reflect.DeepEqual(options, tt.wantOptions) {
+				t.Errorf("Options() = %v, want %v", options, tt.wantOptions)
+			}
+		})
+	}
+}
+
+func TestNFSMountOptions_ValidateKernel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       NFSMountOptions
+		kernelRelease string
+		wantErr       bool
+	}{
+		{name: "no nconnect on old kernel", options: NFSMountOptions{Version: NFSVersion41}, kernelRelease: "4.14.35-2047.el7uek.x86_64"},
+		{name: "nconnect on new kernel", options: NFSMountOptions{NConnect: 4}, kernelRelease: "5.15.0-200.131.27.el8uek.x86_64"},
+		{name: "nconnect on old kernel", options: NFSMountOptions{NConnect: 4}, kernelRelease: "4.18.0-553.el8_10.x86_64", wantErr: true},
+		{name: "unknown kernel release", options: NFSMountOptions{NConnect: 4}, kernelRelease: "unknown", wantErr: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.ValidateKernel(tt.kernelRelease); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateKernel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKernelRelease(t *testing.T) {
+	defaultKernelReleasePath := kernelReleasePath
+	defer func() { kernelReleasePath = defaultKernelReleasePath }()
+	kernelReleasePath = filepath.Join(t.TempDir(), "osrelease")
+	if err := os.WriteFile(kernelReleasePath, []byte("5.15.0-200.131.27.el8uek.x86_64\n"), 0644); err != nil {
+		t.Fatalf("failed to write kernel release: %v", err)
+	}
+
+	got, err := KernelRelease()
+	if err != nil {
+		t.Fatalf("KernelRelease() unexpected error: %v", err)
+	}
+	if got != "5.15.0-200.131.27.el8uek.x86_64" {
+		t.Errorf("KernelRelease() = %q, want %q", got, "5.15.0-200.131.27.el8uek.x86_64")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"fmt"
+	"hash/fnv"
+	cmdexec "os/exec"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	sgPersistCommand = "sg_persist"
+
+	// writeExclusiveAllRegistrants is the SCSI-3 persistent reservation type
+	// which allows every registered initiator to write to the device while
+	// initiators that are not registered can only read from it.
+	writeExclusiveAllRegistrants = "7"
+)
+
+// sgPersist runs sg_persist with the given arguments and is swapped out in tests.
+var sgPersist = func(args ...string) (string, error) {
+	output, err := cmdexec.Command(sgPersistCommand, args...).CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("command failed: %v\narguments: %s %s\nOutput: %v", err, sgPersistCommand, strings.Join(args, " "), string(output))
+	}
+	return string(output), nil
+}
+
+// PersistentReservationKey returns the SCSI-3 persistent reservation key of a
+// node. The key is derived from the node ID so the same node always registers
+// the same key, and is never 0 since that unregisters a key.
+func PersistentReservationKey(nodeID string) string {
+	h := fnv.New64a()
+	h.Write([]byte(nodeID))
+	key := h.Sum64()
+	if key == 0 {
+		key = 1
+	}
+	return fmt.Sprintf("0x%016x", key)
+}
+
+// RegisterPersistentReservation registers the key for the device and, when no
+// reservation is held, reserves the device for all registrants. Initiators
+// whose key is removed, for example by preempting it after a node failed,
+// are fenced off and can no longer write to the device. Registering a key
+// again is a no-op.
+func RegisterPersistentReservation(logger *zap.SugaredLogger, devicePath, key string) error {
+	logger = logger.With("devicePath", devicePath, "reservationKey", key)
+
+	if _, err := sgPersist("--out", "--no-inquiry", "--register-ignore", "--param-sark="+key, devicePath); err != nil {
+		return err
+	}
+	logger.Info("Registered persistent reservation key.")
+
+	output, err := sgPersist("--in", "--no-inquiry", "--read-reservation", devicePath)
+	if err != nil {
+		return err
+	}
+	if isReservationHeld(output) {
+		logger.Info("Persistent reservation is already held.")
+		return nil
+	}
+
+	if _, err := sgPersist("--out", "--no-inquiry", "--reserve", "--param-rk="+key, "--prout-type="+writeExclusiveAllRegistrants, devicePath); err != nil {
+		return err
+	}
+	logger.Info("Reserved device for all registrants.")
+	return nil
+}
+
+// UnregisterPersistentReservation removes the registration of this initiator
+// for the device, whatever its key is. The reservation is released with the
+// last registration. Unregistering an initiator that is not registered is a
+// no-op.
+func UnregisterPersistentReservation(logger *zap.SugaredLogger, devicePath string) error {
+	if _, err := sgPersist("--out", "--no-inquiry", "--register-ignore", "--param-sark=0", devicePath); err != nil {
+		return err
+	}
+	logger.With("devicePath", devicePath).Info("Unregistered persistent reservation key.")
+	return nil
+}
+
+// isReservationHeld returns whether the output of sg_persist --read-reservation
+// reports a reservation.
+func isReservationHeld(output string) bool {
+	return !strings.Contains(output, "there is NO reservation held")
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestPersistentReservationKey(t *testing.T) {
+	key := PersistentReservationKey("ocid1.instance.oc1.phx.node1")
+	if len(key) != 18 || !strings.HasPrefix(key, "0x") {
+		t.Fatalf("PersistentReservationKey() = %q, want a 0x prefixed 8 byte key", key)
+	}
+	if key != PersistentReservationKey("ocid1.instance.oc1.phx.node1") {
+		t.Errorf("PersistentReservationKey() is not stable for the same node")
+	}
+	if key == PersistentReservationKey("ocid1.instance.oc1.phx.node2") {
+		t.Errorf("PersistentReservationKey() is the same for different nodes")
+	}
+}
+
+func TestRegisterPersistentReservation(t *testing.T) {
+	const (
+		noReservation = "  PR generation=0x1, there is NO reservation held\n"
+		reservation   = "  PR generation=0x2, Reservation follows:\n    Key=0x1\n    scope: LU_SCOPE,  type: Write Exclusive, all registrants\n"
+	)
+	tests := map[string]struct {
+		readReservation string
+		registerErr     error
+		wantCommands    []string
+		wantErr         bool
+	}{
+		"Reserve the device when no reservation is held": {
+			readReservation: noReservation,
+			wantCommands: []string{
+				"--out --no-inquiry --register-ignore --param-sark=0x1 /dev/sdb",
+				"--in --no-inquiry --read-reservation /dev/sdb",
+				"--out --no-inquiry --reserve --param-rk=0x1 --prout-type=7 /dev/sdb",
+			},
+		},
+		"Register only when a reservation is held": {
+			readReservation: reservation,
+			wantCommands: []string{
+				"--out --no-inquiry --register-ignore --param-sark=0x1 /dev/sdb",
+				"--in --no-inquiry --read-reservation /dev/sdb",
+			},
+		},
+		"Error registering the key": {
+			registerErr:  errors.New("persistent reservations are not supported"),
+			wantCommands: []string{"--out --no-inquiry --register-ignore --param-sark=0x1 /dev/sdb"},
+			wantErr:      true,
+		},
+	}
+	defer func(f func(args ...string) (string, error)) { sgPersist = f }(sgPersist)
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var commands []string
+			sgPersist = func(args ...string) (string, error) {
+				commands = append(commands, strings.Join(args, " "))
+				if strings.Contains(args[2], "register") {
+					return "", tt.registerErr
+				}
+				return tt.readReservation, nil
+			}
+			err := RegisterPersistentReservation(zap.S(), "/dev/sdb", "0x1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RegisterPersistentReservation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(commands, tt.wantCommands) {
+				t.Errorf("RegisterPersistentReservation() ran %v, want %v", commands, tt.wantCommands)
+			}
+		})
+	}
+}
@@ -62,11 +62,55 @@ func (c *MockBlockStorageClient) GetBootVolume(ctx context.Context, id string) (
 	return nil, nil
 }
 
+func (c *MockBlockStorageClient) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupBackupCommittedOrTimeout(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, groupSnapshotName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
 // AwaitVolumeCloneAvailableOrTimeout implements client.BlockStorageInterface.
 func (c *MockBlockStorageClient) AwaitVolumeHydratedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
 	return &core.Volume{}, nil
 }
 
+func (c *MockBlockStorageClient) AwaitVolumeReplicasRemovedOrTimeout(ctx context.Context, id string) (*core.Volume, error) {
+	return &core.Volume{}, nil
+}
+
 func (c *MockBlockStorageClient) AwaitVolumeAvailableORTimeout(ctx context.Context, id string) (*core.Volume, error) {
 	return &core.Volume{
 		Id:             &id,
@@ -82,6 +126,10 @@ func (c *MockBlockStorageClient) GetVolumesByName(ctx context.Context, volumeNam
 	return nil, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumes(ctx context.Context, compartmentID string) ([]core.Volume, error) {
+	return nil, nil
+}
+
 // CreateVolume mocks the BlockStorage CreateVolume implementation
 func (c *MockBlockStorageClient) CreateVolume(ctx context.Context, details core.CreateVolumeDetails) (*core.Volume, error) {
 	return &core.Volume{Id: &VolumeBackupID}, nil
@@ -121,6 +169,38 @@ func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, sna
 	return []core.VolumeBackup{}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeBackup, error) {
+	return []core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) UpdateVolumeBackup(ctx context.Context, id string, details core.UpdateVolumeBackupDetails) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, id, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	return &core.VolumeBackup{}, nil
+}
+
+func (c *MockBlockStorageClient) InRegion(region string) (client.BlockStorageInterface, error) {
+	return c, nil
+}
+
+func (c *MockBlockStorageClient) ListVolumeBackupPolicies(ctx context.Context, compartmentID string) ([]core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssetAssignment(ctx context.Context, assetID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, assetID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	return nil
+}
+
 // MockFileStorageClient mocks FileStorage client implementation.
 type MockFileStorageClient struct{}
 
@@ -142,6 +222,42 @@ func (c *MockFileStorageClient) DeleteMountTarget(ctx context.Context, id string
 	return nil
 }
 
+func (c *MockFileStorageClient) CreateSnapshot(ctx context.Context, details filestorage.CreateSnapshotDetails) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) GetSnapshot(ctx context.Context, id string) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) ListSnapshots(ctx context.Context, fileSystemID string) ([]filestorage.SnapshotSummary, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) AwaitSnapshotActive(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.Snapshot, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) DeleteSnapshot(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockFileStorageClient) ToggleQuotaRules(ctx context.Context, fileSystemID string, enabled bool) error {
+	return nil
+}
+
+func (c *MockFileStorageClient) ListQuotaRules(ctx context.Context, fileSystemID string, principalType filestorage.ListQuotaRulesPrincipalTypeEnum) ([]filestorage.QuotaRuleSummary, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) CreateQuotaRule(ctx context.Context, fileSystemID string, details filestorage.CreateQuotaRuleDetails) (*filestorage.QuotaRule, error) {
+	return nil, nil
+}
+
+func (c *MockFileStorageClient) UpdateQuotaRule(ctx context.Context, fileSystemID, quotaRuleID string, details filestorage.UpdateQuotaRuleDetails) (*filestorage.QuotaRule, error) {
+	return nil, nil
+}
+
 // CreateFileSystem mocks the FileStorage CreateFileSystem implementation.
 func (c *MockFileStorageClient) CreateFileSystem(ctx context.Context, details filestorage.CreateFileSystemDetails) (*filestorage.FileSystem, error) {
 	return &filestorage.FileSystem{Id: &fileSystemID}, nil
@@ -162,6 +278,14 @@ func (c *MockFileStorageClient) AwaitFileSystemActive(ctx context.Context, logge
 	}, nil
 }
 
+func (c *MockFileStorageClient) AwaitFileSystemHydrated(ctx context.Context, logger *zap.SugaredLogger, id string) (*filestorage.FileSystem, error) {
+	return &filestorage.FileSystem{
+		Id:             &id,
+		LifecycleState: filestorage.FileSystemLifecycleStateActive,
+		IsHydrated:     common.Bool(true),
+	}, nil
+}
+
 func (c *MockFileStorageClient) GetFileSystemSummaryByDisplayName(ctx context.Context, compartmentID, ad, displayName string) (bool, []filestorage.FileSystemSummary, error) {
 	filesystemSummaries := make([]filestorage.FileSystemSummary, 0)
 
@@ -388,6 +512,14 @@ func (c *MockVirtualNetworkClient) UpdateSecurityList(ctx context.Context, id st
 	return core.UpdateSecurityListResponse{}, nil
 }
 
+func (c *MockVirtualNetworkClient) GetRouteTable(ctx context.Context, id string) (*core.RouteTable, *string, error) {
+	return nil, nil, nil
+}
+
+func (c *MockVirtualNetworkClient) UpdateRouteTable(ctx context.Context, id, etag string, routeRules []core.RouteRule) (*core.RouteTable, error) {
+	return nil, nil
+}
+
 func (c *MockVirtualNetworkClient) GetPublicIpByIpAddress(ctx context.Context, id string) (*core.PublicIp, error) {
 	return nil, nil
 }
@@ -415,6 +547,10 @@ func (p *MockProvisionerClient) Lustre() client.LustreInterface {
 	return nil
 }
 
+func (p *MockProvisionerClient) Limits() client.LimitsInterface {
+	return nil
+}
+
 // BlockStorage mocks client BlockStorage implementation
 func (p *MockProvisionerClient) BlockStorage() client.BlockStorageInterface {
 	return p.Storage
@@ -489,6 +625,10 @@ func (c *MockLoadBalancerClient) CreateCertificate(ctx context.Context, lbID str
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) DeleteCertificate(ctx context.Context, lbID, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) CreateBackendSet(ctx context.Context, lbID string, name string, details *client.GenericBackendSetDetails) (string, error) {
 	return "", nil
 }
@@ -525,6 +665,42 @@ func (c *MockLoadBalancerClient) DeleteRuleSet(ctx context.Context, lbID string,
 	return "", nil
 }
 
+func (c *MockLoadBalancerClient) CreateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateHostname(ctx context.Context, lbID string, name string, details *loadbalancer.HostnameDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteHostname(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdatePathRouteSet(ctx context.Context, lbID string, name string, details *loadbalancer.PathRouteSetDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeletePathRouteSet(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) CreateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) UpdateRoutingPolicy(ctx context.Context, lbID string, name string, details *loadbalancer.RoutingPolicyDetails) (string, error) {
+	return "", nil
+}
+
+func (c *MockLoadBalancerClient) DeleteRoutingPolicy(ctx context.Context, lbID string, name string) (string, error) {
+	return "", nil
+}
+
 func (c *MockLoadBalancerClient) UpdateLoadBalancerShape(context.Context, string, *client.GenericUpdateLoadBalancerShapeDetails) (string, error) {
 	return "", nil
 }